@@ -0,0 +1,80 @@
+package null
+
+import (
+	"cmp"
+	"time"
+)
+
+// MinDate returns the earliest of values, skipping nulls and values that are not dates
+// like SQL MIN. It returns a null DateString if there are no valid values.
+func MinDate(values ...DateString) DateString {
+	return extreme(values, DateString.date, time.Time.Before)
+}
+
+// MaxDate returns the latest of values, skipping nulls and values that are not dates
+// like SQL MAX. It returns a null DateString if there are no valid values.
+func MaxDate(values ...DateString) DateString {
+	return extreme(values, DateString.date, time.Time.After)
+}
+
+// MinTime returns the earliest of values, skipping nulls like SQL MIN.
+// It returns a null Time if all values are null.
+func MinTime(values ...Time) Time {
+	return extreme(values, timeKey, time.Time.Before)
+}
+
+// MaxTime returns the latest of values, skipping nulls like SQL MAX.
+// It returns a null Time if all values are null.
+func MaxTime(values ...Time) Time {
+	return extreme(values, timeKey, time.Time.After)
+}
+
+// MinInt returns the smallest of values, skipping nulls like SQL MIN.
+// It returns a null Int if all values are null.
+func MinInt(values ...Int) Int {
+	return extreme(values, intKey, cmp.Less[int64])
+}
+
+// MaxInt returns the largest of values, skipping nulls like SQL MAX.
+// It returns a null Int if all values are null.
+func MaxInt(values ...Int) Int {
+	return extreme(values, intKey, greater[int64])
+}
+
+// MinFloat returns the smallest of values, skipping nulls like SQL MIN.
+// It returns a null Float if all values are null.
+func MinFloat(values ...Float) Float {
+	return extreme(values, floatKey, cmp.Less[float64])
+}
+
+// MaxFloat returns the largest of values, skipping nulls like SQL MAX.
+// It returns a null Float if all values are null.
+func MaxFloat(values ...Float) Float {
+	return extreme(values, floatKey, greater[float64])
+}
+
+func timeKey(t Time) (time.Time, bool) { return t.Time, t.Valid }
+func intKey(i Int) (int64, bool)       { return i.Int64, i.Valid }
+func floatKey(f Float) (float64, bool) { return f.Float64, f.Valid }
+
+func greater[K cmp.Ordered](a, b K) bool {
+	return cmp.Less(b, a)
+}
+
+// extreme returns the first value whose key is better than the keys of all others,
+// skipping values without a key, or the zero (null) T if no value has one.
+func extreme[T, K any](values []T, key func(T) (K, bool), better func(a, b K) bool) T {
+	var best T
+	var bestKey K
+	found := false
+	for _, v := range values {
+		k, ok := key(v)
+		if !ok {
+			continue
+		}
+		if !found || better(k, bestKey) {
+			best, bestKey, found = v, k, true
+		}
+	}
+	return best
+}
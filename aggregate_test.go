@@ -0,0 +1,64 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMinMaxDate(t *testing.T) {
+	dates := []DateString{
+		NewDateString("", false),
+		DateStringFrom("2024-03-01"),
+		NewDateString("soon", true),
+		DateStringFrom("2023-12-31"),
+		DateStringFrom("2024-07-15"),
+	}
+	if got := MinDate(dates...); got.String != "2023-12-31" || !got.Valid {
+		t.Errorf("MinDate = %+v", got)
+	}
+	if got := MaxDate(dates...); got.String != "2024-07-15" || !got.Valid {
+		t.Errorf("MaxDate = %+v", got)
+	}
+	if got := MaxDate(NewDateString("", false), NewDateString("", false)); got.Valid {
+		t.Errorf("all null: got %+v", got)
+	}
+	if got := MinDate(); got.Valid {
+		t.Errorf("no values: got %+v", got)
+	}
+}
+
+func TestMinMaxNumbers(t *testing.T) {
+	ints := []Int{NewInt(0, false), IntFrom(3), IntFrom(-2), NewInt(-100, false), IntFrom(7)}
+	assertInt := func(name string, got Int, want int64) {
+		t.Helper()
+		if !got.Valid || got.Int64 != want {
+			t.Errorf("%s = %+v, want %d", name, got, want)
+		}
+	}
+	assertInt("MinInt", MinInt(ints...), -2)
+	assertInt("MaxInt", MaxInt(ints...), 7)
+	if got := MinInt(NewInt(1, false)); got.Valid {
+		t.Errorf("all null: got %+v", got)
+	}
+
+	floats := []Float{FloatFrom(1.5), NewFloat(99, false), FloatFrom(-0.5)}
+	if got := MinFloat(floats...); !got.Valid || got.Float64 != -0.5 {
+		t.Errorf("MinFloat = %+v", got)
+	}
+	if got := MaxFloat(floats...); !got.Valid || got.Float64 != 1.5 {
+		t.Errorf("MaxFloat = %+v", got)
+	}
+	if got := MaxFloat(); got.Valid {
+		t.Errorf("no values: got %+v", got)
+	}
+
+	early := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := early.Add(time.Hour)
+	times := []Time{TimeFrom(late), NewTime(time.Time{}, false), TimeFrom(early)}
+	if got := MinTime(times...); !got.Valid || !got.Time.Equal(early) {
+		t.Errorf("MinTime = %+v", got)
+	}
+	if got := MaxTime(times...); !got.Valid || !got.Time.Equal(late) {
+		t.Errorf("MaxTime = %+v", got)
+	}
+}
@@ -0,0 +1,59 @@
+package null
+
+import (
+	"bytes"
+	"encoding"
+	"testing"
+	"time"
+)
+
+// textAppender is encoding.TextAppender, which was added in Go 1.24.
+type textAppender interface {
+	AppendText(b []byte) ([]byte, error)
+}
+
+func TestAppendText(t *testing.T) {
+	values := []encoding.TextMarshaler{
+		StringFrom("test"), NewString("", false),
+		IntFrom(12345), NewInt(0, false),
+		FloatFrom(1.2345), NewFloat(0, false),
+		BoolFrom(true), BoolFrom(false), NewBool(false, false),
+		TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)), NewTime(time.Time{}, false),
+		DateStringFrom("2024-01-02"),
+		LanguageTagFrom("en-us"),
+		CountryCodeFrom("th"),
+		PhoneFrom("+66 81 234 5678"),
+		RegexpFrom("a+b"),
+		CronFrom("*/5 * * * *"),
+		IntervalFrom(14, 3, time.Hour),
+		NewInterval(0, 0, 0, false),
+		NewEnum("a", "b").With("b"),
+		NewFlags(map[string]uint64{"read": 1, "write": 2}).With(3),
+		FlagsFrom(5),
+		WeekdayFrom(time.Monday),
+		MonthFrom(time.March),
+		PercentFrom(50),
+		UnixMilliFrom(1700000000000),
+	}
+	prefix := []byte("prefix:")
+	for _, v := range values {
+		want, err := v.MarshalText()
+		maybePanic(err)
+		got, err := v.(textAppender).AppendText(append([]byte(nil), prefix...))
+		maybePanic(err)
+		if !bytes.Equal(got, append(append([]byte(nil), prefix...), want...)) {
+			t.Errorf("%T: AppendText = %q, want prefix + %q", v, got, want)
+		}
+	}
+}
+
+func TestAppendTextAllocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	i := IntFrom(12345)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = i.AppendText(buf[:0])
+	})
+	if allocs != 0 {
+		t.Errorf("Int.AppendText allocated %v times", allocs)
+	}
+}
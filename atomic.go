@@ -0,0 +1,61 @@
+package null
+
+import (
+	"sync/atomic"
+)
+
+// Atomic holds a nullable value of type T, such as String or Int, that can be
+// loaded and stored concurrently. Plain nullable values are not safe to
+// mutate from multiple goroutines.
+// The zero Atomic holds the zero T, which is null.
+// An Atomic must not be copied after first use.
+type Atomic[T interface{ Equal(T) bool }] struct {
+	v atomic.Pointer[T]
+}
+
+// NewAtomic creates a new Atomic holding v.
+func NewAtomic[T interface{ Equal(T) bool }](v T) *Atomic[T] {
+	a := new(Atomic[T])
+	a.Store(v)
+	return a
+}
+
+// Load returns the current value.
+func (a *Atomic[T]) Load() T {
+	if p := a.v.Load(); p != nil {
+		return *p
+	}
+	var zero T
+	return zero
+}
+
+// Store sets the current value to v.
+func (a *Atomic[T]) Store(v T) {
+	a.v.Store(&v)
+}
+
+// Swap sets the current value to v and returns the previous value.
+func (a *Atomic[T]) Swap(v T) (old T) {
+	if p := a.v.Swap(&v); p != nil {
+		return *p
+	}
+	return old
+}
+
+// CompareAndSwap sets the current value to new if it is equal to old according to T's Equal method.
+// It reports whether the value was swapped.
+func (a *Atomic[T]) CompareAndSwap(old, new T) (swapped bool) {
+	for {
+		p := a.v.Load()
+		var cur T
+		if p != nil {
+			cur = *p
+		}
+		if !cur.Equal(old) {
+			return false
+		}
+		if a.v.CompareAndSwap(p, &new) {
+			return true
+		}
+	}
+}
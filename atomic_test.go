@@ -0,0 +1,60 @@
+package null
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAtomic(t *testing.T) {
+	var a Atomic[String]
+	assertNullStr(t, a.Load(), "zero Atomic")
+
+	a.Store(StringFrom("a"))
+	if got := a.Load(); !got.Equal(StringFrom("a")) {
+		t.Errorf("Load() = %v, want a", got)
+	}
+	if old := a.Swap(NewString("", false)); !old.Equal(StringFrom("a")) {
+		t.Errorf("Swap() = %v, want a", old)
+	}
+	assertNullStr(t, a.Load(), "Atomic after Swap to null")
+
+	if a.CompareAndSwap(StringFrom("x"), StringFrom("b")) {
+		t.Error("CompareAndSwap succeeded with wrong old value")
+	}
+	if !a.CompareAndSwap(NewString("", false), StringFrom("b")) {
+		t.Error("CompareAndSwap failed with null old value")
+	}
+	if got := a.Load(); !got.Equal(StringFrom("b")) {
+		t.Errorf("Load() = %v, want b", got)
+	}
+
+	ts := NewAtomic(TimeFrom(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+	same := TimeFrom(time.Date(2024, 1, 2, 7, 0, 0, 0, time.FixedZone("ICT", 7*60*60)))
+	if !ts.CompareAndSwap(same, NewTime(time.Time{}, false)) {
+		t.Error("CompareAndSwap should compare times with Equal")
+	}
+}
+
+func TestAtomicConcurrent(t *testing.T) {
+	var a Atomic[Int]
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				for {
+					old := a.Load()
+					if a.CompareAndSwap(old, IntFrom(old.ValueOrZero()+1)) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if got := a.Load(); !got.Equal(IntFrom(800)) {
+		t.Errorf("Load() = %v, want 800", got)
+	}
+}
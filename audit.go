@@ -0,0 +1,37 @@
+package null
+
+// AuditTimes holds the creation, update and soft-deletion times of a record.
+// Embed it in models to share the fields and their helpers:
+//
+//	type User struct {
+//		ID   int64  `json:"id"`
+//		Name string `json:"name"`
+//		null.AuditTimes
+//	}
+//
+// Null times are omitted by Marshal and json.Marshal.
+// The helpers read the current time from the clock set with SetClock.
+type AuditTimes struct {
+	CreatedAt Time `json:"created_at,omitzero" db:"created_at" null:"omitnull"`
+	UpdatedAt Time `json:"updated_at,omitzero" db:"updated_at" null:"omitnull"`
+	DeletedAt Time `json:"deleted_at,omitzero" db:"deleted_at" null:"omitnull"`
+}
+
+// Touch sets UpdatedAt to the current time, and CreatedAt too if it is null.
+func (a *AuditTimes) Touch() {
+	t := now()
+	if !a.CreatedAt.Valid {
+		a.CreatedAt.SetValid(t)
+	}
+	a.UpdatedAt.SetValid(t)
+}
+
+// MarkDeleted sets DeletedAt to the current time.
+func (a *AuditTimes) MarkDeleted() {
+	a.DeletedAt.SetValid(now())
+}
+
+// IsDeleted returns true if DeletedAt is set.
+func (a AuditTimes) IsDeleted() bool {
+	return a.DeletedAt.Valid
+}
@@ -0,0 +1,44 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAuditTimes(t *testing.T) {
+	created := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	clockTime := created
+	SetClock(func() time.Time { return clockTime })
+	defer SetClock(nil)
+
+	var user struct {
+		ID int64 `json:"id"`
+		AuditTimes
+	}
+	user.Touch()
+	if !user.CreatedAt.Time.Equal(created) || !user.UpdatedAt.Time.Equal(created) {
+		t.Errorf("first Touch: %+v", user.AuditTimes)
+	}
+
+	clockTime = created.Add(time.Hour)
+	user.Touch()
+	if !user.CreatedAt.Time.Equal(created) || !user.UpdatedAt.Time.Equal(clockTime) {
+		t.Errorf("second Touch should keep CreatedAt: %+v", user.AuditTimes)
+	}
+	if user.IsDeleted() {
+		t.Error("IsDeleted should be false before MarkDeleted")
+	}
+
+	data, err := Marshal(user)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"id":0,"created_at":"2024-01-01T09:00:00Z","updated_at":"2024-01-01T10:00:00Z"}`, "Marshal")
+	data, err = json.Marshal(user)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"id":0,"created_at":"2024-01-01T09:00:00Z","updated_at":"2024-01-01T10:00:00Z"}`, "json.Marshal")
+
+	user.MarkDeleted()
+	if !user.IsDeleted() || !user.DeletedAt.Time.Equal(clockTime) {
+		t.Errorf("MarkDeleted: %+v", user.AuditTimes)
+	}
+}
@@ -1,6 +1,7 @@
 package null
 
 import (
+	"io"
 	"testing"
 )
 
@@ -43,3 +44,23 @@ func BenchmarkNullStringUnmarshalJSON(b *testing.B) {
 		nullable.UnmarshalJSON(input)
 	}
 }
+
+func BenchmarkDateStringScan(b *testing.B) {
+	input := []byte("2024-01-02")
+	var nullable DateString
+	for n := 0; n < b.N; n++ {
+		nullable.Scan(input)
+	}
+}
+
+func BenchmarkJSONLWriter(b *testing.B) {
+	row := &struct {
+		Name String
+		Age  Int
+		Date DateString
+	}{StringFrom("hello"), IntFrom(123456), DateStringFrom("2024-01-02")}
+	w := NewJSONLWriter(io.Discard)
+	for n := 0; n < b.N; n++ {
+		w.Encode(row)
+	}
+}
@@ -0,0 +1,42 @@
+package null
+
+import (
+	"fmt"
+)
+
+// The binary encoding used by MarshalBinary and AppendBinary is a flag byte,
+// binaryNull or binaryValid, followed by the value's payload if valid.
+const (
+	binaryNull  byte = 0
+	binaryValid byte = 1
+)
+
+// appendBinaryFlag appends the flag byte for valid to buf.
+func appendBinaryFlag(buf []byte, valid bool) []byte {
+	if valid {
+		return append(buf, binaryValid)
+	}
+	return append(buf, binaryNull)
+}
+
+// binaryPayload splits binary data for the named type into its payload and validity.
+// If size is not negative, the payload of a valid value must be exactly size bytes.
+func binaryPayload(name string, data []byte, size int) (payload []byte, valid bool, err error) {
+	if len(data) == 0 {
+		return nil, false, fmt.Errorf("%w: %s: no data", ErrInvalidBinary, name)
+	}
+	switch data[0] {
+	case binaryNull:
+		if len(data) != 1 {
+			return nil, false, fmt.Errorf("%w: %s: trailing data after null", ErrInvalidBinary, name)
+		}
+		return nil, false, nil
+	case binaryValid:
+		payload = data[1:]
+		if size >= 0 && len(payload) != size {
+			return nil, false, fmt.Errorf("%w: %s: want %d bytes, got %d", ErrInvalidBinary, name, size, len(payload))
+		}
+		return payload, true, nil
+	}
+	return nil, false, fmt.Errorf("%w: %s: bad flag %#x", ErrInvalidBinary, name, data[0])
+}
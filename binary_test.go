@@ -0,0 +1,104 @@
+package null
+
+import (
+	"bytes"
+	"encoding"
+	"errors"
+	"testing"
+	"time"
+)
+
+type binaryCodec interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	AppendBinary(b []byte) ([]byte, error)
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	tests := []struct {
+		in  binaryCodec
+		out binaryCodec
+	}{
+		{&String{}, &String{}},
+		{ptr(StringFrom("test")), &String{}},
+		{ptr(StringFrom("")), &String{}},
+		{ptr(IntFrom(-12345)), &Int{}},
+		{&Int{}, &Int{}},
+		{ptr(FloatFrom(1.2345)), &Float{}},
+		{&Float{}, &Float{}},
+		{ptr(BoolFrom(true)), &Bool{}},
+		{ptr(BoolFrom(false)), &Bool{}},
+		{&Bool{}, &Bool{}},
+		{ptr(TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC))), &Time{}},
+		{&Time{}, &Time{}},
+		{ptr(DateStringFrom("2024-01-02")), &DateString{}},
+		{ptr(UnixMilliFrom(1700000000000)), &UnixMilli{}},
+	}
+	prefix := []byte("prefix:")
+	for _, tc := range tests {
+		data, err := tc.in.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%T: MarshalBinary: %v", tc.in, err)
+		}
+		appended, err := tc.in.AppendBinary(append([]byte(nil), prefix...))
+		if err != nil {
+			t.Fatalf("%T: AppendBinary: %v", tc.in, err)
+		}
+		if !bytes.Equal(appended, append(append([]byte(nil), prefix...), data...)) {
+			t.Errorf("%T: AppendBinary = %x, want prefix followed by %x", tc.in, appended, data)
+		}
+		if err := tc.out.UnmarshalBinary(data); err != nil {
+			t.Fatalf("%T: UnmarshalBinary(%x): %v", tc.out, data, err)
+		}
+		if !equalBinary(tc.in, tc.out) {
+			t.Errorf("%T: round trip got %v, want %v", tc.in, tc.out, tc.in)
+		}
+	}
+}
+
+func equalBinary(a, b binaryCodec) bool {
+	switch x := a.(type) {
+	case *String:
+		return x.Equal(*b.(*String))
+	case *Int:
+		return x.Equal(*b.(*Int))
+	case *Float:
+		return x.Equal(*b.(*Float))
+	case *Bool:
+		return x.Equal(*b.(*Bool))
+	case *Time:
+		return x.Equal(*b.(*Time))
+	case *DateString:
+		return *x == *b.(*DateString)
+	case *UnixMilli:
+		return x.Equal(*b.(*UnixMilli))
+	}
+	return false
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	for _, data := range [][]byte{nil, {2}, {0, 1}, {1, 1, 2}} {
+		var i Int
+		if err := i.UnmarshalBinary(data); err == nil {
+			t.Errorf("Int.UnmarshalBinary(%x): expected error", data)
+		}
+	}
+
+	var b BoundedInt[testRating]
+	data, _ := IntFrom(1000).MarshalBinary()
+	var rangeErr *RangeError
+	if err := b.UnmarshalBinary(data); !errors.As(err, &rangeErr) {
+		t.Errorf("BoundedInt.UnmarshalBinary: got %v, want RangeError", err)
+	}
+	assertNullInt(t, b.Int, "out of range BoundedInt binary")
+
+	var p Percent
+	data, _ = FloatFrom(150).MarshalBinary()
+	if err := p.UnmarshalBinary(data); err == nil {
+		t.Error("Percent.UnmarshalBinary: expected error for out of range value")
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
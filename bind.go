@@ -0,0 +1,50 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// FieldSet is the set of fields present in decoded input, keyed by their json names.
+type FieldSet map[string]struct{}
+
+// Has reports whether the field named name was present.
+func (s FieldSet) Has(name string) bool {
+	_, ok := s[name]
+	return ok
+}
+
+// BindJSON decodes a JSON object from r into the struct dst points to, like Unmarshal, and
+// returns the fields whose keys were present. This tells an omitted field apart from one
+// set to null, such as for HTTP PATCH handlers, while the fields keep using plain nullable types.
+//
+// Present keys are matched to fields like Unmarshal and recorded by the field's json name.
+// Keys without a matching field are ignored. If some fields cannot be decoded, the others
+// are still stored and the error is ValidationErrors.
+func BindJSON(r io.Reader, dst any) (FieldSet, error) {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: BindJSON destination must be a pointer to a struct, not %T", dst)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	fields, err := cachedTagFields(rv.Elem().Type())
+	if err != nil {
+		return nil, err
+	}
+	present := make(FieldSet, len(obj))
+	for key := range obj {
+		if f, ok := findTagField(fields, key); ok {
+			present[f.name] = struct{}{}
+		}
+	}
+	return present, Unmarshal(data, dst)
+}
@@ -0,0 +1,69 @@
+package null
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBindJSON(t *testing.T) {
+	type patchUser struct {
+		Name     String     `json:"name"`
+		Nickname String     `json:"nickname"`
+		Email    String     `json:"email"`
+		Born     DateString `json:"born" null:"format=02/01/2006"`
+	}
+	var req patchUser
+	fields, err := BindJSON(strings.NewReader(`{"NAME": "A", "nickname": null, "born": "02/01/2000", "extra": 1}`), &req)
+	maybePanic(err)
+
+	for name, want := range map[string]bool{"name": true, "nickname": true, "born": true, "email": false, "extra": false} {
+		if got := fields.Has(name); got != want {
+			t.Errorf("Has(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if req.Name != StringFrom("A") || req.Nickname.Valid || req.Email.Valid || req.Born.String != "2000-01-02" {
+		t.Errorf("BindJSON() = %+v", req)
+	}
+
+	if _, err := BindJSON(strings.NewReader(`[1]`), &req); !errors.Is(err, ErrInvalidJSON) {
+		t.Errorf("non-object input: got %v, want ErrInvalidJSON", err)
+	}
+	if _, err := BindJSON(strings.NewReader(`{}`), req); err == nil {
+		t.Error("non-pointer destination should fail")
+	}
+}
+
+// bindUnmarshaler is the BindUnmarshaler interface of Gin and Echo.
+type bindUnmarshaler interface {
+	UnmarshalParam(param string) error
+}
+
+func TestUnmarshalParam(t *testing.T) {
+	var d DateString
+	maybePanic(d.UnmarshalParam("2024-01-02T10:00:00Z"))
+	if d.String != "2024-01-02" || !d.Valid {
+		t.Errorf("DateString.UnmarshalParam = %v", d)
+	}
+
+	e := NewEnum("asc", "desc")
+	maybePanic(e.UnmarshalParam("desc"))
+	if e.String != "desc" {
+		t.Errorf("Enum.UnmarshalParam = %v", e)
+	}
+	if err := e.UnmarshalParam("sideways"); err == nil {
+		t.Error("Enum.UnmarshalParam should reject unknown values")
+	}
+
+	var p Percent
+	if err := p.UnmarshalParam("150"); err == nil {
+		t.Error("Percent.UnmarshalParam should check the range")
+	}
+
+	for _, v := range []bindUnmarshaler{new(String), new(Int), new(Bool), new(Time), new(Weekday), new(Phone)} {
+		maybePanic(v.UnmarshalParam(""))
+		if !v.(interface{ IsNull() bool }).IsNull() {
+			t.Errorf("%T.UnmarshalParam(\"\") should be null", v)
+		}
+	}
+}
@@ -4,8 +4,9 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
 )
 
 // Bool is a nullable bool.
@@ -43,17 +44,29 @@ func (b Bool) ValueOrZero() bool {
 	return b.Valid && b.Bool
 }
 
+// Scan implements the sql.Scanner interface.
+// It returns a *ScanError if value cannot be converted.
+func (b *Bool) Scan(value any) error {
+	if err := b.NullBool.Scan(value); err != nil {
+		b.Valid = false
+		return newScanError("null.Bool", value, err)
+	}
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will not be considered a null Bool.
 func (b *Bool) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "Bool")
+	data = trimJSON("Bool", data)
 	if bytes.Equal(data, nullBytes) {
 		b.Valid = false
 		return nil
 	}
 
 	if err := json.Unmarshal(data, &b.Bool); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
 	}
 
 	b.Valid = true
@@ -64,7 +77,8 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 // It will unmarshal to a null Bool if the input is blank.
 // It will return an error if the input is not an integer, blank, or "null".
 func (b *Bool) UnmarshalText(text []byte) error {
-	str := string(text)
+	text = prepareText("Bool", text)
+	str := viewString(text)
 	switch str {
 	case "", "null":
 		b.Valid = false
@@ -74,15 +88,25 @@ func (b *Bool) UnmarshalText(text []byte) error {
 	case "false":
 		b.Bool = false
 	default:
-		return errors.New("null: invalid input for UnmarshalText:" + str)
+		return fmt.Errorf("%w: invalid input %q", ErrInvalidText, text)
 	}
 	b.Valid = true
 	return nil
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (b *Bool) UnmarshalParam(param string) error {
+	return b.UnmarshalText(viewBytes(param))
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Bool is null.
 func (b Bool) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Bool", b.Valid, b.marshalJSON)
+}
+
+func (b Bool) marshalJSON() ([]byte, error) {
 	if !b.Valid {
 		return []byte("null"), nil
 	}
@@ -104,6 +128,52 @@ func (b Bool) MarshalText() ([]byte, error) {
 	return []byte("true"), nil
 }
 
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (b Bool) AppendText(buf []byte) ([]byte, error) {
+	if !b.Valid {
+		return buf, nil
+	}
+	return strconv.AppendBool(buf, b.Bool), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Bool to v under key, or nothing if null.
+func (b Bool) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, b.Valid, b.MarshalText)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b Bool) MarshalBinary() ([]byte, error) {
+	return b.AppendBinary(make([]byte, 0, 2))
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// It appends the same bytes as MarshalBinary to buf.
+func (b Bool) AppendBinary(buf []byte) ([]byte, error) {
+	buf = appendBinaryFlag(buf, b.Valid)
+	if !b.Valid {
+		return buf, nil
+	}
+	if b.Bool {
+		return append(buf, 1), nil
+	}
+	return append(buf, 0), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *Bool) UnmarshalBinary(data []byte) error {
+	payload, valid, err := binaryPayload("Bool", data, 1)
+	if err != nil {
+		return err
+	}
+	b.Bool, b.Valid = false, valid
+	if valid {
+		b.Bool = payload[0] != 0
+	}
+	return nil
+}
+
 // SetValid changes this Bool's value and also sets it to be non-null.
 func (b *Bool) SetValid(v bool) {
 	b.Bool = v
@@ -124,7 +194,78 @@ func (b Bool) IsZero() bool {
 	return !b.Valid
 }
 
+// IsNull returns true if this Bool is null.
+func (b Bool) IsNull() bool {
+	return !b.Valid
+}
+
+// SetNull sets this Bool to null and clears its value.
+func (b *Bool) SetNull() {
+	b.Bool, b.Valid = false, false
+}
+
+// Reset sets this Bool to its zero value, which is null.
+func (b *Bool) Reset() {
+	*b = Bool{}
+}
+
 // Equal returns true if both booleans have the same value or are both null.
 func (b Bool) Equal(other Bool) bool {
 	return b.Valid == other.Valid && (!b.Valid || b.Bool == other.Bool)
 }
+
+// Merge returns this Bool if it is valid, otherwise other.
+func (b Bool) Merge(other Bool) Bool {
+	if b.Valid {
+		return b
+	}
+	return other
+}
+
+// When returns this Bool if cond is true, otherwise a null Bool.
+func (b Bool) When(cond bool) Bool {
+	if !cond {
+		b.SetNull()
+	}
+	return b
+}
+
+// And returns the SQL three-valued AND of both booleans.
+// It is false if either is false, null if either is null, and true otherwise.
+func (b Bool) And(other Bool) Bool {
+	if (b.Valid && !b.Bool) || (other.Valid && !other.Bool) {
+		return BoolFrom(false)
+	}
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(true)
+}
+
+// Or returns the SQL three-valued OR of both booleans.
+// It is true if either is true, null if either is null, and false otherwise.
+func (b Bool) Or(other Bool) Bool {
+	if (b.Valid && b.Bool) || (other.Valid && other.Bool) {
+		return BoolFrom(true)
+	}
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(false)
+}
+
+// Not returns the SQL three-valued NOT of this Bool, which is null if this Bool is null.
+func (b Bool) Not() Bool {
+	if !b.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(!b.Bool)
+}
+
+// Xor returns the SQL three-valued XOR of both booleans, which is null if either is null.
+func (b Bool) Xor(other Bool) Bool {
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(b.Bool != other.Bool)
+}
@@ -217,6 +217,37 @@ func TestBoolEqual(t *testing.T) {
 	assertBoolEqualIsFalse(t, b1, b2)
 }
 
+func TestBoolLogic(t *testing.T) {
+	T, F, N := BoolFrom(true), BoolFrom(false), NewBool(false, false)
+	tests := []struct {
+		a, b         Bool
+		and, or, xor Bool
+	}{
+		{T, T, T, T, F},
+		{T, F, F, T, T},
+		{F, F, F, F, F},
+		{T, N, N, T, N},
+		{F, N, F, N, N},
+		{N, T, N, T, N},
+		{N, F, F, N, N},
+		{N, N, N, N, N},
+	}
+	for _, tc := range tests {
+		if got := tc.a.And(tc.b); !got.Equal(tc.and) {
+			t.Errorf("%v AND %v = %v, want %v", tc.a, tc.b, got, tc.and)
+		}
+		if got := tc.a.Or(tc.b); !got.Equal(tc.or) {
+			t.Errorf("%v OR %v = %v, want %v", tc.a, tc.b, got, tc.or)
+		}
+		if got := tc.a.Xor(tc.b); !got.Equal(tc.xor) {
+			t.Errorf("%v XOR %v = %v, want %v", tc.a, tc.b, got, tc.xor)
+		}
+	}
+	assertBoolEqualIsTrue(t, T.Not(), F)
+	assertBoolEqualIsTrue(t, F.Not(), T)
+	assertBoolEqualIsTrue(t, N.Not(), N)
+}
+
 func assertBool(t *testing.T, b Bool, from string) {
 	if b.Bool != true {
 		t.Errorf("bad %s bool: %v ≠ %v\n", from, b.Bool, true)
@@ -0,0 +1,143 @@
+package null
+
+import (
+	"fmt"
+)
+
+// IntBounds defines the accepted range of a BoundedInt.
+// Implement it on an empty struct type, for example:
+//
+//	type Rating struct{}
+//
+//	func (Rating) Bounds() (min, max int64) { return 1, 5 }
+type IntBounds interface {
+	Bounds() (min, max int64)
+}
+
+// RangeError is returned when a BoundedInt receives a value outside of its bounds.
+type RangeError struct {
+	Value    int64
+	Min, Max int64
+}
+
+// Error implements the error interface.
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("null: value %d out of range [%d, %d]", e.Value, e.Min, e.Max)
+}
+
+// BoundedInt is a nullable int64 constrained to the range given by B.
+// It supports SQL and JSON serialization like Int, and returns a *RangeError
+// from Scan, UnmarshalJSON and UnmarshalText for out-of-range input.
+// It will marshal to null if null.
+type BoundedInt[B IntBounds] struct {
+	Int
+}
+
+// NewBoundedInt creates a new BoundedInt. The value is not range checked.
+func NewBoundedInt[B IntBounds](i int64, valid bool) BoundedInt[B] {
+	return BoundedInt[B]{Int: NewInt(i, valid)}
+}
+
+// BoundedIntFrom creates a new BoundedInt that will be null if i is out of range.
+func BoundedIntFrom[B IntBounds](i int64) BoundedInt[B] {
+	b := NewBoundedInt[B](i, true)
+	if err := b.check(); err != nil {
+		coerced("BoundedInt", "From", fmt.Sprint(i), err)
+	}
+	return b
+}
+
+// BoundedIntFromPtr creates a new BoundedInt that will be null if i is nil or out of range.
+func BoundedIntFromPtr[B IntBounds](i *int64) BoundedInt[B] {
+	if i == nil {
+		return NewBoundedInt[B](0, false)
+	}
+	return BoundedIntFrom[B](*i)
+}
+
+// Bounds returns the accepted range.
+func (b BoundedInt[B]) Bounds() (min, max int64) {
+	var bounds B
+	return bounds.Bounds()
+}
+
+func (b *BoundedInt[B]) check() error {
+	if !b.Valid {
+		return nil
+	}
+	min, max := b.Bounds()
+	if b.Int64 < min || b.Int64 > max {
+		b.Valid = false
+		return &RangeError{Value: b.Int64, Min: min, Max: max}
+	}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (b *BoundedInt[B]) Scan(value any) error {
+	if err := b.Int.Scan(value); err != nil {
+		return err
+	}
+	return b.check()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+func (b *BoundedInt[B]) UnmarshalJSON(data []byte) error {
+	data = trimJSON("BoundedInt", data)
+	if err := b.Int.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	return b.check()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null BoundedInt if the input is blank or "null".
+func (b *BoundedInt[B]) UnmarshalText(text []byte) error {
+	text = prepareText("BoundedInt", text)
+	if err := b.Int.UnmarshalText(text); err != nil {
+		return err
+	}
+	return b.check()
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (b *BoundedInt[B]) UnmarshalParam(param string) error {
+	return b.UnmarshalText([]byte(param))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *BoundedInt[B]) UnmarshalBinary(data []byte) error {
+	if err := b.Int.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	return b.check()
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this BoundedInt is null.
+func (b BoundedInt[B]) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("BoundedInt", b.Valid, b.Int.marshalJSON)
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (b BoundedInt[B]) Equal(other BoundedInt[B]) bool {
+	return b.Int.Equal(other.Int)
+}
+
+// Merge returns this BoundedInt if it is valid, otherwise other.
+func (b BoundedInt[B]) Merge(other BoundedInt[B]) BoundedInt[B] {
+	if b.Valid {
+		return b
+	}
+	return other
+}
+
+// When returns this BoundedInt if cond is true, otherwise a null BoundedInt.
+func (b BoundedInt[B]) When(cond bool) BoundedInt[B] {
+	if !cond {
+		b.SetNull()
+	}
+	return b
+}
@@ -0,0 +1,103 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type testRating struct{}
+
+func (testRating) Bounds() (min, max int64) { return 1, 5 }
+
+type ratingInStruct struct {
+	Stars BoundedInt[testRating] `json:"stars"`
+}
+
+func TestBoundedIntFrom(t *testing.T) {
+	r := BoundedIntFrom[testRating](4)
+	if !r.Valid || r.Int64 != 4 {
+		t.Errorf("bad BoundedIntFrom(): %d %t", r.Int64, r.Valid)
+	}
+	if BoundedIntFrom[testRating](0).Valid || BoundedIntFrom[testRating](6).Valid {
+		t.Error("out of range BoundedIntFrom() should be null")
+	}
+	if BoundedIntFromPtr[testRating](nil).Valid {
+		t.Error("BoundedIntFromPtr(nil) should be null")
+	}
+	if min, max := r.Bounds(); min != 1 || max != 5 {
+		t.Errorf("bad Bounds(): %d %d", min, max)
+	}
+}
+
+func TestUnmarshalBoundedInt(t *testing.T) {
+	var s ratingInStruct
+	err := json.Unmarshal([]byte(`{"stars":5}`), &s)
+	maybePanic(err)
+	if !s.Stars.Equal(BoundedIntFrom[testRating](5)) {
+		t.Errorf("bad unmarshaled rating: %v", s.Stars)
+	}
+
+	err = json.Unmarshal([]byte(`{"stars":9}`), &s)
+	var rangeErr *RangeError
+	if !errors.As(err, &rangeErr) {
+		t.Fatalf("expected *RangeError, got %T: %v", err, err)
+	}
+	if rangeErr.Value != 9 || rangeErr.Min != 1 || rangeErr.Max != 5 {
+		t.Errorf("bad RangeError: %+v", rangeErr)
+	}
+	if s.Stars.Valid {
+		t.Error("out of range input should be null")
+	}
+
+	err = json.Unmarshal([]byte(`{"stars":null}`), &s)
+	maybePanic(err)
+	if s.Stars.Valid {
+		t.Error("null json should produce null rating")
+	}
+
+	var text BoundedInt[testRating]
+	err = text.UnmarshalText([]byte("0"))
+	if !errors.As(err, &rangeErr) || text.Valid {
+		t.Errorf("expected *RangeError and null, got %v %v", err, text)
+	}
+
+	var bad BoundedInt[testRating]
+	err = bad.UnmarshalText([]byte("x"))
+	if err == nil || errors.As(err, &rangeErr) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+}
+
+func TestMarshalBoundedInt(t *testing.T) {
+	data, err := json.Marshal(ratingInStruct{Stars: BoundedIntFrom[testRating](3)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"stars":3}`, "rating json marshal")
+
+	data, err = json.Marshal(ratingInStruct{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"stars":null}`, "null rating json marshal")
+}
+
+func TestBoundedIntScanValue(t *testing.T) {
+	var r BoundedInt[testRating]
+	err := r.Scan(int64(2))
+	maybePanic(err)
+	if v, err := r.Value(); v != int64(2) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var over BoundedInt[testRating]
+	err = over.Scan(int64(10))
+	var rangeErr *RangeError
+	if !errors.As(err, &rangeErr) || over.Valid {
+		t.Errorf("expected *RangeError and null, got %v %v", err, over)
+	}
+
+	var null BoundedInt[testRating]
+	err = null.Scan(nil)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("scanned null should be null")
+	}
+}
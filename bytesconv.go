@@ -0,0 +1,15 @@
+//go:build !nullunsafe
+
+package null
+
+// viewString returns b as a string for parsing. The string must not outlive the call,
+// because building with the nullunsafe tag makes it share the memory of b.
+func viewString(b []byte) string {
+	return string(b)
+}
+
+// viewBytes returns s as a byte slice for parsing. The slice must not be modified or kept,
+// because building with the nullunsafe tag makes it share the memory of s.
+func viewBytes(s string) []byte {
+	return []byte(s)
+}
@@ -0,0 +1,32 @@
+package null
+
+import "testing"
+
+func TestViewConversions(t *testing.T) {
+	b := []byte("12.5")
+	if s := viewString(b); s != "12.5" {
+		t.Errorf("viewString = %q", s)
+	}
+	if b := viewBytes("true"); string(b) != "true" {
+		t.Errorf("viewBytes = %q", b)
+	}
+
+	var i Int
+	maybePanic(i.Scan([]byte("42")))
+	if i.Int64 != 42 || !i.Valid {
+		t.Errorf("Scan fast path = %+v", i)
+	}
+	if err := i.Scan([]byte("4x")); err == nil || i.Valid {
+		t.Errorf("Scan of bad text = %v %+v", err, i)
+	}
+	var f Float
+	maybePanic(f.Scan([]byte("-1.25")))
+	if f.Float64 != -1.25 || !f.Valid {
+		t.Errorf("Scan fast path = %+v", f)
+	}
+	var bl Bool
+	maybePanic(bl.UnmarshalParam("false"))
+	if bl.Bool || !bl.Valid {
+		t.Errorf("UnmarshalParam = %+v", bl)
+	}
+}
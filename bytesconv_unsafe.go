@@ -0,0 +1,17 @@
+//go:build nullunsafe
+
+package null
+
+import "unsafe"
+
+// viewString returns b as a string that shares its memory, for parsing.
+// The string must not outlive the call.
+func viewString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
+
+// viewBytes returns s as a byte slice that shares its memory, for parsing.
+// The slice must not be modified or kept.
+func viewBytes(s string) []byte {
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}
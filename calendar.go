@@ -0,0 +1,64 @@
+package null
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalendarStyle controls how Weekday and Month values are marshaled.
+type CalendarStyle int
+
+const (
+	// StyleLong marshals full English names, such as "Monday" or "January".
+	StyleLong CalendarStyle = iota
+	// StyleShort marshals three-letter English names, such as "Mon" or "Jan".
+	StyleShort
+	// StyleNumber marshals numbers: 0 (Sunday) to 6 for Weekday, 1 (January) to 12 for Month.
+	StyleNumber
+)
+
+var (
+	// FormatWeekday Set default marshal style of Weekday
+	//
+	// Deprecated: Use Configure with Options.WeekdayStyle. It is ignored once Configure has been called.
+	FormatWeekday = StyleLong
+	// FormatMonth Set default marshal style of Month
+	//
+	// Deprecated: Use Configure with Options.MonthStyle. It is ignored once Configure has been called.
+	FormatMonth = StyleLong
+)
+
+// formatCalendar formats the name or number n according to style.
+func formatCalendar(name string, n int, style CalendarStyle) string {
+	switch style {
+	case StyleShort:
+		return name[:3]
+	case StyleNumber:
+		return strconv.Itoa(n)
+	default:
+		return name
+	}
+}
+
+// parseCalendar parses s as a number in [min, max] or as a full or three-letter
+// name (case-insensitive) from names, where names[i] has the number i+min.
+func parseCalendar(s string, names func(int) string, min, max int) (int, bool) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, n >= min && n <= max
+	}
+	if len(s) < 3 {
+		return 0, false
+	}
+	for n := min; n <= max; n++ {
+		name := names(n)
+		if strings.EqualFold(s, name) || strings.EqualFold(s, name[:3]) {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+func weekdayName(n int) string { return time.Weekday(n).String() }
+func monthName(n int) string   { return time.Month(n).String() }
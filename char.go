@@ -0,0 +1,210 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+)
+
+// Char is a nullable single character, such as a CHAR(1) flag column.
+// Input must be exactly one character; blank input produces a null Char.
+// It marshals to a one-character string, and will marshal to null if null.
+type Char struct {
+	Rune  rune
+	Valid bool
+}
+
+// NewChar creates a new Char.
+func NewChar(r rune, valid bool) Char {
+	return Char{
+		Rune:  r,
+		Valid: valid,
+	}
+}
+
+// CharFrom creates a new Char that will always be valid.
+func CharFrom(r rune) Char {
+	return NewChar(r, true)
+}
+
+// CharFromPtr creates a new Char that will be null if r is nil.
+func CharFromPtr(r *rune) Char {
+	if r == nil {
+		return NewChar(0, false)
+	}
+	return NewChar(*r, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (c Char) ValueOrZero() rune {
+	if !c.Valid {
+		return 0
+	}
+	return c.Rune
+}
+
+var errNotChar = errors.New("not a single character")
+
+func (c *Char) set(str string) error {
+	if str == "" {
+		c.Rune, c.Valid = 0, false
+		return nil
+	}
+	r, size := utf8.DecodeRuneInString(str)
+	if size != len(str) || r == utf8.RuneError {
+		c.Rune, c.Valid = 0, false
+		return fmt.Errorf("invalid char %q: %w", str, errNotChar)
+	}
+	c.Rune, c.Valid = r, true
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if the column holds more than one character.
+func (c *Char) Scan(value any) error {
+	var ns sql.NullString
+	if err := ns.Scan(value); err != nil {
+		c.Rune, c.Valid = 0, false
+		return newScanError("null.Char", value, err)
+	}
+	if err := c.set(ns.String); err != nil {
+		return newScanError("null.Char", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (c Char) Value() (driver.Value, error) {
+	if !c.Valid {
+		return nil, nil
+	}
+	return string(c.Rune), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input. Blank string input produces a null Char.
+// It returns an error if the input is longer than one character.
+func (c *Char) UnmarshalJSON(data []byte) error {
+	data = trimJSON("Char", data)
+	if bytes.Equal(data, nullBytes) {
+		c.Rune, c.Valid = 0, false
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	return unmarshalError(ErrInvalidJSON, c.set(str))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Char if the input is blank or "null".
+// It returns an error if the input is longer than one character.
+func (c *Char) UnmarshalText(text []byte) error {
+	text = prepareText("Char", text)
+	str := string(text)
+	if str == "null" {
+		str = ""
+	}
+	return unmarshalError(ErrInvalidText, c.set(str))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (c *Char) UnmarshalParam(param string) error {
+	return c.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Char is null.
+func (c Char) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Char", c.Valid, c.marshalJSON)
+}
+
+func (c Char) marshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(string(c.Rune))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Char is null.
+func (c Char) MarshalText() ([]byte, error) {
+	return c.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (c Char) AppendText(buf []byte) ([]byte, error) {
+	if !c.Valid {
+		return buf, nil
+	}
+	return utf8.AppendRune(buf, c.Rune), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Char to v under key, or nothing if null.
+func (c Char) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, c.Valid, c.MarshalText)
+}
+
+// SetValid changes this Char's value and also sets it to be non-null.
+func (c *Char) SetValid(v rune) {
+	c.Rune = v
+	c.Valid = true
+}
+
+// Ptr returns a pointer to this Char's value, or a nil pointer if this Char is null.
+func (c Char) Ptr() *rune {
+	if !c.Valid {
+		return nil
+	}
+	return &c.Rune
+}
+
+// IsZero returns true for null Chars, for potential future omitempty support.
+func (c Char) IsZero() bool {
+	return !c.Valid
+}
+
+// IsNull returns true if this Char is null.
+func (c Char) IsNull() bool {
+	return !c.Valid
+}
+
+// SetNull sets this Char to null and clears its value.
+func (c *Char) SetNull() {
+	c.Rune, c.Valid = 0, false
+}
+
+// Reset sets this Char to its zero value, which is null.
+func (c *Char) Reset() {
+	*c = Char{}
+}
+
+// Equal returns true if both Chars have the same value or are both null.
+func (c Char) Equal(other Char) bool {
+	return c.Valid == other.Valid && (!c.Valid || c.Rune == other.Rune)
+}
+
+// Merge returns this Char if it is valid, otherwise other.
+func (c Char) Merge(other Char) Char {
+	if c.Valid {
+		return c
+	}
+	return other
+}
+
+// When returns this Char if cond is true, otherwise a null Char.
+func (c Char) When(cond bool) Char {
+	if !cond {
+		c.SetNull()
+	}
+	return c
+}
@@ -0,0 +1,88 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCharUnmarshal(t *testing.T) {
+	var c Char
+	err := json.Unmarshal([]byte(`"Y"`), &c)
+	maybePanic(err)
+	if c.Rune != 'Y' || !c.Valid {
+		t.Errorf("UnmarshalJSON = %+v", c)
+	}
+	data, err := json.Marshal(c)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"Y"`, "Char")
+
+	err = json.Unmarshal([]byte(`"ก"`), &c)
+	maybePanic(err)
+	if c.Rune != 'ก' || !c.Valid {
+		t.Errorf("UnmarshalJSON multi-byte = %+v", c)
+	}
+
+	for _, in := range []string{`""`, `null`} {
+		err = json.Unmarshal([]byte(in), &c)
+		maybePanic(err)
+		if c.Valid {
+			t.Errorf("UnmarshalJSON(%s): expected null, got %+v", in, c)
+		}
+	}
+	for _, in := range []string{`"YN"`, `1`, `"\ud800"`} {
+		if err := json.Unmarshal([]byte(in), &c); err == nil || c.Valid {
+			t.Errorf("UnmarshalJSON(%s): expected error, got %v %+v", in, err, c)
+		}
+	}
+
+	if err := c.UnmarshalText([]byte("N")); err != nil || c.Rune != 'N' || !c.Valid {
+		t.Errorf("UnmarshalText = %v %+v", err, c)
+	}
+	if err := c.UnmarshalText([]byte("null")); err != nil || c.Valid {
+		t.Errorf("UnmarshalText(null) = %v %+v", err, c)
+	}
+}
+
+func TestCharScanValue(t *testing.T) {
+	var c Char
+	err := c.Scan([]byte("A"))
+	maybePanic(err)
+	if c.Rune != 'A' || !c.Valid {
+		t.Errorf("Scan = %+v", c)
+	}
+	v, err := c.Value()
+	maybePanic(err)
+	if v != "A" {
+		t.Errorf("Value = %v, want A", v)
+	}
+
+	err = c.Scan(nil)
+	maybePanic(err)
+	if c.Valid {
+		t.Errorf("Scan(nil) = %+v", c)
+	}
+	if v, _ := c.Value(); v != nil {
+		t.Errorf("null Value = %v", v)
+	}
+	if err := c.Scan("AB"); err == nil || c.Valid {
+		t.Errorf("Scan(AB): expected error, got %v %+v", err, c)
+	}
+}
+
+func TestCharMarshal(t *testing.T) {
+	data, err := json.Marshal(NewChar(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null Char")
+
+	text, err := CharFrom('ข').MarshalText()
+	maybePanic(err)
+	if string(text) != "ข" {
+		t.Errorf("MarshalText = %q", text)
+	}
+	if p := CharFromPtr(nil).Ptr(); p != nil {
+		t.Errorf("Ptr = %v, want nil", p)
+	}
+	if !CharFrom('a').Equal(CharFrom('a')) || CharFrom('a').Equal(CharFrom('b')) {
+		t.Error("Equal")
+	}
+}
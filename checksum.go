@@ -0,0 +1,88 @@
+package null
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+)
+
+var nullerType = reflect.TypeOf((*Nuller)(nil)).Elem()
+
+// Checksum returns a 64-bit FNV-1a hash of the fields of a struct or a pointer to one,
+// such as for an ETag or to detect changed rows in a sync job. Each field is hashed by its
+// column name, like SetMap, and its value. Nullable fields also hash their validity,
+// and null fields ignore any value left in them, so a null String hashes the same whatever its
+// String field holds, and nil pointers to nullable types hash like null.
+//
+// Valid nullable fields hash the value of their ValueOrZero method as text, or as JSON if it
+// has no text form, so the result does not depend on Options such as DateFormat or JSONMarshalMode.
+// Secret, Encrypted and Password fields only hash their validity, so that their contents cannot
+// be guessed from the checksum. Other fields hash their JSON encoding.
+// The result is the same across processes for equal structs.
+func Checksum(v any) (uint64, error) {
+	rv := structValue(v)
+	if !rv.IsValid() {
+		return 0, fmt.Errorf("null: Checksum of non-struct %T", v)
+	}
+	h := fnv.New64a()
+	for _, f := range dbFields(rv.Type()) {
+		fv := rv.FieldByIndex(f.index)
+		h.Write([]byte(f.name))
+		h.Write([]byte{0})
+		nullable, isNull := nullState(fv)
+		if nullable {
+			if isNull {
+				h.Write([]byte{binaryNull})
+				continue
+			}
+			h.Write([]byte{binaryValid})
+		}
+		data, err := checksumValue(fv, nullable)
+		if err != nil {
+			return 0, fmt.Errorf("null: Checksum of field %s: %w", f.name, err)
+		}
+		h.Write(data)
+		h.Write([]byte{0})
+	}
+	return h.Sum64(), nil
+}
+
+// nullState reports whether fv is a nullable type, one whose pointer is a Nuller,
+// or a pointer to one, and if so whether it is null. Nil pointers are null.
+func nullState(fv reflect.Value) (nullable, isNull bool) {
+	t := fv.Type()
+	if t.Kind() != reflect.Pointer {
+		t = reflect.PointerTo(t)
+	}
+	if !t.Implements(nullerType) {
+		return false, false
+	}
+	if fv.Kind() == reflect.Pointer && fv.IsNil() {
+		return true, true
+	}
+	return true, fv.Interface().(interface{ IsNull() bool }).IsNull()
+}
+
+// checksumValue returns the encoding of fv hashed by Checksum.
+func checksumValue(fv reflect.Value, nullable bool) ([]byte, error) {
+	v := fv.Interface()
+	if !nullable {
+		return json.Marshal(v)
+	}
+	switch reflect.Indirect(fv).Interface().(type) {
+	case Secret, Encrypted, Password:
+		return nil, nil
+	}
+	if m := fv.MethodByName("ValueOrZero"); m.IsValid() && m.Type().NumIn() == 0 && m.Type().NumOut() == 1 {
+		v = m.Call(nil)[0].Interface()
+	}
+	switch v := v.(type) {
+	case encoding.TextMarshaler:
+		return v.MarshalText()
+	case fmt.Stringer:
+		return []byte(v.String()), nil
+	}
+	return json.Marshal(v)
+}
@@ -0,0 +1,101 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChecksum(t *testing.T) {
+	type row struct {
+		ID    int64      `db:"id"`
+		Name  String     `db:"name"`
+		Birth DateString `db:"birth"`
+	}
+	a := row{ID: 1, Name: StringFrom("Somchai"), Birth: DateStringFrom("1990-01-01")}
+	sum, err := Checksum(a)
+	maybePanic(err)
+	if again, _ := Checksum(&a); again != sum {
+		t.Errorf("pointer and value should hash the same: %x != %x", again, sum)
+	}
+	if sum != 0xff0b99f6e1804250 {
+		t.Errorf("checksum changed: %#x", sum)
+	}
+
+	b := a
+	b.Birth = NewDateString("", false)
+	nullSum, _ := Checksum(b)
+	if nullSum == sum {
+		t.Error("validity should change the checksum")
+	}
+	b.Birth = NewDateString("1990-01-01", false)
+	if leftover, _ := Checksum(b); leftover != nullSum {
+		t.Error("null fields should ignore their leftover value")
+	}
+
+	c := a
+	c.Name = StringFrom("")
+	d := a
+	d.Name = NewString("", false)
+	cs, _ := Checksum(c)
+	ds, _ := Checksum(d)
+	if cs == ds {
+		t.Error("a blank string and null should hash differently")
+	}
+
+	e := a
+	e.ID = 2
+	if es, _ := Checksum(e); es == sum {
+		t.Error("plain fields should change the checksum")
+	}
+
+	if _, err := Checksum(42); err == nil {
+		t.Error("expected an error for a non-struct")
+	}
+}
+
+func TestChecksumPointer(t *testing.T) {
+	type row struct {
+		Name *String `db:"name"`
+	}
+	null := NewString("stale", false)
+	a, err := Checksum(row{})
+	maybePanic(err)
+	b, err := Checksum(row{Name: &null})
+	maybePanic(err)
+	if a != b {
+		t.Error("a nil pointer should hash the same as null")
+	}
+}
+
+func TestChecksumOptions(t *testing.T) {
+	type row struct {
+		Name  String     `db:"name"`
+		Birth DateString `db:"birth"`
+		Day   Weekday    `db:"day"`
+	}
+	r := row{Name: StringFrom("Somchai"), Birth: DateStringFrom("1990-01-01"), Day: WeekdayFrom(time.Monday)}
+	sum, err := Checksum(r)
+	maybePanic(err)
+
+	defer configured.Store(nil)
+	Configure(Options{JSONMarshalMode: JSONObject, DateFormat: "02/01/2006", WeekdayStyle: StyleNumber})
+	if configuredSum, _ := Checksum(r); configuredSum != sum {
+		t.Errorf("Options should not change the checksum: %#x != %#x", configuredSum, sum)
+	}
+}
+
+func TestChecksumSecret(t *testing.T) {
+	type row struct {
+		Token Secret `db:"token"`
+	}
+	a, err := Checksum(row{Token: SecretFrom("hunter2")})
+	maybePanic(err)
+	b, err := Checksum(row{Token: SecretFrom("swordfish")})
+	maybePanic(err)
+	if a != b {
+		t.Error("secrets should only hash their validity")
+	}
+	if c, _ := Checksum(row{}); c == a {
+		t.Error("a null secret should hash differently")
+	}
+}
@@ -0,0 +1,37 @@
+package null
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+var clock atomic.Pointer[func() time.Time]
+
+// SetClock sets the function that Today, NowDateTime and the date helpers that default to the
+// current time, such as DateString.Humanize, use to read it. Tests can pass a fixed clock.
+// Pass nil to go back to time.Now.
+func SetClock(fn func() time.Time) {
+	if fn == nil {
+		clock.Store(nil)
+		return
+	}
+	clock.Store(&fn)
+}
+
+// now returns the current time from the clock set with SetClock.
+func now() time.Time {
+	if fn := clock.Load(); fn != nil {
+		return (*fn)()
+	}
+	return time.Now()
+}
+
+// Today returns the current date, in its location, as a valid DateString.
+func Today() DateString {
+	return NewDateString(now().Format(config().DateFormat), true)
+}
+
+// NowDateTime returns the current time as a valid Time.
+func NowDateTime() Time {
+	return TimeFrom(now())
+}
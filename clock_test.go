@@ -0,0 +1,30 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetClock(t *testing.T) {
+	fixed := time.Date(2024, 2, 29, 8, 30, 0, 0, time.UTC)
+	SetClock(func() time.Time { return fixed })
+	defer SetClock(nil)
+
+	if got := Today(); !got.Valid || got.String != "2024-02-29" {
+		t.Errorf("Today = %+v", got)
+	}
+	if got := NowDateTime(); !got.Valid || !got.Time.Equal(fixed) {
+		t.Errorf("NowDateTime = %+v", got)
+	}
+	if got := DateStringFrom("2024-02-28").Humanize(); got != "yesterday" {
+		t.Errorf("Humanize = %q", got)
+	}
+	if got, _ := DateStringFrom("2000-02-29").AgeYears(); got != 24 {
+		t.Errorf("AgeYears = %d", got)
+	}
+
+	SetClock(nil)
+	if got := NowDateTime(); time.Since(got.Time) > time.Minute {
+		t.Errorf("SetClock(nil) should restore time.Now: %+v", got)
+	}
+}
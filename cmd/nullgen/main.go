@@ -0,0 +1,430 @@
+// Command nullgen generates nullable wrappers for named types, with the same
+// SQL, JSON and text method set as the types in the null package.
+//
+// Given a type such as
+//
+//	type OrderStatus string
+//
+// add a directive to the file that declares it and run go generate:
+//
+//	//go:generate go run github.com/attapon-th/null/cmd/nullgen -type=OrderStatus
+//
+// This writes orderstatus_null.go declaring NullOrderStatus. Types whose underlying type
+// is a string, bool, integer or float type are supported.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("nullgen: ")
+
+	typeNames := flag.String("type", "", "comma-separated list of type names; required")
+	output := flag.String("output", "", "output file name; default <type>_null.go")
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if args := flag.Args(); len(args) > 0 {
+		dir = args[0]
+	}
+	pkg, types, err := parseDir(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var infos []typeInfo
+	for _, name := range strings.Split(*typeNames, ",") {
+		underlying, ok := types[name]
+		if !ok {
+			log.Fatalf("type %s not found in %s", name, dir)
+		}
+		info, err := newTypeInfo(name, underlying)
+		if err != nil {
+			log.Fatal(err)
+		}
+		infos = append(infos, info)
+	}
+
+	if *output != "" {
+		writeFile(filepath.Join(dir, *output), pkg, infos)
+		return
+	}
+	for _, info := range infos {
+		writeFile(filepath.Join(dir, strings.ToLower(info.Name)+"_null.go"), pkg, []typeInfo{info})
+	}
+}
+
+func writeFile(path, pkg string, infos []typeInfo) {
+	formatted, err := generate(pkg, infos)
+	if err != nil {
+		log.Fatalf("generating %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseDir returns the package name and the underlying type name of every named type
+// declared in dir whose underlying type is a predeclared type.
+func parseDir(dir string) (string, map[string]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", nil, err
+	}
+	fset := token.NewFileSet()
+	pkg := ""
+	types := make(map[string]string)
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", nil, err
+		}
+		pkg = file.Name.Name
+		ast.Inspect(file, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok || spec.Assign.IsValid() {
+				return true
+			}
+			if ident, ok := spec.Type.(*ast.Ident); ok {
+				types[spec.Name.Name] = ident.Name
+			}
+			return false
+		})
+	}
+	if pkg == "" {
+		return "", nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return pkg, types, nil
+}
+
+// typeInfo is the template input for one generated type.
+type typeInfo struct {
+	Package  string
+	Name     string
+	Kind     string // string, bool, int, uint or float
+	Bits     int
+	NullType string // database/sql type used by Scan
+	NullData string // field of NullType holding the value
+	Driver   string // driver.Value type returned by Value
+}
+
+func newTypeInfo(name, underlying string) (typeInfo, error) {
+	info := typeInfo{Name: name}
+	switch underlying {
+	case "string":
+		info.Kind, info.NullType, info.NullData, info.Driver = "string", "NullString", "String", "string"
+	case "bool":
+		info.Kind, info.NullType, info.NullData, info.Driver = "bool", "NullBool", "Bool", "bool"
+	case "int", "int8", "int16", "int32", "int64":
+		info.Kind, info.NullType, info.NullData, info.Driver = "int", "NullInt64", "Int64", "int64"
+	case "uint", "uint8", "uint16", "uint32", "uint64", "byte":
+		info.Kind, info.NullType, info.NullData, info.Driver = "uint", "NullInt64", "Int64", "int64"
+	case "float32", "float64":
+		info.Kind, info.NullType, info.NullData, info.Driver = "float", "NullFloat64", "Float64", "float64"
+	default:
+		return info, fmt.Errorf("type %s: unsupported underlying type %s", name, underlying)
+	}
+	switch underlying {
+	case "int", "uint":
+		info.Bits = 0 // platform size
+	case "int8", "uint8", "byte":
+		info.Bits = 8
+	case "int16", "uint16":
+		info.Bits = 16
+	case "int32", "uint32", "float32":
+		info.Bits = 32
+	default:
+		info.Bits = 64
+	}
+	return info, nil
+}
+
+// generate returns the formatted source of a file declaring the nullable wrappers of infos.
+func generate(pkg string, infos []typeInfo) ([]byte, error) {
+	var buf bytes.Buffer
+	data := struct {
+		Package     string
+		NeedStrconv bool
+	}{Package: pkg}
+	for _, info := range infos {
+		if info.Kind != "string" {
+			data.NeedStrconv = true
+		}
+	}
+	if err := header.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		info.Package = pkg
+		if err := tmpl.Execute(&buf, info); err != nil {
+			return nil, err
+		}
+	}
+	return format.Source(buf.Bytes())
+}
+
+var header = template.Must(template.New("header").Parse(`// Code generated by nullgen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+{{- if .NeedStrconv}}
+	"strconv"
+{{- end}}
+
+	"github.com/attapon-th/null"
+)
+`))
+
+var tmpl = template.Must(template.New("type").Parse(`
+// Null{{.Name}} is a nullable {{.Name}}. It supports SQL and JSON serialization.
+// It will marshal to null if null.
+type Null{{.Name}} struct {
+	{{.Name}} {{.Name}}
+	Valid bool
+}
+
+// NewNull{{.Name}} creates a new Null{{.Name}}.
+func NewNull{{.Name}}(v {{.Name}}, valid bool) Null{{.Name}} {
+	return Null{{.Name}}{
+		{{.Name}}: v,
+		Valid: valid,
+	}
+}
+
+// Null{{.Name}}From creates a new Null{{.Name}} that will always be valid.
+func Null{{.Name}}From(v {{.Name}}) Null{{.Name}} {
+	return NewNull{{.Name}}(v, true)
+}
+
+// Null{{.Name}}FromPtr creates a new Null{{.Name}} that will be null if v is nil.
+func Null{{.Name}}FromPtr(v *{{.Name}}) Null{{.Name}} {
+	if v == nil {
+		return NewNull{{.Name}}({{if eq .Kind "string"}}""{{else if eq .Kind "bool"}}false{{else}}0{{end}}, false)
+	}
+	return NewNull{{.Name}}(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n Null{{.Name}}) ValueOrZero() {{.Name}} {
+	if !n.Valid {
+		var zero {{.Name}}
+		return zero
+	}
+	return n.{{.Name}}
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *Null{{.Name}}) Scan(value any) error {
+	var v sql.{{.NullType}}
+	if err := v.Scan(value); err != nil {
+		n.Valid = false
+		return fmt.Errorf("%w %T into {{.Package}}.Null{{.Name}}: %w", null.ErrScanType, value, err)
+	}
+	n.{{.Name}}, n.Valid = {{.Name}}(v.{{.NullData}}), v.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n Null{{.Name}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return {{.Driver}}(n.{{.Name}}), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+{{- if or (eq .Kind "int") (eq .Kind "uint") (eq .Kind "float")}}
+// It supports number, string, and null input.
+{{- else}}
+// It supports {{.Kind}} and null input.
+{{- end}}
+func (n *Null{{.Name}}) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		n.Valid = false
+		return nil
+	}
+{{- if or (eq .Kind "int") (eq .Kind "uint") (eq .Kind "float")}}
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+		}
+		if err := n.parse(str); err != nil {
+			return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+		}
+		return nil
+	}
+{{- end}}
+	if err := json.Unmarshal(data, &n.{{.Name}}); err != nil {
+		return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Null{{.Name}} if the input is blank{{if ne .Kind "string"}} or "null"{{end}}.
+func (n *Null{{.Name}}) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == ""{{if ne .Kind "string"}} || str == "null"{{end}} {
+		n.Valid = false
+		return nil
+	}
+	return n.parse(str)
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (n *Null{{.Name}}) UnmarshalParam(param string) error {
+	return n.UnmarshalText([]byte(param))
+}
+
+func (n *Null{{.Name}}) parse(str string) error {
+{{- if eq .Kind "string"}}
+	n.{{.Name}}, n.Valid = {{.Name}}(str), true
+	return nil
+{{- else}}
+{{- if eq .Kind "bool"}}
+	v, err := strconv.ParseBool(str)
+{{- else if eq .Kind "int"}}
+	v, err := strconv.ParseInt(str, 10, {{.Bits}})
+{{- else if eq .Kind "uint"}}
+	v, err := strconv.ParseUint(str, 10, {{.Bits}})
+{{- else}}
+	v, err := strconv.ParseFloat(str, {{.Bits}})
+{{- end}}
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("{{.Package}}: couldn't unmarshal text: %w", err)
+	}
+	n.{{.Name}}, n.Valid = {{.Name}}(v), true
+	return nil
+{{- end}}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Null{{.Name}} is null.
+func (n Null{{.Name}}) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.{{.Name}})
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Null{{.Name}} is null.
+func (n Null{{.Name}}) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (n Null{{.Name}}) AppendText(buf []byte) ([]byte, error) {
+	if !n.Valid {
+		return buf, nil
+	}
+{{- if eq .Kind "string"}}
+	return append(buf, n.{{.Name}}...), nil
+{{- else if eq .Kind "bool"}}
+	return strconv.AppendBool(buf, bool(n.{{.Name}})), nil
+{{- else if eq .Kind "int"}}
+	return strconv.AppendInt(buf, int64(n.{{.Name}}), 10), nil
+{{- else if eq .Kind "uint"}}
+	return strconv.AppendUint(buf, uint64(n.{{.Name}}), 10), nil
+{{- else}}
+	return strconv.AppendFloat(buf, float64(n.{{.Name}}), 'f', -1, {{.Bits}}), nil
+{{- end}}
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this value to v under key, or nothing if null.
+func (n Null{{.Name}}) EncodeValues(key string, v *url.Values) error {
+	if !n.Valid {
+		return nil
+	}
+	text, err := n.MarshalText()
+	if err != nil {
+		return err
+	}
+	v.Add(key, string(text))
+	return nil
+}
+
+// SetValid changes this Null{{.Name}}'s value and also sets it to be non-null.
+func (n *Null{{.Name}}) SetValid(v {{.Name}}) {
+	n.{{.Name}} = v
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this Null{{.Name}}'s value, or a nil pointer if this Null{{.Name}} is null.
+func (n Null{{.Name}}) Ptr() *{{.Name}} {
+	if !n.Valid {
+		return nil
+	}
+	return &n.{{.Name}}
+}
+
+// IsZero returns true for null values, for potential future omitempty support.
+func (n Null{{.Name}}) IsZero() bool {
+	return !n.Valid
+}
+
+// IsNull returns true if this Null{{.Name}} is null.
+func (n Null{{.Name}}) IsNull() bool {
+	return !n.Valid
+}
+
+// SetNull sets this Null{{.Name}} to null and clears its value.
+func (n *Null{{.Name}}) SetNull() {
+	var zero {{.Name}}
+	n.{{.Name}}, n.Valid = zero, false
+}
+
+// Reset sets this Null{{.Name}} to its zero value, which is null.
+func (n *Null{{.Name}}) Reset() {
+	*n = Null{{.Name}}{}
+}
+
+// Equal returns true if both values are the same or are both null.
+func (n Null{{.Name}}) Equal(other Null{{.Name}}) bool {
+	return n.Valid == other.Valid && (!n.Valid || n.{{.Name}} == other.{{.Name}})
+}
+
+// Merge returns this value if it is valid, otherwise other.
+func (n Null{{.Name}}) Merge(other Null{{.Name}}) Null{{.Name}} {
+	if n.Valid {
+		return n
+	}
+	return other
+}
+`))
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/cmd/nullgen/testdata/orders"
+)
+
+func TestGenerateGolden(t *testing.T) {
+	dir := filepath.Join("testdata", "orders")
+	pkg, types, err := parseDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var infos []typeInfo
+	for _, name := range []string{"OrderStatus", "Priority", "Weight", "Paid", "Quantity"} {
+		info, err := newTypeInfo(name, types[name])
+		if err != nil {
+			t.Fatal(err)
+		}
+		infos = append(infos, info)
+	}
+	got, err := generate(pkg, infos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(filepath.Join(dir, "orders_null.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Error("generated code differs from testdata/orders/orders_null.go; run go generate in testdata/orders")
+	}
+}
+
+func TestUnsupportedType(t *testing.T) {
+	if _, err := newTypeInfo("Blob", "complex128"); err == nil {
+		t.Error("expected error for unsupported underlying type")
+	}
+}
+
+func TestGenerated(t *testing.T) {
+	var s orders.NullOrderStatus
+	if err := json.Unmarshal([]byte(`"shipped"`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if !s.Valid || s.OrderStatus != "shipped" {
+		t.Errorf("bad status: %+v", s)
+	}
+	if err := s.UnmarshalText([]byte("")); err != nil || s.Valid {
+		t.Errorf("blank text should be null: %+v %v", s, err)
+	}
+
+	var p orders.NullPriority
+	if err := json.Unmarshal([]byte(`"12"`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Valid || p.Priority != 12 {
+		t.Errorf("bad priority: %+v", p)
+	}
+	if err := p.UnmarshalText([]byte("300")); err == nil {
+		t.Error("expected out of range error for int8")
+	}
+	if err := json.Unmarshal([]byte(`true`), &p); !errors.Is(err, null.ErrInvalidJSON) {
+		t.Errorf("expected ErrInvalidJSON, got %v", err)
+	}
+
+	var q orders.NullQuantity
+	if err := q.Scan(int64(7)); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := q.Value(); v != int64(7) {
+		t.Errorf("bad value: %v", v)
+	}
+	if err := q.Scan([]int{1}); !errors.Is(err, null.ErrScanType) {
+		t.Errorf("expected ErrScanType, got %v", err)
+	}
+
+	data, err := json.Marshal(struct {
+		W orders.NullWeight
+		P orders.NullPaid
+	}{W: orders.NullWeightFrom(1.5)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"W":1.5,"P":null}` {
+		t.Errorf("bad JSON: %s", data)
+	}
+	text, _ := orders.NullPaidFrom(true).MarshalText()
+	if string(text) != "true" {
+		t.Errorf("bad text: %s", text)
+	}
+}
@@ -0,0 +1,19 @@
+// Package orders is used to test nullgen.
+package orders
+
+//go:generate go run github.com/attapon-th/null/cmd/nullgen -type=OrderStatus,Priority,Weight,Paid,Quantity -output=orders_null.go
+
+// OrderStatus is the state of an order.
+type OrderStatus string
+
+// Priority is the urgency of an order.
+type Priority int8
+
+// Weight is the weight of an order in kilograms.
+type Weight float32
+
+// Paid is whether an order is paid for.
+type Paid bool
+
+// Quantity is the number of items in an order.
+type Quantity uint
@@ -0,0 +1,970 @@
+// Code generated by nullgen; DO NOT EDIT.
+
+package orders
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/attapon-th/null"
+)
+
+// NullOrderStatus is a nullable OrderStatus. It supports SQL and JSON serialization.
+// It will marshal to null if null.
+type NullOrderStatus struct {
+	OrderStatus OrderStatus
+	Valid       bool
+}
+
+// NewNullOrderStatus creates a new NullOrderStatus.
+func NewNullOrderStatus(v OrderStatus, valid bool) NullOrderStatus {
+	return NullOrderStatus{
+		OrderStatus: v,
+		Valid:       valid,
+	}
+}
+
+// NullOrderStatusFrom creates a new NullOrderStatus that will always be valid.
+func NullOrderStatusFrom(v OrderStatus) NullOrderStatus {
+	return NewNullOrderStatus(v, true)
+}
+
+// NullOrderStatusFromPtr creates a new NullOrderStatus that will be null if v is nil.
+func NullOrderStatusFromPtr(v *OrderStatus) NullOrderStatus {
+	if v == nil {
+		return NewNullOrderStatus("", false)
+	}
+	return NewNullOrderStatus(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n NullOrderStatus) ValueOrZero() OrderStatus {
+	if !n.Valid {
+		var zero OrderStatus
+		return zero
+	}
+	return n.OrderStatus
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullOrderStatus) Scan(value any) error {
+	var v sql.NullString
+	if err := v.Scan(value); err != nil {
+		n.Valid = false
+		return fmt.Errorf("%w %T into orders.NullOrderStatus: %w", null.ErrScanType, value, err)
+	}
+	n.OrderStatus, n.Valid = OrderStatus(v.String), v.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullOrderStatus) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return string(n.OrderStatus), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (n *NullOrderStatus) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		n.Valid = false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.OrderStatus); err != nil {
+		return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null NullOrderStatus if the input is blank.
+func (n *NullOrderStatus) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" {
+		n.Valid = false
+		return nil
+	}
+	return n.parse(str)
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (n *NullOrderStatus) UnmarshalParam(param string) error {
+	return n.UnmarshalText([]byte(param))
+}
+
+func (n *NullOrderStatus) parse(str string) error {
+	n.OrderStatus, n.Valid = OrderStatus(str), true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullOrderStatus is null.
+func (n NullOrderStatus) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.OrderStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this NullOrderStatus is null.
+func (n NullOrderStatus) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (n NullOrderStatus) AppendText(buf []byte) ([]byte, error) {
+	if !n.Valid {
+		return buf, nil
+	}
+	return append(buf, n.OrderStatus...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this value to v under key, or nothing if null.
+func (n NullOrderStatus) EncodeValues(key string, v *url.Values) error {
+	if !n.Valid {
+		return nil
+	}
+	text, err := n.MarshalText()
+	if err != nil {
+		return err
+	}
+	v.Add(key, string(text))
+	return nil
+}
+
+// SetValid changes this NullOrderStatus's value and also sets it to be non-null.
+func (n *NullOrderStatus) SetValid(v OrderStatus) {
+	n.OrderStatus = v
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this NullOrderStatus's value, or a nil pointer if this NullOrderStatus is null.
+func (n NullOrderStatus) Ptr() *OrderStatus {
+	if !n.Valid {
+		return nil
+	}
+	return &n.OrderStatus
+}
+
+// IsZero returns true for null values, for potential future omitempty support.
+func (n NullOrderStatus) IsZero() bool {
+	return !n.Valid
+}
+
+// IsNull returns true if this NullOrderStatus is null.
+func (n NullOrderStatus) IsNull() bool {
+	return !n.Valid
+}
+
+// SetNull sets this NullOrderStatus to null and clears its value.
+func (n *NullOrderStatus) SetNull() {
+	var zero OrderStatus
+	n.OrderStatus, n.Valid = zero, false
+}
+
+// Reset sets this NullOrderStatus to its zero value, which is null.
+func (n *NullOrderStatus) Reset() {
+	*n = NullOrderStatus{}
+}
+
+// Equal returns true if both values are the same or are both null.
+func (n NullOrderStatus) Equal(other NullOrderStatus) bool {
+	return n.Valid == other.Valid && (!n.Valid || n.OrderStatus == other.OrderStatus)
+}
+
+// Merge returns this value if it is valid, otherwise other.
+func (n NullOrderStatus) Merge(other NullOrderStatus) NullOrderStatus {
+	if n.Valid {
+		return n
+	}
+	return other
+}
+
+// NullPriority is a nullable Priority. It supports SQL and JSON serialization.
+// It will marshal to null if null.
+type NullPriority struct {
+	Priority Priority
+	Valid    bool
+}
+
+// NewNullPriority creates a new NullPriority.
+func NewNullPriority(v Priority, valid bool) NullPriority {
+	return NullPriority{
+		Priority: v,
+		Valid:    valid,
+	}
+}
+
+// NullPriorityFrom creates a new NullPriority that will always be valid.
+func NullPriorityFrom(v Priority) NullPriority {
+	return NewNullPriority(v, true)
+}
+
+// NullPriorityFromPtr creates a new NullPriority that will be null if v is nil.
+func NullPriorityFromPtr(v *Priority) NullPriority {
+	if v == nil {
+		return NewNullPriority(0, false)
+	}
+	return NewNullPriority(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n NullPriority) ValueOrZero() Priority {
+	if !n.Valid {
+		var zero Priority
+		return zero
+	}
+	return n.Priority
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullPriority) Scan(value any) error {
+	var v sql.NullInt64
+	if err := v.Scan(value); err != nil {
+		n.Valid = false
+		return fmt.Errorf("%w %T into orders.NullPriority: %w", null.ErrScanType, value, err)
+	}
+	n.Priority, n.Valid = Priority(v.Int64), v.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullPriority) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return int64(n.Priority), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+func (n *NullPriority) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		n.Valid = false
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+		}
+		if err := n.parse(str); err != nil {
+			return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+		}
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Priority); err != nil {
+		return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null NullPriority if the input is blank or "null".
+func (n *NullPriority) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		n.Valid = false
+		return nil
+	}
+	return n.parse(str)
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (n *NullPriority) UnmarshalParam(param string) error {
+	return n.UnmarshalText([]byte(param))
+}
+
+func (n *NullPriority) parse(str string) error {
+	v, err := strconv.ParseInt(str, 10, 8)
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("orders: couldn't unmarshal text: %w", err)
+	}
+	n.Priority, n.Valid = Priority(v), true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullPriority is null.
+func (n NullPriority) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Priority)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this NullPriority is null.
+func (n NullPriority) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (n NullPriority) AppendText(buf []byte) ([]byte, error) {
+	if !n.Valid {
+		return buf, nil
+	}
+	return strconv.AppendInt(buf, int64(n.Priority), 10), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this value to v under key, or nothing if null.
+func (n NullPriority) EncodeValues(key string, v *url.Values) error {
+	if !n.Valid {
+		return nil
+	}
+	text, err := n.MarshalText()
+	if err != nil {
+		return err
+	}
+	v.Add(key, string(text))
+	return nil
+}
+
+// SetValid changes this NullPriority's value and also sets it to be non-null.
+func (n *NullPriority) SetValid(v Priority) {
+	n.Priority = v
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this NullPriority's value, or a nil pointer if this NullPriority is null.
+func (n NullPriority) Ptr() *Priority {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Priority
+}
+
+// IsZero returns true for null values, for potential future omitempty support.
+func (n NullPriority) IsZero() bool {
+	return !n.Valid
+}
+
+// IsNull returns true if this NullPriority is null.
+func (n NullPriority) IsNull() bool {
+	return !n.Valid
+}
+
+// SetNull sets this NullPriority to null and clears its value.
+func (n *NullPriority) SetNull() {
+	var zero Priority
+	n.Priority, n.Valid = zero, false
+}
+
+// Reset sets this NullPriority to its zero value, which is null.
+func (n *NullPriority) Reset() {
+	*n = NullPriority{}
+}
+
+// Equal returns true if both values are the same or are both null.
+func (n NullPriority) Equal(other NullPriority) bool {
+	return n.Valid == other.Valid && (!n.Valid || n.Priority == other.Priority)
+}
+
+// Merge returns this value if it is valid, otherwise other.
+func (n NullPriority) Merge(other NullPriority) NullPriority {
+	if n.Valid {
+		return n
+	}
+	return other
+}
+
+// NullWeight is a nullable Weight. It supports SQL and JSON serialization.
+// It will marshal to null if null.
+type NullWeight struct {
+	Weight Weight
+	Valid  bool
+}
+
+// NewNullWeight creates a new NullWeight.
+func NewNullWeight(v Weight, valid bool) NullWeight {
+	return NullWeight{
+		Weight: v,
+		Valid:  valid,
+	}
+}
+
+// NullWeightFrom creates a new NullWeight that will always be valid.
+func NullWeightFrom(v Weight) NullWeight {
+	return NewNullWeight(v, true)
+}
+
+// NullWeightFromPtr creates a new NullWeight that will be null if v is nil.
+func NullWeightFromPtr(v *Weight) NullWeight {
+	if v == nil {
+		return NewNullWeight(0, false)
+	}
+	return NewNullWeight(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n NullWeight) ValueOrZero() Weight {
+	if !n.Valid {
+		var zero Weight
+		return zero
+	}
+	return n.Weight
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullWeight) Scan(value any) error {
+	var v sql.NullFloat64
+	if err := v.Scan(value); err != nil {
+		n.Valid = false
+		return fmt.Errorf("%w %T into orders.NullWeight: %w", null.ErrScanType, value, err)
+	}
+	n.Weight, n.Valid = Weight(v.Float64), v.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullWeight) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return float64(n.Weight), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+func (n *NullWeight) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		n.Valid = false
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+		}
+		if err := n.parse(str); err != nil {
+			return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+		}
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Weight); err != nil {
+		return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null NullWeight if the input is blank or "null".
+func (n *NullWeight) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		n.Valid = false
+		return nil
+	}
+	return n.parse(str)
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (n *NullWeight) UnmarshalParam(param string) error {
+	return n.UnmarshalText([]byte(param))
+}
+
+func (n *NullWeight) parse(str string) error {
+	v, err := strconv.ParseFloat(str, 32)
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("orders: couldn't unmarshal text: %w", err)
+	}
+	n.Weight, n.Valid = Weight(v), true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullWeight is null.
+func (n NullWeight) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Weight)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this NullWeight is null.
+func (n NullWeight) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (n NullWeight) AppendText(buf []byte) ([]byte, error) {
+	if !n.Valid {
+		return buf, nil
+	}
+	return strconv.AppendFloat(buf, float64(n.Weight), 'f', -1, 32), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this value to v under key, or nothing if null.
+func (n NullWeight) EncodeValues(key string, v *url.Values) error {
+	if !n.Valid {
+		return nil
+	}
+	text, err := n.MarshalText()
+	if err != nil {
+		return err
+	}
+	v.Add(key, string(text))
+	return nil
+}
+
+// SetValid changes this NullWeight's value and also sets it to be non-null.
+func (n *NullWeight) SetValid(v Weight) {
+	n.Weight = v
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this NullWeight's value, or a nil pointer if this NullWeight is null.
+func (n NullWeight) Ptr() *Weight {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Weight
+}
+
+// IsZero returns true for null values, for potential future omitempty support.
+func (n NullWeight) IsZero() bool {
+	return !n.Valid
+}
+
+// IsNull returns true if this NullWeight is null.
+func (n NullWeight) IsNull() bool {
+	return !n.Valid
+}
+
+// SetNull sets this NullWeight to null and clears its value.
+func (n *NullWeight) SetNull() {
+	var zero Weight
+	n.Weight, n.Valid = zero, false
+}
+
+// Reset sets this NullWeight to its zero value, which is null.
+func (n *NullWeight) Reset() {
+	*n = NullWeight{}
+}
+
+// Equal returns true if both values are the same or are both null.
+func (n NullWeight) Equal(other NullWeight) bool {
+	return n.Valid == other.Valid && (!n.Valid || n.Weight == other.Weight)
+}
+
+// Merge returns this value if it is valid, otherwise other.
+func (n NullWeight) Merge(other NullWeight) NullWeight {
+	if n.Valid {
+		return n
+	}
+	return other
+}
+
+// NullPaid is a nullable Paid. It supports SQL and JSON serialization.
+// It will marshal to null if null.
+type NullPaid struct {
+	Paid  Paid
+	Valid bool
+}
+
+// NewNullPaid creates a new NullPaid.
+func NewNullPaid(v Paid, valid bool) NullPaid {
+	return NullPaid{
+		Paid:  v,
+		Valid: valid,
+	}
+}
+
+// NullPaidFrom creates a new NullPaid that will always be valid.
+func NullPaidFrom(v Paid) NullPaid {
+	return NewNullPaid(v, true)
+}
+
+// NullPaidFromPtr creates a new NullPaid that will be null if v is nil.
+func NullPaidFromPtr(v *Paid) NullPaid {
+	if v == nil {
+		return NewNullPaid(false, false)
+	}
+	return NewNullPaid(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n NullPaid) ValueOrZero() Paid {
+	if !n.Valid {
+		var zero Paid
+		return zero
+	}
+	return n.Paid
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullPaid) Scan(value any) error {
+	var v sql.NullBool
+	if err := v.Scan(value); err != nil {
+		n.Valid = false
+		return fmt.Errorf("%w %T into orders.NullPaid: %w", null.ErrScanType, value, err)
+	}
+	n.Paid, n.Valid = Paid(v.Bool), v.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullPaid) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return bool(n.Paid), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports bool and null input.
+func (n *NullPaid) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		n.Valid = false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Paid); err != nil {
+		return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null NullPaid if the input is blank or "null".
+func (n *NullPaid) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		n.Valid = false
+		return nil
+	}
+	return n.parse(str)
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (n *NullPaid) UnmarshalParam(param string) error {
+	return n.UnmarshalText([]byte(param))
+}
+
+func (n *NullPaid) parse(str string) error {
+	v, err := strconv.ParseBool(str)
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("orders: couldn't unmarshal text: %w", err)
+	}
+	n.Paid, n.Valid = Paid(v), true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullPaid is null.
+func (n NullPaid) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Paid)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this NullPaid is null.
+func (n NullPaid) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (n NullPaid) AppendText(buf []byte) ([]byte, error) {
+	if !n.Valid {
+		return buf, nil
+	}
+	return strconv.AppendBool(buf, bool(n.Paid)), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this value to v under key, or nothing if null.
+func (n NullPaid) EncodeValues(key string, v *url.Values) error {
+	if !n.Valid {
+		return nil
+	}
+	text, err := n.MarshalText()
+	if err != nil {
+		return err
+	}
+	v.Add(key, string(text))
+	return nil
+}
+
+// SetValid changes this NullPaid's value and also sets it to be non-null.
+func (n *NullPaid) SetValid(v Paid) {
+	n.Paid = v
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this NullPaid's value, or a nil pointer if this NullPaid is null.
+func (n NullPaid) Ptr() *Paid {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Paid
+}
+
+// IsZero returns true for null values, for potential future omitempty support.
+func (n NullPaid) IsZero() bool {
+	return !n.Valid
+}
+
+// IsNull returns true if this NullPaid is null.
+func (n NullPaid) IsNull() bool {
+	return !n.Valid
+}
+
+// SetNull sets this NullPaid to null and clears its value.
+func (n *NullPaid) SetNull() {
+	var zero Paid
+	n.Paid, n.Valid = zero, false
+}
+
+// Reset sets this NullPaid to its zero value, which is null.
+func (n *NullPaid) Reset() {
+	*n = NullPaid{}
+}
+
+// Equal returns true if both values are the same or are both null.
+func (n NullPaid) Equal(other NullPaid) bool {
+	return n.Valid == other.Valid && (!n.Valid || n.Paid == other.Paid)
+}
+
+// Merge returns this value if it is valid, otherwise other.
+func (n NullPaid) Merge(other NullPaid) NullPaid {
+	if n.Valid {
+		return n
+	}
+	return other
+}
+
+// NullQuantity is a nullable Quantity. It supports SQL and JSON serialization.
+// It will marshal to null if null.
+type NullQuantity struct {
+	Quantity Quantity
+	Valid    bool
+}
+
+// NewNullQuantity creates a new NullQuantity.
+func NewNullQuantity(v Quantity, valid bool) NullQuantity {
+	return NullQuantity{
+		Quantity: v,
+		Valid:    valid,
+	}
+}
+
+// NullQuantityFrom creates a new NullQuantity that will always be valid.
+func NullQuantityFrom(v Quantity) NullQuantity {
+	return NewNullQuantity(v, true)
+}
+
+// NullQuantityFromPtr creates a new NullQuantity that will be null if v is nil.
+func NullQuantityFromPtr(v *Quantity) NullQuantity {
+	if v == nil {
+		return NewNullQuantity(0, false)
+	}
+	return NewNullQuantity(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n NullQuantity) ValueOrZero() Quantity {
+	if !n.Valid {
+		var zero Quantity
+		return zero
+	}
+	return n.Quantity
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullQuantity) Scan(value any) error {
+	var v sql.NullInt64
+	if err := v.Scan(value); err != nil {
+		n.Valid = false
+		return fmt.Errorf("%w %T into orders.NullQuantity: %w", null.ErrScanType, value, err)
+	}
+	n.Quantity, n.Valid = Quantity(v.Int64), v.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullQuantity) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return int64(n.Quantity), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+func (n *NullQuantity) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		n.Valid = false
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+		}
+		if err := n.parse(str); err != nil {
+			return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+		}
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Quantity); err != nil {
+		return fmt.Errorf("%w: %w", null.ErrInvalidJSON, err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null NullQuantity if the input is blank or "null".
+func (n *NullQuantity) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		n.Valid = false
+		return nil
+	}
+	return n.parse(str)
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (n *NullQuantity) UnmarshalParam(param string) error {
+	return n.UnmarshalText([]byte(param))
+}
+
+func (n *NullQuantity) parse(str string) error {
+	v, err := strconv.ParseUint(str, 10, 0)
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("orders: couldn't unmarshal text: %w", err)
+	}
+	n.Quantity, n.Valid = Quantity(v), true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullQuantity is null.
+func (n NullQuantity) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Quantity)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this NullQuantity is null.
+func (n NullQuantity) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (n NullQuantity) AppendText(buf []byte) ([]byte, error) {
+	if !n.Valid {
+		return buf, nil
+	}
+	return strconv.AppendUint(buf, uint64(n.Quantity), 10), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this value to v under key, or nothing if null.
+func (n NullQuantity) EncodeValues(key string, v *url.Values) error {
+	if !n.Valid {
+		return nil
+	}
+	text, err := n.MarshalText()
+	if err != nil {
+		return err
+	}
+	v.Add(key, string(text))
+	return nil
+}
+
+// SetValid changes this NullQuantity's value and also sets it to be non-null.
+func (n *NullQuantity) SetValid(v Quantity) {
+	n.Quantity = v
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this NullQuantity's value, or a nil pointer if this NullQuantity is null.
+func (n NullQuantity) Ptr() *Quantity {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Quantity
+}
+
+// IsZero returns true for null values, for potential future omitempty support.
+func (n NullQuantity) IsZero() bool {
+	return !n.Valid
+}
+
+// IsNull returns true if this NullQuantity is null.
+func (n NullQuantity) IsNull() bool {
+	return !n.Valid
+}
+
+// SetNull sets this NullQuantity to null and clears its value.
+func (n *NullQuantity) SetNull() {
+	var zero Quantity
+	n.Quantity, n.Valid = zero, false
+}
+
+// Reset sets this NullQuantity to its zero value, which is null.
+func (n *NullQuantity) Reset() {
+	*n = NullQuantity{}
+}
+
+// Equal returns true if both values are the same or are both null.
+func (n NullQuantity) Equal(other NullQuantity) bool {
+	return n.Valid == other.Valid && (!n.Valid || n.Quantity == other.Quantity)
+}
+
+// Merge returns this value if it is valid, otherwise other.
+func (n NullQuantity) Merge(other NullQuantity) NullQuantity {
+	if n.Valid {
+		return n
+	}
+	return other
+}
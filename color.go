@@ -0,0 +1,278 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Color is a nullable RGB color. It accepts "#RRGGBB", "#RGB" and "rgb(R, G, B)" input,
+// and is stored as lower-case "#rrggbb".
+// It will marshal to null if null.
+type Color struct {
+	sql.NullString
+}
+
+// NewColor creates a new Color.
+// The value is stored as-is and is not validated.
+func NewColor(s string, valid bool) Color {
+	return Color{
+		NullString: sql.NullString{
+			String: s,
+			Valid:  valid,
+		},
+	}
+}
+
+// ColorFrom creates a new Color from s in its canonical form.
+// It will be null if s is not a valid color.
+func ColorFrom(s string) Color {
+	canonical, err := parseColor(s)
+	if err != nil {
+		coerced("Color", "From", s, err)
+		return NewColor("", false)
+	}
+	return NewColor(canonical, true)
+}
+
+// ColorFromPtr creates a new Color that will be null if s is nil
+// or not a valid color.
+func ColorFromPtr(s *string) Color {
+	if s == nil {
+		return NewColor("", false)
+	}
+	return ColorFrom(*s)
+}
+
+// ColorFromRGBA creates a new Color from the red, green and blue components of rgba,
+// ignoring its alpha.
+func ColorFromRGBA(rgba color.RGBA) Color {
+	return NewColor(fmt.Sprintf("#%02x%02x%02x", rgba.R, rgba.G, rgba.B), true)
+}
+
+var errInvalidColor = errors.New("not #RRGGBB, #RGB or rgb(R, G, B)")
+
+func parseColor(s string) (string, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if rest, ok := strings.CutPrefix(s, "#"); ok {
+		if len(rest) == 3 {
+			rest = string([]byte{rest[0], rest[0], rest[1], rest[1], rest[2], rest[2]})
+		}
+		if len(rest) != 6 {
+			return "", errInvalidColor
+		}
+		if _, err := strconv.ParseUint(rest, 16, 32); err != nil {
+			return "", errInvalidColor
+		}
+		return "#" + rest, nil
+	}
+	inner, ok := strings.CutPrefix(s, "rgb(")
+	if !ok || !strings.HasSuffix(inner, ")") {
+		return "", errInvalidColor
+	}
+	parts := strings.Split(strings.TrimSuffix(inner, ")"), ",")
+	if len(parts) != 3 {
+		return "", errInvalidColor
+	}
+	var rgb [3]uint64
+	for i, p := range parts {
+		n, err := strconv.ParseUint(strings.TrimSpace(p), 10, 8)
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", errInvalidColor, err)
+		}
+		rgb[i] = n
+	}
+	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2]), nil
+}
+
+// RGBA returns the components of this Color, with an alpha of 255,
+// and false if it is null or not a valid color.
+func (c Color) RGBA() (color.RGBA, bool) {
+	if !c.Valid {
+		return color.RGBA{}, false
+	}
+	canonical, err := parseColor(c.String)
+	if err != nil {
+		return color.RGBA{}, false
+	}
+	n, _ := strconv.ParseUint(canonical[1:], 16, 32)
+	return color.RGBA{R: uint8(n >> 16), G: uint8(n >> 8), B: uint8(n), A: 255}, true
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (c Color) ValueOrZero() string {
+	if !c.Valid {
+		return ""
+	}
+	return c.String
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if the column holds a malformed color.
+func (c *Color) Scan(value any) error {
+	if err := c.NullString.Scan(value); err != nil {
+		c.Valid = false
+		return newScanError("null.Color", value, err)
+	}
+	if !c.Valid {
+		return nil
+	}
+	canonical, err := parseColor(c.String)
+	if err != nil {
+		c.Valid = false
+		return newScanError("null.Color", value, err)
+	}
+	c.String = canonical
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input. Blank string input produces a null Color.
+// It returns an error if the input is not a valid color.
+func (c *Color) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "String")
+	data = trimJSON("Color", data)
+	if bytes.Equal(data, nullBytes) {
+		c.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	return unmarshalError(ErrInvalidJSON, c.set(str))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Color if the input is blank or "null".
+// It returns an error if the input is not a valid color.
+func (c *Color) UnmarshalText(text []byte) error {
+	text = prepareText("Color", text)
+	str := string(text)
+	if str == "null" {
+		str = ""
+	}
+	return unmarshalError(ErrInvalidText, c.set(str))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (c *Color) UnmarshalParam(param string) error {
+	return c.UnmarshalText([]byte(param))
+}
+
+func (c *Color) set(str string) error {
+	if str == "" {
+		c.String = ""
+		c.Valid = false
+		return nil
+	}
+	canonical, err := parseColor(str)
+	if err != nil {
+		c.Valid = false
+		return fmt.Errorf("invalid color %q: %w", str, err)
+	}
+	c.String = canonical
+	c.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Color is null.
+func (c Color) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Color", c.Valid, c.marshalJSON)
+}
+
+func (c Color) marshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(c.String)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Color is null.
+func (c Color) MarshalText() ([]byte, error) {
+	if !c.Valid {
+		return []byte{}, nil
+	}
+	return []byte(c.String), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (c Color) AppendText(buf []byte) ([]byte, error) {
+	if !c.Valid {
+		return buf, nil
+	}
+	return append(buf, c.String...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Color to v under key, or nothing if null.
+func (c Color) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, c.Valid, c.MarshalText)
+}
+
+// SetValid changes this Color's value and also sets it to be non-null.
+// The value is stored as-is and is not validated.
+func (c *Color) SetValid(v string) {
+	c.String = v
+	c.Valid = true
+}
+
+// Ptr returns a pointer to this Color's value, or a nil pointer if this Color is null.
+func (c Color) Ptr() *string {
+	if !c.Valid {
+		return nil
+	}
+	return &c.String
+}
+
+// IsZero returns true for null colors, for potential future omitempty support.
+func (c Color) IsZero() bool {
+	return !c.Valid
+}
+
+// IsNull returns true if this Color is null.
+func (c Color) IsNull() bool {
+	return !c.Valid
+}
+
+// SetNull sets this Color to null and clears its value.
+func (c *Color) SetNull() {
+	c.String, c.Valid = "", false
+}
+
+// Reset sets this Color to its zero value, which is null.
+func (c *Color) Reset() {
+	*c = Color{}
+}
+
+// Equal returns true if both colors have the same value or are both null.
+func (c Color) Equal(other Color) bool {
+	return c.Valid == other.Valid && (!c.Valid || c.String == other.String)
+}
+
+// Merge returns this Color if it is valid, otherwise other.
+func (c Color) Merge(other Color) Color {
+	if c.Valid {
+		return c
+	}
+	return other
+}
+
+// When returns this Color if cond is true, otherwise a null Color.
+func (c Color) When(cond bool) Color {
+	if !cond {
+		c.SetNull()
+	}
+	return c
+}
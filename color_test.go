@@ -0,0 +1,76 @@
+package null
+
+import (
+	"encoding/json"
+	"image/color"
+	"testing"
+)
+
+func TestColorFrom(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"#FF8800", "#ff8800"},
+		{"#f80", "#ff8800"},
+		{" rgb(255, 136, 0) ", "#ff8800"},
+		{"RGB(0,0,0)", "#000000"},
+	}
+	for _, tc := range tests {
+		c := ColorFrom(tc.in)
+		if !c.Valid || c.String != tc.want {
+			t.Errorf("ColorFrom(%q) = %+v, want %s", tc.in, c, tc.want)
+		}
+	}
+	for _, in := range []string{"", "ff8800", "#ff880", "#gggggg", "rgb(256, 0, 0)", "rgb(1, 2)", "red"} {
+		if c := ColorFrom(in); c.Valid {
+			t.Errorf("ColorFrom(%q): expected null, got %+v", in, c)
+		}
+	}
+	if c := ColorFromPtr(nil); c.Valid {
+		t.Errorf("ColorFromPtr(nil) = %+v", c)
+	}
+}
+
+func TestColorRGBA(t *testing.T) {
+	rgba, ok := ColorFrom("#1a2b3c").RGBA()
+	if want := (color.RGBA{R: 0x1a, G: 0x2b, B: 0x3c, A: 255}); !ok || rgba != want {
+		t.Errorf("RGBA = %v, %v; want %v", rgba, ok, want)
+	}
+	if c := ColorFromRGBA(rgba); c.String != "#1a2b3c" || !c.Valid {
+		t.Errorf("ColorFromRGBA = %+v", c)
+	}
+	if _, ok := NewColor("", false).RGBA(); ok {
+		t.Error("null Color should have no components")
+	}
+}
+
+func TestColorUnmarshal(t *testing.T) {
+	var c Color
+	err := json.Unmarshal([]byte(`"#ABC"`), &c)
+	maybePanic(err)
+	if c.String != "#aabbcc" || !c.Valid {
+		t.Errorf("UnmarshalJSON = %+v", c)
+	}
+	data, err := json.Marshal(c)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"#aabbcc"`, "Color")
+
+	if err := json.Unmarshal([]byte(`"purple"`), &c); err == nil || c.Valid {
+		t.Errorf("invalid color: got %v %+v", err, c)
+	}
+	err = c.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if c.Valid {
+		t.Errorf("blank text: %+v", c)
+	}
+
+	err = c.Scan("rgb(1, 2, 3)")
+	maybePanic(err)
+	if c.String != "#010203" {
+		t.Errorf("Scan = %+v", c)
+	}
+	if err := c.Scan("nope"); err == nil || c.Valid {
+		t.Errorf("Scan invalid: got %v %+v", err, c)
+	}
+}
@@ -0,0 +1,41 @@
+package null
+
+// Combine2 derives a nullable value from the values of a and b with f,
+// such as an end date from a start date and a duration.
+// If a or b is null, f is not called and the zero value of R is returned,
+// which is null for the types in this package.
+func Combine2[A, B, R any, NA Nullable[A], NB Nullable[B]](a NA, b NB, f func(A, B) R) R {
+	if a.IsZero() || b.IsZero() {
+		var r R
+		return r
+	}
+	return f(a.ValueOrZero(), b.ValueOrZero())
+}
+
+// Combine3 is like Combine2 for three values.
+func Combine3[A, B, C, R any, NA Nullable[A], NB Nullable[B], NC Nullable[C]](a NA, b NB, c NC, f func(A, B, C) R) R {
+	if a.IsZero() || b.IsZero() || c.IsZero() {
+		var r R
+		return r
+	}
+	return f(a.ValueOrZero(), b.ValueOrZero(), c.ValueOrZero())
+}
+
+// Combine4 is like Combine2 for four values.
+func Combine4[A, B, C, D, R any, NA Nullable[A], NB Nullable[B], NC Nullable[C], ND Nullable[D]](a NA, b NB, c NC, d ND, f func(A, B, C, D) R) R {
+	if a.IsZero() || b.IsZero() || c.IsZero() || d.IsZero() {
+		var r R
+		return r
+	}
+	return f(a.ValueOrZero(), b.ValueOrZero(), c.ValueOrZero(), d.ValueOrZero())
+}
+
+// If returns value if cond is true, otherwise a null value of its type, like value.When(cond).
+// It is handy for building structs of optional filters:
+//
+//	filter := Filter{
+//		Status: null.If(onlyActive, null.StringFrom("active")),
+//	}
+func If[N interface{ When(bool) N }](cond bool, value N) N {
+	return value.When(cond)
+}
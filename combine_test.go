@@ -0,0 +1,74 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCombine2(t *testing.T) {
+	addDays := func(start time.Time, days int64) Time {
+		return TimeFrom(start.AddDate(0, 0, int(days)))
+	}
+	start := TimeFrom(time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC))
+
+	end := Combine2(start, IntFrom(3), addDays)
+	if want := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC); !end.Valid || !end.Time.Equal(want) {
+		t.Errorf("Combine2 = %v, want %v", end, want)
+	}
+	if end := Combine2(start, NewInt(3, false), addDays); end.Valid {
+		t.Errorf("Combine2 with null input = %v, want null", end)
+	}
+	if end := Combine2(Time{}, IntFrom(3), addDays); end.Valid {
+		t.Errorf("Combine2 with null input = %v, want null", end)
+	}
+}
+
+func TestCombine3And4(t *testing.T) {
+	sum3 := func(a, b, c int64) Int { return IntFrom(a + b + c) }
+	if got := Combine3(IntFrom(1), IntFrom(2), IntFrom(3), sum3); got != IntFrom(6) {
+		t.Errorf("Combine3 = %v, want 6", got)
+	}
+	if got := Combine3(IntFrom(1), Int{}, IntFrom(3), sum3); got.Valid {
+		t.Errorf("Combine3 with null input = %v, want null", got)
+	}
+
+	label := func(name string, qty int64, price float64, paid bool) String {
+		if !paid {
+			return StringFrom(name + " (unpaid)")
+		}
+		return StringFrom(name)
+	}
+	if got := Combine4(StringFrom("pen"), IntFrom(2), FloatFrom(1.5), BoolFrom(false), label); got.String != "pen (unpaid)" {
+		t.Errorf("Combine4 = %v", got)
+	}
+	if got := Combine4(StringFrom("pen"), IntFrom(2), FloatFrom(1.5), Bool{}, label); got.Valid {
+		t.Errorf("Combine4 with null input = %v, want null", got)
+	}
+}
+
+func TestIfWhen(t *testing.T) {
+	if got := If(true, StringFrom("active")); got != StringFrom("active") {
+		t.Errorf("If(true) = %v", got)
+	}
+	if got := If(false, StringFrom("active")); got.Valid || got.String != "" {
+		t.Errorf("If(false) = %v, want null", got)
+	}
+	if got := IntFrom(3).When(false); got.Valid {
+		t.Errorf("When(false) = %v, want null", got)
+	}
+	if got := DateStringFrom("2024-01-02").When(true); got.String != "2024-01-02" {
+		t.Errorf("When(true) = %v", got)
+	}
+
+	// When keeps the allowed values of an Enum
+	e := NewEnum("a", "b").With("a").When(false)
+	if e.Valid {
+		t.Errorf("Enum.When(false) = %v, want null", e)
+	}
+	if err := e.Set("b"); err != nil {
+		t.Errorf("Enum.When should keep allowed values: %v", err)
+	}
+	if b := BoundedIntFrom[testRating](4).When(false); b.Valid {
+		t.Errorf("BoundedInt.When(false) = %v, want null", b)
+	}
+}
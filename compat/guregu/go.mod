@@ -0,0 +1,18 @@
+module github.com/attapon-th/null/compat/guregu
+
+go 1.24
+
+require (
+	github.com/attapon-th/null v0.0.0-00010101000000-000000000000
+	github.com/guregu/null/v5 v5.0.0
+)
+
+require (
+	github.com/josharian/intern v1.0.0 // indirect
+	github.com/mailru/easyjson v0.7.7 // indirect
+	github.com/philhofer/fwd v1.1.3-0.20240916144458-20a13a1f6b7c // indirect
+	github.com/tinylib/msgp v1.2.5 // indirect
+	golang.org/x/text v0.19.0 // indirect
+)
+
+replace github.com/attapon-th/null => ../..
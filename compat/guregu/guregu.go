@@ -0,0 +1,206 @@
+// Package guregu converts between the types in this module and those of
+// github.com/guregu/null/v5, so code can move from one to the other a package at a time.
+// Every conversion keeps both the value and its validity. Conversions to narrower types,
+// such as IntToGureguInt32, return an error instead if a valid value does not fit.
+package guregu
+
+import (
+	"math"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/zero"
+	gnull "github.com/guregu/null/v5"
+	gzero "github.com/guregu/null/v5/zero"
+)
+
+// StringFromGuregu converts a guregu null.String to a null.String.
+func StringFromGuregu(v gnull.String) null.String {
+	return null.NewString(v.String, v.Valid)
+}
+
+// StringToGuregu converts a null.String to a guregu null.String.
+func StringToGuregu(v null.String) gnull.String {
+	return gnull.NewString(v.String, v.Valid)
+}
+
+// IntFromGuregu converts a guregu null.Int to a null.Int.
+func IntFromGuregu(v gnull.Int) null.Int {
+	return null.NewInt(v.Int64, v.Valid)
+}
+
+// IntToGuregu converts a null.Int to a guregu null.Int.
+func IntToGuregu(v null.Int) gnull.Int {
+	return gnull.NewInt(v.Int64, v.Valid)
+}
+
+// FloatFromGuregu converts a guregu null.Float to a null.Float.
+func FloatFromGuregu(v gnull.Float) null.Float {
+	return null.NewFloat(v.Float64, v.Valid)
+}
+
+// FloatToGuregu converts a null.Float to a guregu null.Float.
+func FloatToGuregu(v null.Float) gnull.Float {
+	return gnull.NewFloat(v.Float64, v.Valid)
+}
+
+// BoolFromGuregu converts a guregu null.Bool to a null.Bool.
+func BoolFromGuregu(v gnull.Bool) null.Bool {
+	return null.NewBool(v.Bool, v.Valid)
+}
+
+// BoolToGuregu converts a null.Bool to a guregu null.Bool.
+func BoolToGuregu(v null.Bool) gnull.Bool {
+	return gnull.NewBool(v.Bool, v.Valid)
+}
+
+// TimeFromGuregu converts a guregu null.Time to a null.Time.
+func TimeFromGuregu(v gnull.Time) null.Time {
+	return null.NewTime(v.Time, v.Valid)
+}
+
+// TimeToGuregu converts a null.Time to a guregu null.Time.
+func TimeToGuregu(v null.Time) gnull.Time {
+	return gnull.NewTime(v.Time, v.Valid)
+}
+
+// IntFromGureguInt32 converts a guregu null.Int32 to a null.Int, which holds any int32.
+func IntFromGureguInt32(v gnull.Int32) null.Int {
+	return null.NewInt(int64(v.Int32), v.Valid)
+}
+
+// IntToGureguInt32 converts a null.Int to a guregu null.Int32.
+// It returns a *null.RangeError if v is valid but its value does not fit in an int32.
+func IntToGureguInt32(v null.Int) (gnull.Int32, error) {
+	if err := checkRange(v.Int64, v.Valid, math.MinInt32, math.MaxInt32); err != nil {
+		return gnull.Int32{}, err
+	}
+	return gnull.NewInt32(int32(v.Int64), v.Valid), nil
+}
+
+// IntFromGureguInt16 converts a guregu null.Int16 to a null.Int, which holds any int16.
+func IntFromGureguInt16(v gnull.Int16) null.Int {
+	return null.NewInt(int64(v.Int16), v.Valid)
+}
+
+// IntToGureguInt16 converts a null.Int to a guregu null.Int16.
+// It returns a *null.RangeError if v is valid but its value does not fit in an int16.
+func IntToGureguInt16(v null.Int) (gnull.Int16, error) {
+	if err := checkRange(v.Int64, v.Valid, math.MinInt16, math.MaxInt16); err != nil {
+		return gnull.Int16{}, err
+	}
+	return gnull.NewInt16(int16(v.Int64), v.Valid), nil
+}
+
+// IntFromGureguByte converts a guregu null.Byte to a null.Int, which holds any byte.
+func IntFromGureguByte(v gnull.Byte) null.Int {
+	return null.NewInt(int64(v.Byte), v.Valid)
+}
+
+// IntToGureguByte converts a null.Int to a guregu null.Byte.
+// It returns a *null.RangeError if v is valid but its value is not between 0 and 255.
+func IntToGureguByte(v null.Int) (gnull.Byte, error) {
+	if err := checkRange(v.Int64, v.Valid, 0, math.MaxUint8); err != nil {
+		return gnull.Byte{}, err
+	}
+	return gnull.NewByte(byte(v.Int64), v.Valid), nil
+}
+
+// ZeroStringFromGuregu converts a guregu zero.String to a zero.String.
+func ZeroStringFromGuregu(v gzero.String) zero.String {
+	return zero.NewString(v.String, v.Valid)
+}
+
+// ZeroStringToGuregu converts a zero.String to a guregu zero.String.
+func ZeroStringToGuregu(v zero.String) gzero.String {
+	return gzero.NewString(v.String, v.Valid)
+}
+
+// ZeroIntFromGuregu converts a guregu zero.Int to a zero.Int.
+func ZeroIntFromGuregu(v gzero.Int) zero.Int {
+	return zero.NewInt(v.Int64, v.Valid)
+}
+
+// ZeroIntToGuregu converts a zero.Int to a guregu zero.Int.
+func ZeroIntToGuregu(v zero.Int) gzero.Int {
+	return gzero.NewInt(v.Int64, v.Valid)
+}
+
+// ZeroFloatFromGuregu converts a guregu zero.Float to a zero.Float.
+func ZeroFloatFromGuregu(v gzero.Float) zero.Float {
+	return zero.NewFloat(v.Float64, v.Valid)
+}
+
+// ZeroFloatToGuregu converts a zero.Float to a guregu zero.Float.
+func ZeroFloatToGuregu(v zero.Float) gzero.Float {
+	return gzero.NewFloat(v.Float64, v.Valid)
+}
+
+// ZeroBoolFromGuregu converts a guregu zero.Bool to a zero.Bool.
+func ZeroBoolFromGuregu(v gzero.Bool) zero.Bool {
+	return zero.NewBool(v.Bool, v.Valid)
+}
+
+// ZeroBoolToGuregu converts a zero.Bool to a guregu zero.Bool.
+func ZeroBoolToGuregu(v zero.Bool) gzero.Bool {
+	return gzero.NewBool(v.Bool, v.Valid)
+}
+
+// ZeroTimeFromGuregu converts a guregu zero.Time to a zero.Time.
+func ZeroTimeFromGuregu(v gzero.Time) zero.Time {
+	return zero.NewTime(v.Time, v.Valid)
+}
+
+// ZeroTimeToGuregu converts a zero.Time to a guregu zero.Time.
+func ZeroTimeToGuregu(v zero.Time) gzero.Time {
+	return gzero.NewTime(v.Time, v.Valid)
+}
+
+// ZeroIntFromGureguInt32 converts a guregu zero.Int32 to a zero.Int, which holds any int32.
+func ZeroIntFromGureguInt32(v gzero.Int32) zero.Int {
+	return zero.NewInt(int64(v.Int32), v.Valid)
+}
+
+// ZeroIntToGureguInt32 converts a zero.Int to a guregu zero.Int32.
+// It returns a *null.RangeError if v is valid but its value does not fit in an int32.
+func ZeroIntToGureguInt32(v zero.Int) (gzero.Int32, error) {
+	if err := checkRange(v.Int64, v.Valid, math.MinInt32, math.MaxInt32); err != nil {
+		return gzero.Int32{}, err
+	}
+	return gzero.NewInt32(int32(v.Int64), v.Valid), nil
+}
+
+// ZeroIntFromGureguInt16 converts a guregu zero.Int16 to a zero.Int, which holds any int16.
+func ZeroIntFromGureguInt16(v gzero.Int16) zero.Int {
+	return zero.NewInt(int64(v.Int16), v.Valid)
+}
+
+// ZeroIntToGureguInt16 converts a zero.Int to a guregu zero.Int16.
+// It returns a *null.RangeError if v is valid but its value does not fit in an int16.
+func ZeroIntToGureguInt16(v zero.Int) (gzero.Int16, error) {
+	if err := checkRange(v.Int64, v.Valid, math.MinInt16, math.MaxInt16); err != nil {
+		return gzero.Int16{}, err
+	}
+	return gzero.NewInt16(int16(v.Int64), v.Valid), nil
+}
+
+// ZeroIntFromGureguByte converts a guregu zero.Byte to a zero.Int, which holds any byte.
+func ZeroIntFromGureguByte(v gzero.Byte) zero.Int {
+	return zero.NewInt(int64(v.Byte), v.Valid)
+}
+
+// ZeroIntToGureguByte converts a zero.Int to a guregu zero.Byte.
+// It returns a *null.RangeError if v is valid but its value is not between 0 and 255.
+func ZeroIntToGureguByte(v zero.Int) (gzero.Byte, error) {
+	if err := checkRange(v.Int64, v.Valid, 0, math.MaxUint8); err != nil {
+		return gzero.Byte{}, err
+	}
+	return gzero.NewByte(byte(v.Int64), v.Valid), nil
+}
+
+// checkRange returns a *null.RangeError if valid is true and i is outside [min, max].
+func checkRange(i int64, valid bool, min, max int64) error {
+	if valid && (i < min || i > max) {
+		return &null.RangeError{Value: i, Min: min, Max: max}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package guregu
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/zero"
+	gnull "github.com/guregu/null/v5"
+	gzero "github.com/guregu/null/v5/zero"
+)
+
+func TestRoundTrip(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 6, time.FixedZone("ICT", 7*60*60))
+	for _, valid := range []bool{true, false} {
+		if s := null.NewString("a", valid); StringFromGuregu(StringToGuregu(s)) != s {
+			t.Errorf("String round trip changed %v", s)
+		}
+		if i := null.NewInt(-5, valid); IntFromGuregu(IntToGuregu(i)) != i {
+			t.Errorf("Int round trip changed %v", i)
+		}
+		if f := null.NewFloat(1.5, valid); FloatFromGuregu(FloatToGuregu(f)) != f {
+			t.Errorf("Float round trip changed %v", f)
+		}
+		if b := null.NewBool(true, valid); BoolFromGuregu(BoolToGuregu(b)) != b {
+			t.Errorf("Bool round trip changed %v", b)
+		}
+		if tm := null.NewTime(at, valid); TimeFromGuregu(TimeToGuregu(tm)) != tm {
+			t.Errorf("Time round trip changed %v", tm)
+		}
+
+		// zero types keep a valid zero value as it is
+		if s := zero.NewString("", valid); ZeroStringFromGuregu(ZeroStringToGuregu(s)) != s {
+			t.Errorf("zero.String round trip changed %v", s)
+		}
+		if i := zero.NewInt(0, valid); ZeroIntFromGuregu(ZeroIntToGuregu(i)) != i {
+			t.Errorf("zero.Int round trip changed %v", i)
+		}
+		if f := zero.NewFloat(0, valid); ZeroFloatFromGuregu(ZeroFloatToGuregu(f)) != f {
+			t.Errorf("zero.Float round trip changed %v", f)
+		}
+		if b := zero.NewBool(false, valid); ZeroBoolFromGuregu(ZeroBoolToGuregu(b)) != b {
+			t.Errorf("zero.Bool round trip changed %v", b)
+		}
+		if tm := zero.NewTime(at, valid); ZeroTimeFromGuregu(ZeroTimeToGuregu(tm)) != tm {
+			t.Errorf("zero.Time round trip changed %v", tm)
+		}
+	}
+}
+
+func TestIntFromSmallerTypes(t *testing.T) {
+	if got := IntFromGureguInt32(gnull.Int32From(-7)); got != null.IntFrom(-7) {
+		t.Errorf("IntFromGureguInt32 = %v", got)
+	}
+	if got := IntFromGureguInt16(gnull.NewInt16(0, false)); got.Valid {
+		t.Errorf("IntFromGureguInt16 of null = %v", got)
+	}
+	if got := ZeroIntFromGureguByte(gzero.ByteFrom(200)); got != zero.IntFrom(200) {
+		t.Errorf("ZeroIntFromGureguByte = %v", got)
+	}
+}
+
+func TestIntToSmallerTypes(t *testing.T) {
+	if got, err := IntToGureguInt32(null.IntFrom(-7)); err != nil || got != gnull.Int32From(-7) {
+		t.Errorf("IntToGureguInt32 = %v, %v", got, err)
+	}
+	if got, err := IntToGureguInt16(null.NewInt(1<<20, false)); err != nil || got.Valid {
+		t.Errorf("IntToGureguInt16 of null = %v, %v", got, err)
+	}
+	if got, err := ZeroIntToGureguByte(zero.IntFrom(255)); err != nil || got != gzero.ByteFrom(255) {
+		t.Errorf("ZeroIntToGureguByte = %v, %v", got, err)
+	}
+
+	var rangeErr *null.RangeError
+	if _, err := IntToGureguInt32(null.IntFrom(1 << 31)); !errors.As(err, &rangeErr) || rangeErr.Max != math.MaxInt32 {
+		t.Errorf("IntToGureguInt32 out of range: got %v", err)
+	}
+	if _, err := ZeroIntToGureguInt16(zero.IntFrom(math.MinInt16 - 1)); !errors.As(err, &rangeErr) {
+		t.Errorf("ZeroIntToGureguInt16 out of range: got %v", err)
+	}
+	if _, err := IntToGureguByte(null.IntFrom(-1)); !errors.As(err, &rangeErr) || rangeErr.Min != 0 {
+		t.Errorf("IntToGureguByte out of range: got %v", err)
+	}
+}
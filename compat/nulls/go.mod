@@ -0,0 +1,19 @@
+module github.com/attapon-th/null/compat/nulls
+
+go 1.24
+
+require (
+	github.com/attapon-th/null v0.0.0-00010101000000-000000000000
+	github.com/gobuffalo/nulls v0.4.2
+)
+
+require (
+	github.com/gofrs/uuid v4.2.0+incompatible // indirect
+	github.com/josharian/intern v1.0.0 // indirect
+	github.com/mailru/easyjson v0.7.7 // indirect
+	github.com/philhofer/fwd v1.1.3-0.20240916144458-20a13a1f6b7c // indirect
+	github.com/tinylib/msgp v1.2.5 // indirect
+	golang.org/x/text v0.19.0 // indirect
+)
+
+replace github.com/attapon-th/null => ../..
@@ -0,0 +1,127 @@
+// Package nulls converts between the types in this module and those of
+// github.com/gobuffalo/nulls, as used by Buffalo, so both can be used at module boundaries.
+// Every conversion keeps the validity, and the value except for the float32 precision of FloatToFloat32.
+// Conversions to narrower types return an error if a valid value does not fit.
+package nulls
+
+import (
+	"math"
+
+	"github.com/attapon-th/null"
+	buffalo "github.com/gobuffalo/nulls"
+)
+
+// StringFromString converts a nulls.String to a null.String.
+func StringFromString(v buffalo.String) null.String {
+	return null.NewString(v.String, v.Valid)
+}
+
+// StringToString converts a null.String to a nulls.String.
+func StringToString(v null.String) buffalo.String {
+	return buffalo.String{String: v.String, Valid: v.Valid}
+}
+
+// IntFromInt64 converts a nulls.Int64 to a null.Int.
+func IntFromInt64(v buffalo.Int64) null.Int {
+	return null.NewInt(v.Int64, v.Valid)
+}
+
+// IntToInt64 converts a null.Int to a nulls.Int64.
+func IntToInt64(v null.Int) buffalo.Int64 {
+	return buffalo.Int64{Int64: v.Int64, Valid: v.Valid}
+}
+
+// FloatFromFloat64 converts a nulls.Float64 to a null.Float.
+func FloatFromFloat64(v buffalo.Float64) null.Float {
+	return null.NewFloat(v.Float64, v.Valid)
+}
+
+// FloatToFloat64 converts a null.Float to a nulls.Float64.
+func FloatToFloat64(v null.Float) buffalo.Float64 {
+	return buffalo.Float64{Float64: v.Float64, Valid: v.Valid}
+}
+
+// BoolFromBool converts a nulls.Bool to a null.Bool.
+func BoolFromBool(v buffalo.Bool) null.Bool {
+	return null.NewBool(v.Bool, v.Valid)
+}
+
+// BoolToBool converts a null.Bool to a nulls.Bool.
+func BoolToBool(v null.Bool) buffalo.Bool {
+	return buffalo.Bool{Bool: v.Bool, Valid: v.Valid}
+}
+
+// TimeFromTime converts a nulls.Time to a null.Time.
+func TimeFromTime(v buffalo.Time) null.Time {
+	return null.NewTime(v.Time, v.Valid)
+}
+
+// TimeToTime converts a null.Time to a nulls.Time.
+func TimeToTime(v null.Time) buffalo.Time {
+	return buffalo.Time{Time: v.Time, Valid: v.Valid}
+}
+
+// IntFromInt converts a nulls.Int to a null.Int.
+func IntFromInt(v buffalo.Int) null.Int {
+	return null.NewInt(int64(v.Int), v.Valid)
+}
+
+// IntToInt converts a null.Int to a nulls.Int.
+// It returns a *null.RangeError if v is valid but its value does not fit in an int,
+// which can only happen where int is 32 bits.
+func IntToInt(v null.Int) (buffalo.Int, error) {
+	if err := checkRange(v.Int64, v.Valid, math.MinInt, math.MaxInt); err != nil {
+		return buffalo.Int{}, err
+	}
+	return buffalo.Int{Int: int(v.Int64), Valid: v.Valid}, nil
+}
+
+// IntFromInt32 converts a nulls.Int32 to a null.Int.
+func IntFromInt32(v buffalo.Int32) null.Int {
+	return null.NewInt(int64(v.Int32), v.Valid)
+}
+
+// IntToInt32 converts a null.Int to a nulls.Int32.
+// It returns a *null.RangeError if v is valid but its value does not fit in an int32.
+func IntToInt32(v null.Int) (buffalo.Int32, error) {
+	if err := checkRange(v.Int64, v.Valid, math.MinInt32, math.MaxInt32); err != nil {
+		return buffalo.Int32{}, err
+	}
+	return buffalo.Int32{Int32: int32(v.Int64), Valid: v.Valid}, nil
+}
+
+// IntFromUInt32 converts a nulls.UInt32 to a null.Int.
+func IntFromUInt32(v buffalo.UInt32) null.Int {
+	return null.NewInt(int64(v.UInt32), v.Valid)
+}
+
+// IntToUInt32 converts a null.Int to a nulls.UInt32.
+// It returns a *null.RangeError if v is valid but its value is negative or above math.MaxUint32.
+func IntToUInt32(v null.Int) (buffalo.UInt32, error) {
+	if err := checkRange(v.Int64, v.Valid, 0, math.MaxUint32); err != nil {
+		return buffalo.UInt32{}, err
+	}
+	return buffalo.UInt32{UInt32: uint32(v.Int64), Valid: v.Valid}, nil
+}
+
+// FloatFromFloat32 converts a nulls.Float32 to a null.Float.
+func FloatFromFloat32(v buffalo.Float32) null.Float {
+	return null.NewFloat(float64(v.Float32), v.Valid)
+}
+
+// FloatToFloat32 converts a null.Float to a nulls.Float32, rounding its value to float32 precision.
+// It returns a *null.FloatRangeError if v is valid but its magnitude is above math.MaxFloat32.
+func FloatToFloat32(v null.Float) (buffalo.Float32, error) {
+	if v.Valid && math.Abs(v.Float64) > math.MaxFloat32 {
+		return buffalo.Float32{}, &null.FloatRangeError{Value: v.Float64, Min: -math.MaxFloat32, Max: math.MaxFloat32}
+	}
+	return buffalo.Float32{Float32: float32(v.Float64), Valid: v.Valid}, nil
+}
+
+// checkRange returns a *null.RangeError if valid is true and i is outside [min, max].
+func checkRange(i int64, valid bool, min, max int64) error {
+	if valid && (i < min || i > max) {
+		return &null.RangeError{Value: i, Min: min, Max: max}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package nulls
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	buffalo "github.com/gobuffalo/nulls"
+)
+
+func TestRoundTrip(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	for _, valid := range []bool{true, false} {
+		if s := null.NewString("a", valid); StringFromString(StringToString(s)) != s {
+			t.Errorf("String round trip changed %v", s)
+		}
+		if i := null.NewInt(-5, valid); IntFromInt64(IntToInt64(i)) != i {
+			t.Errorf("Int round trip changed %v", i)
+		}
+		if f := null.NewFloat(1.5, valid); FloatFromFloat64(FloatToFloat64(f)) != f {
+			t.Errorf("Float round trip changed %v", f)
+		}
+		if b := null.NewBool(true, valid); BoolFromBool(BoolToBool(b)) != b {
+			t.Errorf("Bool round trip changed %v", b)
+		}
+		if tm := null.NewTime(at, valid); TimeFromTime(TimeToTime(tm)) != tm {
+			t.Errorf("Time round trip changed %v", tm)
+		}
+	}
+}
+
+func TestFromSmallerTypes(t *testing.T) {
+	if got := IntFromInt(buffalo.NewInt(7)); got != null.IntFrom(7) {
+		t.Errorf("IntFromInt = %v", got)
+	}
+	if got := IntFromUInt32(buffalo.UInt32{}); got.Valid {
+		t.Errorf("IntFromUInt32 of null = %v", got)
+	}
+	if got := FloatFromFloat32(buffalo.NewFloat32(0.5)); got != null.FloatFrom(0.5) {
+		t.Errorf("FloatFromFloat32 = %v", got)
+	}
+}
+
+func TestToSmallerTypes(t *testing.T) {
+	if got, err := IntToInt(null.IntFrom(7)); err != nil || got != buffalo.NewInt(7) {
+		t.Errorf("IntToInt = %v, %v", got, err)
+	}
+	if got, err := IntToInt32(null.NewInt(math.MaxInt64, false)); err != nil || got.Valid {
+		t.Errorf("IntToInt32 of null = %v, %v", got, err)
+	}
+	if got, err := IntToUInt32(null.IntFrom(math.MaxUint32)); err != nil || got != buffalo.NewUInt32(math.MaxUint32) {
+		t.Errorf("IntToUInt32 = %v, %v", got, err)
+	}
+	if got, err := FloatToFloat32(null.FloatFrom(0.5)); err != nil || got != buffalo.NewFloat32(0.5) {
+		t.Errorf("FloatToFloat32 = %v, %v", got, err)
+	}
+
+	var rangeErr *null.RangeError
+	if _, err := IntToInt32(null.IntFrom(math.MaxInt32 + 1)); !errors.As(err, &rangeErr) {
+		t.Errorf("IntToInt32 out of range: got %v", err)
+	}
+	if _, err := IntToUInt32(null.IntFrom(-1)); !errors.As(err, &rangeErr) || rangeErr.Min != 0 {
+		t.Errorf("IntToUInt32 out of range: got %v", err)
+	}
+	var floatErr *null.FloatRangeError
+	if _, err := FloatToFloat32(null.FloatFrom(math.MaxFloat64)); !errors.As(err, &floatErr) {
+		t.Errorf("FloatToFloat32 out of range: got %v", err)
+	}
+}
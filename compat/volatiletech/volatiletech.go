@@ -0,0 +1,183 @@
+// Package volatiletech converts between the types in this module and those of
+// github.com/volatiletech/null/v8, as used by sqlboiler, so both can be used at module boundaries.
+// Every conversion keeps the validity, and the value except for the float32 precision of FloatToFloat32.
+// Conversions to narrower types return an error if a valid value does not fit.
+package volatiletech
+
+import (
+	"math"
+
+	"github.com/attapon-th/null"
+	vnull "github.com/volatiletech/null/v8"
+)
+
+// StringFromString converts a volatiletech null.String to a null.String.
+func StringFromString(v vnull.String) null.String {
+	return null.NewString(v.String, v.Valid)
+}
+
+// StringToString converts a null.String to a volatiletech null.String.
+func StringToString(v null.String) vnull.String {
+	return vnull.NewString(v.String, v.Valid)
+}
+
+// IntFromInt64 converts a volatiletech null.Int64 to a null.Int.
+func IntFromInt64(v vnull.Int64) null.Int {
+	return null.NewInt(v.Int64, v.Valid)
+}
+
+// IntToInt64 converts a null.Int to a volatiletech null.Int64.
+func IntToInt64(v null.Int) vnull.Int64 {
+	return vnull.NewInt64(v.Int64, v.Valid)
+}
+
+// FloatFromFloat64 converts a volatiletech null.Float64 to a null.Float.
+func FloatFromFloat64(v vnull.Float64) null.Float {
+	return null.NewFloat(v.Float64, v.Valid)
+}
+
+// FloatToFloat64 converts a null.Float to a volatiletech null.Float64.
+func FloatToFloat64(v null.Float) vnull.Float64 {
+	return vnull.NewFloat64(v.Float64, v.Valid)
+}
+
+// BoolFromBool converts a volatiletech null.Bool to a null.Bool.
+func BoolFromBool(v vnull.Bool) null.Bool {
+	return null.NewBool(v.Bool, v.Valid)
+}
+
+// BoolToBool converts a null.Bool to a volatiletech null.Bool.
+func BoolToBool(v null.Bool) vnull.Bool {
+	return vnull.NewBool(v.Bool, v.Valid)
+}
+
+// TimeFromTime converts a volatiletech null.Time to a null.Time.
+func TimeFromTime(v vnull.Time) null.Time {
+	return null.NewTime(v.Time, v.Valid)
+}
+
+// TimeToTime converts a null.Time to a volatiletech null.Time.
+func TimeToTime(v null.Time) vnull.Time {
+	return vnull.NewTime(v.Time, v.Valid)
+}
+
+// IntFromInt converts a volatiletech null.Int to a null.Int.
+func IntFromInt(v vnull.Int) null.Int {
+	return null.NewInt(int64(v.Int), v.Valid)
+}
+
+// IntToInt converts a null.Int to a volatiletech null.Int.
+// It returns a *null.RangeError if v is valid but its value does not fit in an int,
+// which can only happen where int is 32 bits.
+func IntToInt(v null.Int) (vnull.Int, error) {
+	if err := checkRange(v.Int64, v.Valid, math.MinInt, math.MaxInt); err != nil {
+		return vnull.Int{}, err
+	}
+	return vnull.NewInt(int(v.Int64), v.Valid), nil
+}
+
+// IntFromInt32 converts a volatiletech null.Int32 to a null.Int.
+func IntFromInt32(v vnull.Int32) null.Int {
+	return null.NewInt(int64(v.Int32), v.Valid)
+}
+
+// IntToInt32 converts a null.Int to a volatiletech null.Int32.
+// It returns a *null.RangeError if v is valid but its value does not fit in an int32.
+func IntToInt32(v null.Int) (vnull.Int32, error) {
+	if err := checkRange(v.Int64, v.Valid, math.MinInt32, math.MaxInt32); err != nil {
+		return vnull.Int32{}, err
+	}
+	return vnull.NewInt32(int32(v.Int64), v.Valid), nil
+}
+
+// IntFromInt16 converts a volatiletech null.Int16 to a null.Int.
+func IntFromInt16(v vnull.Int16) null.Int {
+	return null.NewInt(int64(v.Int16), v.Valid)
+}
+
+// IntToInt16 converts a null.Int to a volatiletech null.Int16.
+// It returns a *null.RangeError if v is valid but its value does not fit in an int16.
+func IntToInt16(v null.Int) (vnull.Int16, error) {
+	if err := checkRange(v.Int64, v.Valid, math.MinInt16, math.MaxInt16); err != nil {
+		return vnull.Int16{}, err
+	}
+	return vnull.NewInt16(int16(v.Int64), v.Valid), nil
+}
+
+// IntFromInt8 converts a volatiletech null.Int8 to a null.Int.
+func IntFromInt8(v vnull.Int8) null.Int {
+	return null.NewInt(int64(v.Int8), v.Valid)
+}
+
+// IntToInt8 converts a null.Int to a volatiletech null.Int8.
+// It returns a *null.RangeError if v is valid but its value does not fit in an int8.
+func IntToInt8(v null.Int) (vnull.Int8, error) {
+	if err := checkRange(v.Int64, v.Valid, math.MinInt8, math.MaxInt8); err != nil {
+		return vnull.Int8{}, err
+	}
+	return vnull.NewInt8(int8(v.Int64), v.Valid), nil
+}
+
+// IntFromUint32 converts a volatiletech null.Uint32 to a null.Int.
+func IntFromUint32(v vnull.Uint32) null.Int {
+	return null.NewInt(int64(v.Uint32), v.Valid)
+}
+
+// IntToUint32 converts a null.Int to a volatiletech null.Uint32.
+// It returns a *null.RangeError if v is valid but its value is negative or above math.MaxUint32.
+func IntToUint32(v null.Int) (vnull.Uint32, error) {
+	if err := checkRange(v.Int64, v.Valid, 0, math.MaxUint32); err != nil {
+		return vnull.Uint32{}, err
+	}
+	return vnull.NewUint32(uint32(v.Int64), v.Valid), nil
+}
+
+// IntFromUint16 converts a volatiletech null.Uint16 to a null.Int.
+func IntFromUint16(v vnull.Uint16) null.Int {
+	return null.NewInt(int64(v.Uint16), v.Valid)
+}
+
+// IntToUint16 converts a null.Int to a volatiletech null.Uint16.
+// It returns a *null.RangeError if v is valid but its value is negative or above math.MaxUint16.
+func IntToUint16(v null.Int) (vnull.Uint16, error) {
+	if err := checkRange(v.Int64, v.Valid, 0, math.MaxUint16); err != nil {
+		return vnull.Uint16{}, err
+	}
+	return vnull.NewUint16(uint16(v.Int64), v.Valid), nil
+}
+
+// IntFromUint8 converts a volatiletech null.Uint8 to a null.Int.
+func IntFromUint8(v vnull.Uint8) null.Int {
+	return null.NewInt(int64(v.Uint8), v.Valid)
+}
+
+// IntToUint8 converts a null.Int to a volatiletech null.Uint8.
+// It returns a *null.RangeError if v is valid but its value is negative or above math.MaxUint8.
+func IntToUint8(v null.Int) (vnull.Uint8, error) {
+	if err := checkRange(v.Int64, v.Valid, 0, math.MaxUint8); err != nil {
+		return vnull.Uint8{}, err
+	}
+	return vnull.NewUint8(uint8(v.Int64), v.Valid), nil
+}
+
+// FloatFromFloat32 converts a volatiletech null.Float32 to a null.Float.
+func FloatFromFloat32(v vnull.Float32) null.Float {
+	return null.NewFloat(float64(v.Float32), v.Valid)
+}
+
+// FloatToFloat32 converts a null.Float to a volatiletech null.Float32, rounding its value to float32 precision.
+// It returns a *null.FloatRangeError if v is valid but its magnitude is above math.MaxFloat32.
+func FloatToFloat32(v null.Float) (vnull.Float32, error) {
+	if v.Valid && math.Abs(v.Float64) > math.MaxFloat32 {
+		return vnull.Float32{}, &null.FloatRangeError{Value: v.Float64, Min: -math.MaxFloat32, Max: math.MaxFloat32}
+	}
+	return vnull.NewFloat32(float32(v.Float64), v.Valid), nil
+}
+
+// checkRange returns a *null.RangeError if valid is true and i is outside [min, max].
+func checkRange(i int64, valid bool, min, max int64) error {
+	if valid && (i < min || i > max) {
+		return &null.RangeError{Value: i, Min: min, Max: max}
+	}
+	return nil
+}
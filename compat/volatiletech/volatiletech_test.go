@@ -0,0 +1,68 @@
+package volatiletech
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	vnull "github.com/volatiletech/null/v8"
+)
+
+func TestRoundTrip(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	for _, valid := range []bool{true, false} {
+		if s := null.NewString("a", valid); StringFromString(StringToString(s)) != s {
+			t.Errorf("String round trip changed %v", s)
+		}
+		if i := null.NewInt(-5, valid); IntFromInt64(IntToInt64(i)) != i {
+			t.Errorf("Int round trip changed %v", i)
+		}
+		if f := null.NewFloat(1.5, valid); FloatFromFloat64(FloatToFloat64(f)) != f {
+			t.Errorf("Float round trip changed %v", f)
+		}
+		if b := null.NewBool(true, valid); BoolFromBool(BoolToBool(b)) != b {
+			t.Errorf("Bool round trip changed %v", b)
+		}
+		if tm := null.NewTime(at, valid); TimeFromTime(TimeToTime(tm)) != tm {
+			t.Errorf("Time round trip changed %v", tm)
+		}
+	}
+}
+
+func TestFromSmallerTypes(t *testing.T) {
+	if got := IntFromUint8(vnull.Uint8From(200)); got != null.IntFrom(200) {
+		t.Errorf("IntFromUint8 = %v", got)
+	}
+	if got := IntFromInt32(vnull.NewInt32(0, false)); got.Valid {
+		t.Errorf("IntFromInt32 of null = %v", got)
+	}
+	if got := FloatFromFloat32(vnull.Float32From(0.5)); got != null.FloatFrom(0.5) {
+		t.Errorf("FloatFromFloat32 = %v", got)
+	}
+}
+
+func TestToSmallerTypes(t *testing.T) {
+	if got, err := IntToUint8(null.IntFrom(200)); err != nil || got != vnull.Uint8From(200) {
+		t.Errorf("IntToUint8 = %v, %v", got, err)
+	}
+	if got, err := IntToInt32(null.NewInt(math.MaxInt64, false)); err != nil || got.Valid {
+		t.Errorf("IntToInt32 of null = %v, %v", got, err)
+	}
+	if got, err := FloatToFloat32(null.FloatFrom(0.5)); err != nil || got != vnull.Float32From(0.5) {
+		t.Errorf("FloatToFloat32 = %v, %v", got, err)
+	}
+
+	var rangeErr *null.RangeError
+	if _, err := IntToInt8(null.IntFrom(128)); !errors.As(err, &rangeErr) || rangeErr.Max != math.MaxInt8 {
+		t.Errorf("IntToInt8 out of range: got %v", err)
+	}
+	if _, err := IntToUint16(null.IntFrom(-1)); !errors.As(err, &rangeErr) {
+		t.Errorf("IntToUint16 out of range: got %v", err)
+	}
+	var floatErr *null.FloatRangeError
+	if _, err := FloatToFloat32(null.FloatFrom(math.Inf(-1))); !errors.As(err, &floatErr) {
+		t.Errorf("FloatToFloat32 out of range: got %v", err)
+	}
+}
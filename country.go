@@ -0,0 +1,250 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"golang.org/x/text/language"
+)
+
+// CountryCode is a nullable ISO 3166-1 alpha-2 country code, such as "TH".
+// Input is validated and stored in upper case. Alpha-3 codes ("THA") are
+// converted to their alpha-2 equivalent.
+// It will marshal to null if null.
+type CountryCode struct {
+	sql.NullString
+}
+
+// NewCountryCode creates a new CountryCode.
+// The value is stored as-is and is not validated.
+func NewCountryCode(s string, valid bool) CountryCode {
+	return CountryCode{
+		NullString: sql.NullString{
+			String: s,
+			Valid:  valid,
+		},
+	}
+}
+
+// CountryCodeFrom creates a new CountryCode from s in its canonical form.
+// It will be null if s is not a known country code.
+func CountryCodeFrom(s string) CountryCode {
+	canonical, err := parseCountryCode(s)
+	if err != nil {
+		coerced("CountryCode", "From", s, err)
+		return NewCountryCode("", false)
+	}
+	return NewCountryCode(canonical, true)
+}
+
+// CountryCodeFromPtr creates a new CountryCode that will be null if s is nil
+// or not a known country code.
+func CountryCodeFromPtr(s *string) CountryCode {
+	if s == nil {
+		return NewCountryCode("", false)
+	}
+	return CountryCodeFrom(*s)
+}
+
+func parseCountryCode(s string) (string, error) {
+	// ParseRegion also accepts UN M.49 numeric codes, which are not country codes.
+	if len(s) != 2 && len(s) != 3 {
+		return "", errors.New("not an ISO 3166-1 code")
+	}
+	region, err := language.ParseRegion(s)
+	if err != nil {
+		return "", err
+	}
+	if !region.IsCountry() {
+		return "", errors.New("not a country")
+	}
+	return region.String(), nil
+}
+
+// Region returns the parsed language.Region and whether this CountryCode is valid.
+func (c CountryCode) Region() (language.Region, bool) {
+	if !c.Valid {
+		return language.Region{}, false
+	}
+	region, err := language.ParseRegion(c.String)
+	if err != nil {
+		return language.Region{}, false
+	}
+	return region, true
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (c CountryCode) ValueOrZero() string {
+	if !c.Valid {
+		return ""
+	}
+	return c.String
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if the column holds an unknown country code.
+func (c *CountryCode) Scan(value any) error {
+	if err := c.NullString.Scan(value); err != nil {
+		c.Valid = false
+		return newScanError("null.CountryCode", value, err)
+	}
+	if !c.Valid {
+		return nil
+	}
+	canonical, err := parseCountryCode(c.String)
+	if err != nil {
+		c.Valid = false
+		return newScanError("null.CountryCode", value, err)
+	}
+	c.String = canonical
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input. Blank string input produces a null CountryCode.
+// It returns an error if the input is not a known country code.
+func (c *CountryCode) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "String")
+	data = trimJSON("CountryCode", data)
+	if bytes.Equal(data, nullBytes) {
+		c.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	return unmarshalError(ErrInvalidJSON, c.set(str))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null CountryCode if the input is blank or "null".
+// It returns an error if the input is not a known country code.
+func (c *CountryCode) UnmarshalText(text []byte) error {
+	text = prepareText("CountryCode", text)
+	str := string(text)
+	if str == "null" {
+		str = ""
+	}
+	return unmarshalError(ErrInvalidText, c.set(str))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (c *CountryCode) UnmarshalParam(param string) error {
+	return c.UnmarshalText([]byte(param))
+}
+
+func (c *CountryCode) set(str string) error {
+	if str == "" {
+		c.String = ""
+		c.Valid = false
+		return nil
+	}
+	canonical, err := parseCountryCode(str)
+	if err != nil {
+		c.Valid = false
+		return fmt.Errorf("invalid country code %q: %w", str, err)
+	}
+	c.String = canonical
+	c.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this CountryCode is null.
+func (c CountryCode) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("CountryCode", c.Valid, c.marshalJSON)
+}
+
+func (c CountryCode) marshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(c.String)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this CountryCode is null.
+func (c CountryCode) MarshalText() ([]byte, error) {
+	if !c.Valid {
+		return []byte{}, nil
+	}
+	return []byte(c.String), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (c CountryCode) AppendText(buf []byte) ([]byte, error) {
+	if !c.Valid {
+		return buf, nil
+	}
+	return append(buf, c.String...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this CountryCode to v under key, or nothing if null.
+func (c CountryCode) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, c.Valid, c.MarshalText)
+}
+
+// SetValid changes this CountryCode's value and also sets it to be non-null.
+// The value is stored as-is and is not validated.
+func (c *CountryCode) SetValid(v string) {
+	c.String = v
+	c.Valid = true
+}
+
+// Ptr returns a pointer to this CountryCode's value, or a nil pointer if this CountryCode is null.
+func (c CountryCode) Ptr() *string {
+	if !c.Valid {
+		return nil
+	}
+	return &c.String
+}
+
+// IsZero returns true for null country codes, for potential future omitempty support.
+func (c CountryCode) IsZero() bool {
+	return !c.Valid
+}
+
+// IsNull returns true if this CountryCode is null.
+func (c CountryCode) IsNull() bool {
+	return !c.Valid
+}
+
+// SetNull sets this CountryCode to null and clears its value.
+func (c *CountryCode) SetNull() {
+	c.String, c.Valid = "", false
+}
+
+// Reset sets this CountryCode to its zero value, which is null.
+func (c *CountryCode) Reset() {
+	*c = CountryCode{}
+}
+
+// Equal returns true if both country codes have the same value or are both null.
+func (c CountryCode) Equal(other CountryCode) bool {
+	return c.Valid == other.Valid && (!c.Valid || c.String == other.String)
+}
+
+// Merge returns this CountryCode if it is valid, otherwise other.
+func (c CountryCode) Merge(other CountryCode) CountryCode {
+	if c.Valid {
+		return c
+	}
+	return other
+}
+
+// When returns this CountryCode if cond is true, otherwise a null CountryCode.
+func (c CountryCode) When(cond bool) CountryCode {
+	if !cond {
+		c.SetNull()
+	}
+	return c
+}
@@ -0,0 +1,112 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCountryCodeFrom(t *testing.T) {
+	c := CountryCodeFrom("th")
+	assertCountryCode(t, c, "TH", "CountryCodeFrom()")
+
+	alpha3 := CountryCodeFrom("THA")
+	assertCountryCode(t, alpha3, "TH", "CountryCodeFrom() alpha-3")
+
+	numeric := CountryCodeFrom("150")
+	assertNullCountryCode(t, numeric, "CountryCodeFrom() numeric region")
+
+	bad := CountryCodeFrom("XX")
+	assertNullCountryCode(t, bad, "CountryCodeFrom() unknown code")
+
+	null := CountryCodeFromPtr(nil)
+	assertNullCountryCode(t, null, "CountryCodeFromPtr(nil)")
+}
+
+func TestUnmarshalCountryCode(t *testing.T) {
+	var c CountryCode
+	err := json.Unmarshal([]byte(`"us"`), &c)
+	maybePanic(err)
+	assertCountryCode(t, c, "US", "country code json")
+
+	var null CountryCode
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullCountryCode(t, null, "null json")
+
+	var bad CountryCode
+	err = json.Unmarshal([]byte(`"ZZZZ"`), &bad)
+	if err == nil {
+		t.Error("expected error for unknown code")
+	}
+	assertNullCountryCode(t, bad, "unknown code json")
+
+	var badType CountryCode
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error for wrong type")
+	}
+	assertNullCountryCode(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalCountryCode(t *testing.T) {
+	var c CountryCode
+	err := c.UnmarshalText([]byte("jp"))
+	maybePanic(err)
+	assertCountryCode(t, c, "JP", "UnmarshalText()")
+
+	var blank CountryCode
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullCountryCode(t, blank, "UnmarshalText() blank")
+}
+
+func TestMarshalCountryCode(t *testing.T) {
+	c := CountryCodeFrom("TH")
+	data, err := json.Marshal(c)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"TH"`, "non-empty json marshal")
+
+	null := CountryCodeFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestCountryCodeScan(t *testing.T) {
+	var c CountryCode
+	err := c.Scan([]byte("de"))
+	maybePanic(err)
+	assertCountryCode(t, c, "DE", "scanned country code")
+
+	var null CountryCode
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullCountryCode(t, null, "scanned null")
+
+	var bad CountryCode
+	err = bad.Scan("QQ")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullCountryCode(t, bad, "scanned bad code")
+}
+
+func assertCountryCode(t *testing.T, c CountryCode, want string, from string) {
+	t.Helper()
+	if c.String != want {
+		t.Errorf("bad %s country code: %s ≠ %s\n", from, c.String, want)
+	}
+	if !c.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullCountryCode(t *testing.T, c CountryCode, from string) {
+	t.Helper()
+	if c.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
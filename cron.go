@@ -0,0 +1,487 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed cron expression.
+// Next returns the first activation time strictly after the given time,
+// or the zero time if there is none.
+type CronSchedule interface {
+	Next(after time.Time) time.Time
+}
+
+// CronParser parses a cron spec into a CronSchedule.
+type CronParser func(spec string) (CronSchedule, error)
+
+var (
+	// ParseCron Set the parser used by Cron, defaults to ParseCronSpec
+	//
+	// Deprecated: Use Configure with Options.ParseCron. It is ignored once Configure has been called.
+	ParseCron CronParser = ParseCronSpec
+)
+
+// Cron is a nullable cron expression. It supports SQL and JSON serialization
+// using the raw spec string, which is validated with ParseCron on assignment.
+// It will marshal to null if null.
+type Cron struct {
+	sql.NullString
+	schedule CronSchedule
+}
+
+// NewCron creates a new Cron. The spec is stored as-is and is parsed on first use.
+func NewCron(spec string, valid bool) Cron {
+	return Cron{
+		NullString: sql.NullString{
+			String: spec,
+			Valid:  valid,
+		},
+	}
+}
+
+// CronFrom creates a new Cron from spec.
+// It will be null if spec is not a valid cron expression.
+func CronFrom(spec string) Cron {
+	var c Cron
+	if err := c.set(spec); err != nil {
+		coerced("Cron", "From", spec, err)
+		return NewCron("", false)
+	}
+	return c
+}
+
+// CronFromPtr creates a new Cron that will be null if spec is nil or not a valid cron expression.
+func CronFromPtr(spec *string) Cron {
+	if spec == nil {
+		return NewCron("", false)
+	}
+	return CronFrom(*spec)
+}
+
+func (c *Cron) set(spec string) error {
+	schedule, err := config().ParseCron(spec)
+	if err != nil {
+		c.String, c.Valid, c.schedule = "", false, nil
+		return fmt.Errorf("null: invalid cron spec %q: %w", spec, err)
+	}
+	c.String, c.Valid, c.schedule = spec, true, schedule
+	return nil
+}
+
+// Schedule returns the parsed schedule, and false if this Cron is null or its spec does not parse.
+func (c Cron) Schedule() (CronSchedule, bool) {
+	if !c.Valid {
+		return nil, false
+	}
+	if c.schedule != nil {
+		return c.schedule, true
+	}
+	schedule, err := config().ParseCron(c.String)
+	if err != nil {
+		return nil, false
+	}
+	return schedule, true
+}
+
+// Next returns the first activation time after the given time.
+// ok is false if this Cron is null or the schedule never activates again.
+func (c Cron) Next(after time.Time) (next time.Time, ok bool) {
+	schedule, ok := c.Schedule()
+	if !ok {
+		return time.Time{}, false
+	}
+	next = schedule.Next(after)
+	return next, !next.IsZero()
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (c Cron) ValueOrZero() string {
+	if !c.Valid {
+		return ""
+	}
+	return c.String
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if the column holds an invalid cron spec.
+func (c *Cron) Scan(value any) error {
+	if err := c.NullString.Scan(value); err != nil {
+		c.Valid = false
+		return newScanError("null.Cron", value, err)
+	}
+	if !c.Valid {
+		c.schedule = nil
+		return nil
+	}
+	return c.set(c.String)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input, and returns an error if the spec is invalid.
+func (c *Cron) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "String")
+	data = trimJSON("Cron", data)
+	if bytes.Equal(data, nullBytes) {
+		c.Valid, c.schedule = false, nil
+		return nil
+	}
+
+	var spec string
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	if spec == "" && config().EmptyStringAsNull {
+		c.String, c.Valid, c.schedule = "", false, nil
+		return nil
+	}
+	return unmarshalError(ErrInvalidJSON, c.set(spec))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Cron if the input is blank or "null".
+func (c *Cron) UnmarshalText(text []byte) error {
+	text = prepareText("Cron", text)
+	str := string(text)
+	if str == "" || str == "null" {
+		c.Valid, c.schedule = false, nil
+		return nil
+	}
+	return unmarshalError(ErrInvalidText, c.set(str))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (c *Cron) UnmarshalParam(param string) error {
+	return c.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Cron is null.
+func (c Cron) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Cron", c.Valid, c.marshalJSON)
+}
+
+func (c Cron) marshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(c.String)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Cron is null.
+func (c Cron) MarshalText() ([]byte, error) {
+	if !c.Valid {
+		return []byte{}, nil
+	}
+	return []byte(c.String), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (c Cron) AppendText(buf []byte) ([]byte, error) {
+	if !c.Valid {
+		return buf, nil
+	}
+	return append(buf, c.String...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Cron to v under key, or nothing if null.
+func (c Cron) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, c.Valid, c.MarshalText)
+}
+
+// SetValid changes this Cron's spec and also sets it to be non-null.
+// The Cron will be null if spec is not a valid cron expression.
+func (c *Cron) SetValid(spec string) {
+	_ = c.set(spec)
+}
+
+// Ptr returns a pointer to this Cron's spec, or a nil pointer if this Cron is null.
+func (c Cron) Ptr() *string {
+	if !c.Valid {
+		return nil
+	}
+	return &c.String
+}
+
+// IsZero returns true for null Crons, for potential future omitempty support.
+func (c Cron) IsZero() bool {
+	return !c.Valid
+}
+
+// IsNull returns true if this Cron is null.
+func (c Cron) IsNull() bool {
+	return !c.Valid
+}
+
+// SetNull sets this Cron to null and clears its value.
+func (c *Cron) SetNull() {
+	c.String, c.schedule, c.Valid = "", nil, false
+}
+
+// Reset sets this Cron to its zero value, which is null.
+func (c *Cron) Reset() {
+	*c = Cron{}
+}
+
+// Equal returns true if both Crons have the same spec or are both null.
+func (c Cron) Equal(other Cron) bool {
+	return c.Valid == other.Valid && (!c.Valid || c.String == other.String)
+}
+
+// Merge returns this Cron if it is valid, otherwise other.
+func (c Cron) Merge(other Cron) Cron {
+	if c.Valid {
+		return c
+	}
+	return other
+}
+
+// When returns this Cron if cond is true, otherwise a null Cron.
+func (c Cron) When(cond bool) Cron {
+	if !cond {
+		c.SetNull()
+	}
+	return c
+}
+
+// cronSpec is the schedule produced by ParseCronSpec.
+// Each field is a bit set of the values it matches.
+type cronSpec struct {
+	second, minute, hour, dom, month, dow uint64
+}
+
+type cronField struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronSeconds = cronField{0, 59, nil}
+	cronMinutes = cronField{0, 59, nil}
+	cronHours   = cronField{0, 23, nil}
+	cronDom     = cronField{1, 31, nil}
+	cronMonths  = cronField{1, 12, map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	cronDow = cronField{0, 7, map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// ParseCronSpec is the default CronParser.
+// It accepts standard 5-field specs (minute hour day-of-month month day-of-week),
+// 6-field specs with a leading seconds field, and the @yearly, @monthly, @weekly,
+// @daily and @hourly descriptors. Fields support *, lists, ranges, steps and
+// three-letter month and weekday names. Schedules are evaluated in the location of
+// the time passed to Next.
+func ParseCronSpec(spec string) (CronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if d, ok := cronDescriptors[strings.ToLower(spec)]; ok {
+		spec = d
+	}
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("expected 5 or 6 fields, found %d", len(fields))
+	}
+
+	var s cronSpec
+	var err error
+	for i, f := range []struct {
+		dst   *uint64
+		field cronField
+	}{
+		{&s.second, cronSeconds},
+		{&s.minute, cronMinutes},
+		{&s.hour, cronHours},
+		{&s.dom, cronDom},
+		{&s.month, cronMonths},
+		{&s.dow, cronDow},
+	} {
+		if *f.dst, err = parseCronField(fields[i], f.field); err != nil {
+			return nil, err
+		}
+	}
+	// 7 is an alias for Sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return &s, nil
+}
+
+// cronStar marks a field written as "*" or "?", which matters for day matching.
+const cronStar = 1 << 63
+
+func parseCronField(expr string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		b, err := parseCronRange(part, field)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func parseCronRange(expr string, field cronField) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(expr, "/")
+	start, end := field.min, field.max
+	var star bool
+	switch {
+	case rangePart == "*" || rangePart == "?":
+		star = !hasStep
+	default:
+		lo, hi, isRange := strings.Cut(rangePart, "-")
+		var err error
+		if start, err = parseCronValue(lo, field); err != nil {
+			return 0, err
+		}
+		end = start
+		if isRange {
+			if end, err = parseCronValue(hi, field); err != nil {
+				return 0, err
+			}
+		} else if hasStep {
+			end = field.max
+		}
+	}
+	step := 1
+	if hasStep {
+		var err error
+		if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+			return 0, fmt.Errorf("bad step %q", stepPart)
+		}
+	}
+	if start > end {
+		return 0, fmt.Errorf("bad range %q", expr)
+	}
+
+	var bits uint64
+	for v := start; v <= end; v += step {
+		bits |= 1 << uint(v)
+	}
+	if star {
+		bits |= cronStar
+	}
+	return bits, nil
+}
+
+func parseCronValue(s string, field cronField) (int, error) {
+	if v, ok := field.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("bad value %q", s)
+	}
+	if v < field.min || v > field.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, field.min, field.max)
+	}
+	return v, nil
+}
+
+// Next implements CronSchedule. It gives up after searching five years ahead.
+func (s *cronSpec) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Add(time.Second - time.Duration(after.Nanosecond()))
+	added := false
+	yearLimit := t.Year() + 5
+
+wrap:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for s.month&(1<<uint(t.Month())) == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+
+	for !s.dayMatches(t) {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 0, 1)
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+
+	for s.hour&(1<<uint(t.Hour())) == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+		}
+		t = t.Add(time.Hour)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+
+	for s.minute&(1<<uint(t.Minute())) == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Minute)
+		}
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+
+	for s.second&(1<<uint(t.Second())) == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Second)
+		}
+		t = t.Add(time.Second)
+		if t.Second() == 0 {
+			goto wrap
+		}
+	}
+
+	return t
+}
+
+// dayMatches follows cron semantics: if both day-of-month and day-of-week are
+// restricted, a day matching either field is accepted.
+func (s *cronSpec) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.dom&cronStar != 0 || s.dow&cronStar != 0 {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
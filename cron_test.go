@@ -0,0 +1,169 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+var cronBase = time.Date(2024, 1, 31, 10, 15, 30, 0, time.UTC)
+
+func TestParseCronSpec(t *testing.T) {
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"*/15 * * * *", time.Date(2024, 1, 31, 10, 30, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)},
+		{"30 * * * * *", time.Date(2024, 1, 31, 10, 16, 30, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1,15 * *", time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC)},
+		{"0 0 13 * 5", time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range tests {
+		s, err := ParseCronSpec(tc.spec)
+		if err != nil {
+			t.Errorf("ParseCronSpec(%q): %v", tc.spec, err)
+			continue
+		}
+		if got := s.Next(cronBase); !got.Equal(tc.want) {
+			t.Errorf("ParseCronSpec(%q).Next() = %v, want %v", tc.spec, got, tc.want)
+		}
+	}
+
+	for _, bad := range []string{"", "* * * *", "* * * * * * *", "60 * * * *", "* * * 13 *", "5-1 * * * *", "*/0 * * * *", "x * * * *"} {
+		if _, err := ParseCronSpec(bad); err == nil {
+			t.Errorf("ParseCronSpec(%q) should fail", bad)
+		}
+	}
+
+	never, err := ParseCronSpec("0 0 30 2 *")
+	maybePanic(err)
+	if got := never.Next(cronBase); !got.IsZero() {
+		t.Errorf("impossible schedule should never fire, got %v", got)
+	}
+}
+
+func TestCronFrom(t *testing.T) {
+	c := CronFrom("0 9 * * *")
+	assertCron(t, c, "0 9 * * *", "CronFrom()")
+	if next, ok := c.Next(cronBase); !ok || !next.Equal(time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad Next(): %v %t", next, ok)
+	}
+
+	bad := CronFrom("every day")
+	assertNullCron(t, bad, "CronFrom() bad spec")
+	if _, ok := bad.Next(cronBase); ok {
+		t.Error("null Cron should not have a next time")
+	}
+
+	null := CronFromPtr(nil)
+	assertNullCron(t, null, "CronFromPtr(nil)")
+
+	lazy := NewCron("0 0 * * *", true)
+	if _, ok := lazy.Next(cronBase); !ok {
+		t.Error("NewCron() should parse on first use")
+	}
+
+	var set Cron
+	set.SetValid("not cron")
+	assertNullCron(t, set, "SetValid() bad spec")
+}
+
+func TestCronCustomParser(t *testing.T) {
+	defer configured.Store(nil)
+	errBoom := errors.New("boom")
+	Configure(Options{ParseCron: func(spec string) (CronSchedule, error) {
+		return nil, errBoom
+	}})
+
+	var c Cron
+	err := c.UnmarshalText([]byte("* * * * *"))
+	if !errors.Is(err, errBoom) {
+		t.Errorf("expected parser error, got %v", err)
+	}
+	assertNullCron(t, c, "custom parser")
+}
+
+func TestUnmarshalCron(t *testing.T) {
+	var c Cron
+	err := json.Unmarshal([]byte(`"*/5 * * * *"`), &c)
+	maybePanic(err)
+	assertCron(t, c, "*/5 * * * *", "cron json")
+
+	var null Cron
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullCron(t, null, "null json")
+
+	var bad Cron
+	err = json.Unmarshal([]byte(`"* *"`), &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullCron(t, bad, "bad spec json")
+
+	var badType Cron
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullCron(t, badType, "wrong type json")
+}
+
+func TestMarshalCron(t *testing.T) {
+	c := CronFrom("0 0 * * sun")
+	data, err := json.Marshal(c)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0 0 * * sun"`, "non-empty json marshal")
+	data, err = c.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0 0 * * sun", "non-empty text marshal")
+
+	null := CronFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestCronScanValue(t *testing.T) {
+	var c Cron
+	err := c.Scan("@daily")
+	maybePanic(err)
+	assertCron(t, c, "@daily", "scanned cron")
+	if v, err := c.Value(); v != "@daily" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null Cron
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullCron(t, null, "scanned null")
+
+	var bad Cron
+	err = bad.Scan([]byte("1 2 3"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullCron(t, bad, "scanned bad spec")
+}
+
+func assertCron(t *testing.T, c Cron, want string, from string) {
+	t.Helper()
+	if c.String != want {
+		t.Errorf("bad %s cron: %s ≠ %s\n", from, c.String, want)
+	}
+	if !c.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullCron(t *testing.T, c Cron, from string) {
+	t.Helper()
+	if c.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
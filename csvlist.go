@@ -0,0 +1,261 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Defaults of Options.CSVSeparator and Options.CSVEscape.
+const (
+	defaultCSVSeparator = ','
+	defaultCSVEscape    = '\\'
+)
+
+// CSVList is a nullable list of strings stored in a text column as delimited values, such as "a,b,c",
+// for legacy schemas. The separator and escape character are set with Options.CSVSeparator
+// and Options.CSVEscape; an item containing either is written with it escaped, such as `a\,b`.
+// A blank column is an empty list, not null.
+// It marshals to a JSON array, and will marshal to null if null.
+type CSVList struct {
+	Items []string
+	Valid bool
+}
+
+// NewCSVList creates a new CSVList.
+func NewCSVList(items []string, valid bool) CSVList {
+	return CSVList{
+		Items: items,
+		Valid: valid,
+	}
+}
+
+// CSVListFrom creates a new CSVList that will always be valid.
+func CSVListFrom(items []string) CSVList {
+	return NewCSVList(items, true)
+}
+
+// CSVListFromPtr creates a new CSVList that will be null if items is nil.
+func CSVListFromPtr(items *[]string) CSVList {
+	if items == nil {
+		return NewCSVList(nil, false)
+	}
+	return NewCSVList(*items, true)
+}
+
+// splitCSV splits s at unescaped separators, removing the escape characters.
+func splitCSV(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	opts := config()
+	var items []string
+	var item strings.Builder
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			item.WriteRune(r)
+			escaped = false
+		case r == opts.CSVEscape:
+			escaped = true
+		case r == opts.CSVSeparator:
+			items = append(items, item.String())
+			item.Reset()
+		default:
+			item.WriteRune(r)
+		}
+	}
+	if escaped {
+		// a trailing escape character has nothing to escape, so it is kept
+		item.WriteRune(opts.CSVEscape)
+	}
+	return append(items, item.String())
+}
+
+// appendCSV appends items to buf joined by the separator, escaping separators and escape characters.
+func appendCSV(buf []byte, items []string) []byte {
+	opts := config()
+	for i, item := range items {
+		if i > 0 {
+			buf = appendRune(buf, opts.CSVSeparator)
+		}
+		for _, r := range item {
+			if r == opts.CSVSeparator || r == opts.CSVEscape {
+				buf = appendRune(buf, opts.CSVEscape)
+			}
+			buf = appendRune(buf, r)
+		}
+	}
+	return buf
+}
+
+func appendRune(buf []byte, r rune) []byte {
+	return append(buf, string(r)...)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (l CSVList) ValueOrZero() []string {
+	if !l.Valid {
+		return nil
+	}
+	return l.Items
+}
+
+// Scan implements the sql.Scanner interface.
+// It splits a text column at unescaped separators.
+func (l *CSVList) Scan(value any) error {
+	var ns sql.NullString
+	if err := ns.Scan(value); err != nil {
+		l.Items, l.Valid = nil, false
+		return newScanError("null.CSVList", value, err)
+	}
+	if !ns.Valid {
+		l.Items, l.Valid = nil, false
+		return nil
+	}
+	l.Items, l.Valid = splitCSV(ns.String), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the items joined by the separator.
+func (l CSVList) Value() (driver.Value, error) {
+	if !l.Valid {
+		return nil, nil
+	}
+	return string(appendCSV(nil, l.Items)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array of strings and null input.
+func (l *CSVList) UnmarshalJSON(data []byte) error {
+	data = trimJSON("CSVList", data)
+	if bytes.Equal(data, nullBytes) {
+		l.Items, l.Valid = nil, false
+		return nil
+	}
+	var items []string
+	if err := json.Unmarshal(data, &items); err != nil {
+		l.Items, l.Valid = nil, false
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	l.Items, l.Valid = items, true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It splits the input like Scan, and will unmarshal to a null CSVList if the input is blank or "null".
+func (l *CSVList) UnmarshalText(text []byte) error {
+	text = prepareText("CSVList", text)
+	str := string(text)
+	if str == "" || str == "null" {
+		l.Items, l.Valid = nil, false
+		return nil
+	}
+	l.Items, l.Valid = splitCSV(str), true
+	return nil
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (l *CSVList) UnmarshalParam(param string) error {
+	return l.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode a JSON array, or null if this CSVList is null.
+func (l CSVList) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("CSVList", l.Valid, l.marshalJSON)
+}
+
+func (l CSVList) marshalJSON() ([]byte, error) {
+	if !l.Valid {
+		return []byte("null"), nil
+	}
+	if l.Items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(l.Items)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the delimited form, or a blank string if this CSVList is null.
+func (l CSVList) MarshalText() ([]byte, error) {
+	return l.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (l CSVList) AppendText(buf []byte) ([]byte, error) {
+	if !l.Valid {
+		return buf, nil
+	}
+	return appendCSV(buf, l.Items), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this CSVList to v under key, or nothing if null.
+func (l CSVList) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, l.Valid, l.MarshalText)
+}
+
+// SetValid changes this CSVList's value and also sets it to be non-null.
+func (l *CSVList) SetValid(v []string) {
+	l.Items = v
+	l.Valid = true
+}
+
+// Ptr returns a pointer to this CSVList's value, or a nil pointer if this CSVList is null.
+func (l CSVList) Ptr() *[]string {
+	if !l.Valid {
+		return nil
+	}
+	return &l.Items
+}
+
+// IsZero returns true for null CSVLists, for potential future omitempty support.
+func (l CSVList) IsZero() bool {
+	return !l.Valid
+}
+
+// IsNull returns true if this CSVList is null.
+func (l CSVList) IsNull() bool {
+	return !l.Valid
+}
+
+// SetNull sets this CSVList to null and clears its value.
+func (l *CSVList) SetNull() {
+	l.Items, l.Valid = nil, false
+}
+
+// Reset sets this CSVList to its zero value, which is null.
+func (l *CSVList) Reset() {
+	*l = CSVList{}
+}
+
+// Equal returns true if both CSVLists have the same items in the same order or are both null.
+func (l CSVList) Equal(other CSVList) bool {
+	return l.Valid == other.Valid && (!l.Valid || slices.Equal(l.Items, other.Items))
+}
+
+// Merge returns this CSVList if it is valid, otherwise other.
+func (l CSVList) Merge(other CSVList) CSVList {
+	if l.Valid {
+		return l
+	}
+	return other
+}
+
+// When returns this CSVList if cond is true, otherwise a null CSVList.
+func (l CSVList) When(cond bool) CSVList {
+	if !cond {
+		l.SetNull()
+	}
+	return l
+}
@@ -0,0 +1,88 @@
+package null
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestCSVListScanValue(t *testing.T) {
+	tests := []struct {
+		column string
+		items  []string
+	}{
+		{"a,b,c", []string{"a", "b", "c"}},
+		{`a\,b,c\\d`, []string{"a,b", `c\d`}},
+		{"a,,b", []string{"a", "", "b"}},
+		{"", []string{}},
+	}
+	for _, tc := range tests {
+		var l CSVList
+		err := l.Scan([]byte(tc.column))
+		maybePanic(err)
+		if !l.Valid || !slices.Equal(l.Items, tc.items) {
+			t.Errorf("Scan(%q) = %+v, want %q", tc.column, l, tc.items)
+		}
+		v, err := l.Value()
+		maybePanic(err)
+		if v != tc.column {
+			t.Errorf("Value of %q = %v, want %q", tc.items, v, tc.column)
+		}
+	}
+
+	var l CSVList
+	err := l.Scan(nil)
+	maybePanic(err)
+	if l.Valid {
+		t.Errorf("Scan(nil) = %+v", l)
+	}
+	if v, _ := l.Value(); v != nil {
+		t.Errorf("null Value = %v", v)
+	}
+}
+
+func TestCSVListOptions(t *testing.T) {
+	defer configured.Store(nil)
+	Configure(Options{CSVSeparator: ';', CSVEscape: '^'})
+
+	var l CSVList
+	err := l.Scan("a;b^;c;d,e")
+	maybePanic(err)
+	if want := []string{"a", "b;c", "d,e"}; !slices.Equal(l.Items, want) {
+		t.Errorf("Scan = %q, want %q", l.Items, want)
+	}
+	text, err := l.MarshalText()
+	maybePanic(err)
+	if string(text) != "a;b^;c;d,e" {
+		t.Errorf("MarshalText = %q", text)
+	}
+}
+
+func TestCSVListJSON(t *testing.T) {
+	var l CSVList
+	err := json.Unmarshal([]byte(`["x","y,z"]`), &l)
+	maybePanic(err)
+	if !l.Valid || !slices.Equal(l.Items, []string{"x", "y,z"}) {
+		t.Errorf("UnmarshalJSON = %+v", l)
+	}
+	data, err := json.Marshal(l)
+	maybePanic(err)
+	assertJSONEquals(t, data, `["x","y,z"]`, "CSVList")
+
+	data, err = json.Marshal(CSVListFrom(nil))
+	maybePanic(err)
+	assertJSONEquals(t, data, `[]`, "empty CSVList")
+
+	err = json.Unmarshal(nullJSON, &l)
+	maybePanic(err)
+	if l.Valid {
+		t.Errorf("UnmarshalJSON(null) = %+v", l)
+	}
+	data, err = json.Marshal(l)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null CSVList")
+
+	if err := json.Unmarshal([]byte(`"a,b"`), &l); err == nil {
+		t.Error("expected error for string input")
+	}
+}
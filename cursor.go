@@ -0,0 +1,45 @@
+package null
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// EncodeCursor returns an opaque, URL-safe cursor holding the values of fields, such as the
+// sort keys of the last row of a page for keyset pagination. Null values stay null when the
+// cursor is decoded. It returns an error if a field cannot be marshaled to JSON.
+//
+// Cursors are only encoded, not signed or encrypted: clients can read them and make their own,
+// so treat decoded values as untrusted input, like any other query parameter.
+func EncodeCursor(fields ...any) (string, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return "", fmt.Errorf("null: EncodeCursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor stores the values of a cursor made by EncodeCursor in dst,
+// which must be pointers to the types that were encoded, in the same order.
+// It returns an error wrapping ErrInvalidCursor if the cursor is malformed
+// or does not hold len(dst) values.
+func DecodeCursor(cursor string, dst ...any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	var fields []json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	if len(fields) != len(dst) {
+		return fmt.Errorf("%w: has %d values, want %d", ErrInvalidCursor, len(fields), len(dst))
+	}
+	for i, field := range fields {
+		if err := json.Unmarshal(field, dst[i]); err != nil {
+			return fmt.Errorf("%w: value %d: %w", ErrInvalidCursor, i, err)
+		}
+	}
+	return nil
+}
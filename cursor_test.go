@@ -0,0 +1,48 @@
+package null
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	cursor, err := EncodeCursor(DateStringFrom("2024-03-01"), IntFrom(42), NewString("", false))
+	maybePanic(err)
+	if strings.ContainsAny(cursor, "+/=") {
+		t.Errorf("cursor should be URL-safe: %s", cursor)
+	}
+
+	var (
+		date DateString
+		id   Int
+		name = StringFrom("stale")
+	)
+	err = DecodeCursor(cursor, &date, &id, &name)
+	maybePanic(err)
+	if date.String != "2024-03-01" || !date.Valid {
+		t.Errorf("date = %+v", date)
+	}
+	if !id.Valid || id.Int64 != 42 {
+		t.Errorf("id = %+v", id)
+	}
+	assertNullStr(t, name, "name")
+
+	short, err := EncodeCursor(1)
+	maybePanic(err)
+	for _, bad := range []string{"not base64!", short, "e30"} {
+		if err := DecodeCursor(bad, &date, &id); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("DecodeCursor(%q): expected ErrInvalidCursor, got %v", bad, err)
+		}
+	}
+	mismatched, err := EncodeCursor(1, "x")
+	maybePanic(err)
+	if err := DecodeCursor(mismatched, &date, &id); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("wrong types: expected ErrInvalidCursor, got %v", err)
+	}
+
+	if _, err := EncodeCursor(FloatFrom(math.NaN())); err == nil {
+		t.Error("EncodeCursor of NaN should fail")
+	}
+}
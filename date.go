@@ -5,12 +5,14 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"strings"
+	"net/url"
 	"time"
 )
 
 var (
 	// FormatDate Set default Format DateString
+	//
+	// Deprecated: Use Configure with Options.DateFormat. It is ignored once Configure has been called.
 	FormatDate = "2006-01-02"
 )
 
@@ -30,11 +32,12 @@ func NewDateString(s string, valid bool) DateString {
 }
 
 // DateStringFrom creates a new String that will never be blank.
+// Full timestamps, such as "2024-01-02T15:04:05Z", are truncated to their date.
+// It will be null if s is not a date or timestamp.
 func DateStringFrom(s string) DateString {
-	if t, err := time.Parse(FormatDate, s); err == nil {
-		return NewDateString(t.Format(FormatDate), true)
-	}
-	return NewDateString(s, false)
+	ds := NewDateString(s, true)
+	ds.Valid = ds.normalize("From")
+	return ds
 }
 
 // DateStringFromPtr creates a new String that be null if s is nil.
@@ -42,17 +45,193 @@ func DateStringFromPtr(s *string) DateString {
 	if s == nil {
 		return NewDateString("", false)
 	}
-	if t, err := time.Parse(FormatDate, *s); err == nil {
-		return NewDateString(t.Format(FormatDate), true)
+	return DateStringFrom(*s)
+}
+
+// dateTimeLayouts are the timestamp layouts that parseDate accepts besides DateFormat.
+// Fractional seconds are accepted by all of them.
+var dateTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+}
+
+// parseDate parses s in the DateFormat layout or as a full timestamp,
+// and checks it against DateRule if StrictDates is set.
+func parseDate(s string) (time.Time, error) {
+	layout := config().DateFormat
+	if layout == time.DateOnly {
+		if t, ok := parseDateOnly(s); ok {
+			if err := checkDate(t); err != nil {
+				return time.Time{}, err
+			}
+			return t, nil
+		}
+	}
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		for _, layout := range dateTimeLayouts {
+			if lt, lerr := time.Parse(layout, s); lerr == nil {
+				t, err = lt, nil
+				break
+			}
+		}
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := checkDate(t); err != nil {
+		return time.Time{}, err
+	}
+	return t, nil
+}
+
+// parseDateOnly parses s in the default "2006-01-02" layout like time.Parse, but much faster.
+// It returns false for any other input, which is left to time.Parse to parse or explain.
+func parseDateOnly(s string) (time.Time, bool) {
+	if len(s) != len(time.DateOnly) || s[4] != '-' || s[7] != '-' {
+		return time.Time{}, false
+	}
+	year, ok1 := atoiDigits(s[0:4])
+	month, ok2 := atoiDigits(s[5:7])
+	day, ok3 := atoiDigits(s[8:10])
+	if !ok1 || !ok2 || !ok3 || month < 1 || month > 12 || day < 1 || day > daysIn(time.Month(month), year) {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), true
+}
+
+// atoiDigits parses s, which must consist only of ASCII digits.
+func atoiDigits(s string) (int, bool) {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n, true
+}
+
+// daysIn returns the number of days in month of year.
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Normalize reparses this DateString's value, which may be a full timestamp such as
+// "2024-01-02T00:00:00Z", and rewrites it as a date in the DateFormat layout.
+// A null DateString is left unchanged. If the value is not a date or timestamp,
+// it is left unchanged and the returned error wraps ErrInvalidDate.
+func (s *DateString) Normalize() error {
+	if !s.Valid {
+		return nil
+	}
+	t, err := parseDate(s.String)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidDate, err)
+	}
+	s.String = t.Format(config().DateFormat)
+	return nil
+}
+
+// date parses this DateString's value, and returns false if it is null or not a date.
+func (s DateString) date() (time.Time, bool) {
+	if !s.Valid {
+		return time.Time{}, false
+	}
+	t, err := parseDate(s.String)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Format returns this DateString's date formatted with layout, such as "02 Jan 2006".
+// ok is false if this DateString is null or its value is not a date.
+func (s DateString) Format(layout string) (formatted string, ok bool) {
+	t, ok := s.date()
+	if !ok {
+		return "", false
+	}
+	return t.Format(layout), true
+}
+
+// MustFormat is like Format but panics if this DateString is null or its value is not a date.
+func (s DateString) MustFormat(layout string) string {
+	formatted, ok := s.Format(layout)
+	if !ok {
+		panic(fmt.Sprintf("null: cannot format DateString %q", s.String))
+	}
+	return formatted
+}
+
+// Year returns the year of this DateString's date.
+// ok is false if this DateString is null or its value is not a date.
+func (s DateString) Year() (year int, ok bool) {
+	t, ok := s.date()
+	if !ok {
+		return 0, false
+	}
+	return t.Year(), true
+}
+
+// MonthOf returns the month of this DateString's date.
+// ok is false if this DateString is null or its value is not a date.
+func (s DateString) MonthOf() (month time.Month, ok bool) {
+	t, ok := s.date()
+	if !ok {
+		return 0, false
+	}
+	return t.Month(), true
+}
+
+// Day returns the day of the month of this DateString's date.
+// ok is false if this DateString is null or its value is not a date.
+func (s DateString) Day() (day int, ok bool) {
+	t, ok := s.date()
+	if !ok {
+		return 0, false
+	}
+	return t.Day(), true
+}
+
+// Weekday returns the day of the week of this DateString's date.
+// ok is false if this DateString is null or its value is not a date.
+func (s DateString) Weekday() (weekday time.Weekday, ok bool) {
+	t, ok := s.date()
+	if !ok {
+		return 0, false
 	}
-	return NewDateString(*s, false)
+	return t.Weekday(), true
 }
 
-func (s DateString) checkValid() bool {
-	if _, err := time.Parse(FormatDate, s.String); err == nil {
-		return true
+// YearDay returns the day of the year of this DateString's date, in [1, 365] or [1, 366] in leap years.
+// ok is false if this DateString is null or its value is not a date.
+func (s DateString) YearDay() (day int, ok bool) {
+	t, ok := s.date()
+	if !ok {
+		return 0, false
 	}
-	return false
+	return t.YearDay(), true
+}
+
+// normalize rewrites the value received by op in the DateFormat layout
+// and reports whether it is a valid date.
+func (s *DateString) normalize(op string) bool {
+	t, err := parseDate(s.String)
+	if err != nil {
+		if s.String != "" {
+			coerced("DateString", op, s.String, err)
+		}
+		return false
+	}
+	// a date in the default layout is already in its canonical form
+	if layout := config().DateFormat; layout != time.DateOnly || len(s.String) != len(time.DateOnly) {
+		s.String = t.Format(layout)
+	}
+	return true
 }
 
 // ValueOrZero returns the inner value if valid, otherwise zero.
@@ -63,30 +242,66 @@ func (s DateString) ValueOrZero() string {
 	return s.String
 }
 
+// Scan implements the sql.Scanner interface.
+// It supports date, timestamp and text columns, truncating timestamps to their date.
+// Values that are not dates produce a null DateString.
+// It returns a *ScanError if value cannot be converted.
+func (s *DateString) Scan(value any) error {
+	if t, ok := value.(time.Time); ok {
+		if err := checkDate(t); err != nil {
+			coerced("DateString", "Scan", t.String(), err)
+			s.String, s.Valid = "", false
+			return nil
+		}
+		s.String, s.Valid = internString(t.Format(config().DateFormat)), true
+		return nil
+	}
+	if err := s.NullString.Scan(value); err != nil {
+		s.Valid = false
+		return newScanError("null.DateString", value, err)
+	}
+	if s.Valid {
+		if s.Valid = s.normalize("Scan"); s.Valid {
+			s.String = internString(s.String)
+		}
+	}
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string and null input. Blank string input does not produce a null String.
+// It supports string and null input. Input that is not a date produces a null DateString.
+// Blank string input is kept in String unless EmptyStringAsNull is set.
 func (s *DateString) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "String")
+	data = trimJSON("DateString", data)
 	if bytes.Equal(data, nullBytes) {
 		s.Valid = false
 		return nil
 	}
 
 	if err := json.Unmarshal(data, &s.String); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
 	}
 
-	s.Valid = s.checkValid()
+	if s.String == "" && config().EmptyStringAsNull {
+		s.Valid = false
+		return nil
+	}
+	s.Valid = s.normalize("UnmarshalJSON")
 	return nil
 }
 
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this String is null.
 func (s DateString) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("DateString", s.Valid, s.marshalJSON)
+}
+
+func (s DateString) marshalJSON() ([]byte, error) {
 	if !s.Valid {
 		return []byte("null"), nil
 	}
-	s.String = strings.Split(s.String, "T")[0]
-	return json.Marshal(s.String)
+	return marshalJSONString(s.String)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -95,18 +310,64 @@ func (s DateString) MarshalText() ([]byte, error) {
 	if !s.Valid {
 		return []byte{}, nil
 	}
-	s.String = strings.Split(s.String, "T")[0]
 	return []byte(s.String), nil
 }
 
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (s DateString) AppendText(buf []byte) ([]byte, error) {
+	if !s.Valid {
+		return buf, nil
+	}
+	return append(buf, s.String...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this DateString to v under key, or nothing if null.
+func (s DateString) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, s.Valid, s.MarshalText)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s DateString) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(make([]byte, 0, 1+len(s.String)))
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// It appends the same bytes as MarshalBinary to buf.
+func (s DateString) AppendBinary(buf []byte) ([]byte, error) {
+	buf = appendBinaryFlag(buf, s.Valid)
+	if !s.Valid {
+		return buf, nil
+	}
+	return append(buf, s.String...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *DateString) UnmarshalBinary(data []byte) error {
+	payload, valid, err := binaryPayload("DateString", data, -1)
+	if err != nil {
+		return err
+	}
+	s.String, s.Valid = string(payload), valid
+	return nil
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null String if the input is a blank string.
 func (s *DateString) UnmarshalText(text []byte) error {
+	text = prepareText("DateString", text)
 	s.String = string(text)
-	s.Valid = s.checkValid()
+	s.Valid = s.normalize("UnmarshalText")
 	return nil
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (s *DateString) UnmarshalParam(param string) error {
+	return s.UnmarshalText([]byte(param))
+}
+
 // SetValid changes this String's value and also sets it to be non-null.
 func (s *DateString) SetValid(v string) {
 	s.String = v
@@ -126,7 +387,38 @@ func (s DateString) IsZero() bool {
 	return !s.Valid
 }
 
+// IsNull returns true if this DateString is null.
+func (s DateString) IsNull() bool {
+	return !s.Valid
+}
+
+// SetNull sets this DateString to null and clears its value.
+func (s *DateString) SetNull() {
+	s.String, s.Valid = "", false
+}
+
+// Reset sets this DateString to its zero value, which is null.
+func (s *DateString) Reset() {
+	*s = DateString{}
+}
+
 // Equal returns true if both strings have the same value or are both null.
 func (s DateString) Equal(other DateString) bool {
 	return s.Valid == other.Valid && (!s.Valid || s.String == other.String)
 }
+
+// Merge returns this DateString if it is valid, otherwise other.
+func (s DateString) Merge(other DateString) DateString {
+	if s.Valid {
+		return s
+	}
+	return other
+}
+
+// When returns this DateString if cond is true, otherwise a null DateString.
+func (s DateString) When(cond bool) DateString {
+	if !cond {
+		s.SetNull()
+	}
+	return s
+}
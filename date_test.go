@@ -0,0 +1,155 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDateStringNormalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"2024-01-02", "2024-01-02"},
+		{"2024-01-02T00:00:00Z", "2024-01-02"},
+		{"2024-01-02T23:59:59.123+07:00", "2024-01-02"},
+		{"2024-01-02T10:00:00", "2024-01-02"},
+		{"2024-01-02 10:00:00", "2024-01-02"},
+		{"2024-01-02 10:00:00.5+00:00", "2024-01-02"},
+	}
+	for _, tc := range tests {
+		ds := NewDateString(tc.in, true)
+		if err := ds.Normalize(); err != nil {
+			t.Errorf("Normalize(%q): unexpected error: %v", tc.in, err)
+			continue
+		}
+		if !ds.Valid || ds.String != tc.want {
+			t.Errorf("Normalize(%q) = %+v, want %q", tc.in, ds, tc.want)
+		}
+	}
+
+	ds := NewDateString("yesterday", true)
+	if err := ds.Normalize(); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("expected ErrInvalidDate, got %v", err)
+	}
+	if !ds.Valid || ds.String != "yesterday" {
+		t.Errorf("invalid value should be left unchanged: %+v", ds)
+	}
+
+	ds = NewDateString("", false)
+	if err := ds.Normalize(); err != nil || ds.Valid {
+		t.Errorf("null DateString should be left unchanged: %+v %v", ds, err)
+	}
+}
+
+func TestDateStringTruncate(t *testing.T) {
+	ds := DateStringFrom("2024-01-02T15:04:05Z")
+	if !ds.Valid || ds.String != "2024-01-02" {
+		t.Errorf("DateStringFrom should truncate timestamps: %+v", ds)
+	}
+
+	err := ds.Scan("2024-03-04 05:06:07")
+	maybePanic(err)
+	if !ds.Valid || ds.String != "2024-03-04" {
+		t.Errorf("Scan should truncate timestamps: %+v", ds)
+	}
+
+	err = ds.Scan(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC))
+	maybePanic(err)
+	if !ds.Valid || ds.String != "2024-05-06" {
+		t.Errorf("Scan should accept time.Time: %+v", ds)
+	}
+
+	err = ds.Scan([]byte("not a date"))
+	maybePanic(err)
+	if ds.Valid {
+		t.Errorf("Scan should produce null for non-dates: %+v", ds)
+	}
+
+	err = ds.UnmarshalText([]byte("2024-07-08T00:00:00+07:00"))
+	maybePanic(err)
+	if !ds.Valid || ds.String != "2024-07-08" {
+		t.Errorf("UnmarshalText should truncate timestamps: %+v", ds)
+	}
+
+	// the value is stored truncated, so marshaling does not rewrite it
+	data, err := json.Marshal(ds)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2024-07-08"`, "truncated date")
+}
+
+func TestDateStringFormat(t *testing.T) {
+	ds := DateStringFrom("2024-03-04")
+	if got, ok := ds.Format("02 Jan 2006"); !ok || got != "04 Mar 2024" {
+		t.Errorf("Format = %q, %v", got, ok)
+	}
+	if got := ds.MustFormat("Jan 2, 2006"); got != "Mar 4, 2024" {
+		t.Errorf("MustFormat = %q", got)
+	}
+
+	for _, ds := range []DateString{NewDateString("", false), NewDateString("soon", true)} {
+		if got, ok := ds.Format("02 Jan 2006"); ok || got != "" {
+			t.Errorf("Format(%+v) = %q, %v; want \"\", false", ds, got, ok)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustFormat of null should panic")
+		}
+	}()
+	NewDateString("", false).MustFormat("02 Jan 2006")
+}
+
+func TestDateStringAccessors(t *testing.T) {
+	ds := DateStringFrom("2024-12-31")
+	if y, ok := ds.Year(); !ok || y != 2024 {
+		t.Errorf("Year = %d, %v", y, ok)
+	}
+	if m, ok := ds.MonthOf(); !ok || m != time.December {
+		t.Errorf("MonthOf = %v, %v", m, ok)
+	}
+	if d, ok := ds.Day(); !ok || d != 31 {
+		t.Errorf("Day = %d, %v", d, ok)
+	}
+	if wd, ok := ds.Weekday(); !ok || wd != time.Tuesday {
+		t.Errorf("Weekday = %v, %v", wd, ok)
+	}
+	if yd, ok := ds.YearDay(); !ok || yd != 366 {
+		t.Errorf("YearDay = %d, %v", yd, ok)
+	}
+
+	for _, ds := range []DateString{NewDateString("", false), NewDateString("soon", true)} {
+		if _, ok := ds.Year(); ok {
+			t.Errorf("Year(%+v): expected ok to be false", ds)
+		}
+		if _, ok := ds.MonthOf(); ok {
+			t.Errorf("MonthOf(%+v): expected ok to be false", ds)
+		}
+		if _, ok := ds.Day(); ok {
+			t.Errorf("Day(%+v): expected ok to be false", ds)
+		}
+		if _, ok := ds.Weekday(); ok {
+			t.Errorf("Weekday(%+v): expected ok to be false", ds)
+		}
+		if _, ok := ds.YearDay(); ok {
+			t.Errorf("YearDay(%+v): expected ok to be false", ds)
+		}
+	}
+}
+
+func TestParseDateOnly(t *testing.T) {
+	inputs := []string{
+		"2024-01-02", "2024-02-29", "2023-02-29", "2024-04-31", "2024-00-10", "2024-13-01",
+		"0001-01-01", "9999-12-31", "2024-1-02", "2024/01/02", "20x4-01-02", "2024-01-0a", "+024-01-02",
+	}
+	for _, in := range inputs {
+		want, err := time.Parse(time.DateOnly, in)
+		got, ok := parseDateOnly(in)
+		if ok != (err == nil) || !got.Equal(want) {
+			t.Errorf("parseDateOnly(%q) = %v, %v; time.Parse = %v, %v", in, got, ok, want, err)
+		}
+	}
+}
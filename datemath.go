@@ -0,0 +1,148 @@
+package null
+
+import "time"
+
+// AgeYears returns the number of whole years from this DateString's date, such as a birth date,
+// to the date of asOf, defaulting to the clock set with SetClock.
+// Someone born on February 29 turns a year older on March 1 in non-leap years.
+// ok is false if this DateString is null, its value is not a date, or it is after asOf.
+func (s DateString) AgeYears(asOf ...time.Time) (years int, ok bool) {
+	born, ok := s.date()
+	if !ok {
+		return 0, false
+	}
+	now := nowOr(asOf)
+	years = now.Year() - born.Year()
+	if now.Month() < born.Month() || (now.Month() == born.Month() && now.Day() < born.Day()) {
+		years--
+	}
+	if years < 0 {
+		return 0, false
+	}
+	return years, true
+}
+
+// BusinessCalendar reports which dates are business days, excluding weekends and holidays.
+// Implement it to compute due dates and settlement dates with DateString.AddBusinessDays.
+type BusinessCalendar interface {
+	IsBusinessDay(date time.Time) bool
+}
+
+// BusinessCalendarFunc adapts a function to a BusinessCalendar.
+type BusinessCalendarFunc func(date time.Time) bool
+
+// IsBusinessDay calls f(date).
+func (f BusinessCalendarFunc) IsBusinessDay(date time.Time) bool {
+	return f(date)
+}
+
+// Weekdays is a BusinessCalendar of Monday to Friday, without holidays.
+// It is used when a nil BusinessCalendar is passed.
+var Weekdays BusinessCalendar = BusinessCalendarFunc(func(date time.Time) bool {
+	return date.Weekday() != time.Saturday && date.Weekday() != time.Sunday
+})
+
+// AddBusinessDays returns the date n business days of cal after this DateString's date,
+// or before it if n is negative. A nil cal counts Monday to Friday.
+// It returns a null DateString if this DateString is null or its value is not a date,
+// or if cal has fewer than n business days in the next 366*n days, such as a calendar
+// of holidays only, which would otherwise never finish.
+func (s DateString) AddBusinessDays(n int, cal BusinessCalendar) DateString {
+	t, ok := s.date()
+	if !ok {
+		return NewDateString("", false)
+	}
+	if cal == nil {
+		cal = Weekdays
+	}
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for limit := 366 * n; n > 0; limit-- {
+		if limit == 0 {
+			return NewDateString("", false)
+		}
+		t = t.AddDate(0, 0, step)
+		if cal.IsBusinessDay(t) {
+			n--
+		}
+	}
+	return NewDateString(t.Format(config().DateFormat), true)
+}
+
+// BusinessDaysBetween returns the number of business days of cal from this DateString's date
+// to other, such that s.AddBusinessDays(n, cal) is other when other is a business day.
+// It is negative if other is before this date. A nil cal counts Monday to Friday.
+// ok is false if either DateString is null or its value is not a date.
+func (s DateString) BusinessDaysBetween(other DateString, cal BusinessCalendar) (n int, ok bool) {
+	from, ok := s.date()
+	if !ok {
+		return 0, false
+	}
+	to, ok := other.date()
+	if !ok {
+		return 0, false
+	}
+	if cal == nil {
+		cal = Weekdays
+	}
+	if to.Before(from) {
+		// count [to, from) so that AddBusinessDays(-n) lands on to
+		for t := to; t.Before(from); t = t.AddDate(0, 0, 1) {
+			if cal.IsBusinessDay(t) {
+				n--
+			}
+		}
+		return n, true
+	}
+	for t := from.AddDate(0, 0, 1); !t.After(to); t = t.AddDate(0, 0, 1) {
+		if cal.IsBusinessDay(t) {
+			n++
+		}
+	}
+	return n, true
+}
+
+// withDate returns fn applied to this DateString's date, or a null DateString
+// if it is null or its value is not a date.
+func (s DateString) withDate(fn func(time.Time) time.Time) DateString {
+	t, ok := s.date()
+	if !ok {
+		return NewDateString("", false)
+	}
+	return NewDateString(fn(t).Format(config().DateFormat), true)
+}
+
+// StartOfMonth returns the first day of this DateString's month.
+// It returns a null DateString if this DateString is null or its value is not a date.
+func (s DateString) StartOfMonth() DateString {
+	return s.withDate(func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	})
+}
+
+// EndOfMonth returns the last day of this DateString's month.
+// It returns a null DateString if this DateString is null or its value is not a date.
+func (s DateString) EndOfMonth() DateString {
+	return s.withDate(func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+	})
+}
+
+// StartOfWeek returns the last date on or before this DateString's date that falls on weekStart,
+// such as time.Monday. It returns a null DateString if this DateString is null or its value is not a date.
+func (s DateString) StartOfWeek(weekStart time.Weekday) DateString {
+	return s.withDate(func(t time.Time) time.Time {
+		back := (int(t.Weekday()) - int(weekStart) + 7) % 7
+		return t.AddDate(0, 0, -back)
+	})
+}
+
+// StartOfYear returns January 1 of this DateString's year.
+// It returns a null DateString if this DateString is null or its value is not a date.
+func (s DateString) StartOfYear() DateString {
+	return s.withDate(func(t time.Time) time.Time {
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	})
+}
@@ -0,0 +1,117 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateStringAgeYears(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 12, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		born string
+		asOf time.Time
+		want int
+		ok   bool
+	}{
+		{"1990-06-15", date(2024, 6, 14), 33, true},
+		{"1990-06-15", date(2024, 6, 15), 34, true},
+		{"1990-06-15", date(2024, 12, 31), 34, true},
+		{"2000-02-29", date(2023, 2, 28), 22, true},
+		{"2000-02-29", date(2023, 3, 1), 23, true},
+		{"2000-02-29", date(2024, 2, 28), 23, true},
+		{"2000-02-29", date(2024, 2, 29), 24, true},
+		{"2024-01-01", date(2024, 1, 1), 0, true},
+		{"2024-01-02", date(2024, 1, 1), 0, false},
+	}
+	for _, tc := range tests {
+		got, ok := DateStringFrom(tc.born).AgeYears(tc.asOf)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("AgeYears(%s, %s) = %d, %v; want %d, %v", tc.born, tc.asOf.Format("2006-01-02"), got, ok, tc.want, tc.ok)
+		}
+	}
+
+	if _, ok := NewDateString("", false).AgeYears(); ok {
+		t.Error("null DateString should not have an age")
+	}
+	if got, ok := DateStringFrom("1970-01-01").AgeYears(); !ok || got < 50 {
+		t.Errorf("default asOf: got %d, %v", got, ok)
+	}
+}
+
+func TestDateStringBusinessDays(t *testing.T) {
+	// Songkran holidays falling on weekdays in 2024
+	holidays := map[string]bool{"2024-04-15": true, "2024-04-16": true}
+	songkran := BusinessCalendarFunc(func(date time.Time) bool {
+		return Weekdays.IsBusinessDay(date) && !holidays[date.Format("2006-01-02")]
+	})
+
+	tests := []struct {
+		from string
+		n    int
+		cal  BusinessCalendar
+		want string
+	}{
+		{"2024-04-10", 0, nil, "2024-04-10"},
+		{"2024-04-10", 1, nil, "2024-04-11"},
+		{"2024-04-11", 2, nil, "2024-04-15"},
+		{"2024-04-13", 1, nil, "2024-04-15"},
+		{"2024-04-11", 2, songkran, "2024-04-17"},
+		{"2024-04-17", -2, songkran, "2024-04-11"},
+		{"2024-04-15", -1, nil, "2024-04-12"},
+	}
+	for _, tc := range tests {
+		from := DateStringFrom(tc.from)
+		got := from.AddBusinessDays(tc.n, tc.cal)
+		if !got.Valid || got.String != tc.want {
+			t.Errorf("AddBusinessDays(%s, %d) = %+v, want %s", tc.from, tc.n, got, tc.want)
+		}
+		n, ok := from.BusinessDaysBetween(got, tc.cal)
+		if !ok || n != tc.n {
+			t.Errorf("BusinessDaysBetween(%s, %s) = %d, %v; want %d", tc.from, tc.want, n, ok, tc.n)
+		}
+	}
+
+	if n, ok := DateStringFrom("2024-04-13").BusinessDaysBetween(DateStringFrom("2024-04-14"), nil); !ok || n != 0 {
+		t.Errorf("weekend: got %d, %v; want 0", n, ok)
+	}
+	null := NewDateString("", false)
+	if got := null.AddBusinessDays(1, nil); got.Valid {
+		t.Errorf("null: got %+v", got)
+	}
+	if _, ok := null.BusinessDaysBetween(DateStringFrom("2024-04-10"), nil); ok {
+		t.Error("null: expected ok to be false")
+	}
+	closed := BusinessCalendarFunc(func(time.Time) bool { return false })
+	if got := DateStringFrom("2024-04-10").AddBusinessDays(-2, closed); got.Valid {
+		t.Errorf("no business days: got %+v", got)
+	}
+}
+
+func TestDateStringPeriods(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(DateString) DateString
+		in   string
+		want string
+	}{
+		{"StartOfMonth", DateString.StartOfMonth, "2024-02-17", "2024-02-01"},
+		{"EndOfMonth", DateString.EndOfMonth, "2024-02-17", "2024-02-29"},
+		{"EndOfMonth", DateString.EndOfMonth, "2023-02-01", "2023-02-28"},
+		{"EndOfMonth", DateString.EndOfMonth, "2024-12-31", "2024-12-31"},
+		{"StartOfYear", DateString.StartOfYear, "2024-07-04", "2024-01-01"},
+		{"StartOfWeek(Monday)", func(s DateString) DateString { return s.StartOfWeek(time.Monday) }, "2024-03-03", "2024-02-26"},
+		{"StartOfWeek(Monday)", func(s DateString) DateString { return s.StartOfWeek(time.Monday) }, "2024-03-04", "2024-03-04"},
+		{"StartOfWeek(Sunday)", func(s DateString) DateString { return s.StartOfWeek(time.Sunday) }, "2024-03-06", "2024-03-03"},
+	}
+	for _, tc := range tests {
+		got := tc.fn(DateStringFrom(tc.in))
+		if !got.Valid || got.String != tc.want {
+			t.Errorf("%s(%s) = %+v, want %s", tc.name, tc.in, got, tc.want)
+		}
+		if got := tc.fn(NewDateString("", false)); got.Valid {
+			t.Errorf("%s(null) = %+v, want null", tc.name, got)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+)
+
+// DeletedAt is a nullable soft-deletion timestamp. It supports SQL and JSON serialization
+// like Time, and is null while the record is not deleted.
+// Its fields match gorm.DeletedAt; use nullgorm.DeletedAt to have GORM filter deleted records.
+type DeletedAt struct {
+	sql.NullTime
+}
+
+// NewDeletedAt creates a new DeletedAt.
+func NewDeletedAt(t time.Time, valid bool) DeletedAt {
+	return DeletedAt{
+		NullTime: sql.NullTime{
+			Time:  t,
+			Valid: valid,
+		},
+	}
+}
+
+// Delete sets this DeletedAt to the current time, from the clock set with SetClock.
+func (d *DeletedAt) Delete() {
+	d.Time, d.Valid = now(), true
+}
+
+// Restore sets this DeletedAt to null, undeleting the record.
+func (d *DeletedAt) Restore() {
+	d.Time, d.Valid = time.Time{}, false
+}
+
+// IsDeleted returns true if this DeletedAt is set.
+func (d DeletedAt) IsDeleted() bool {
+	return d.Valid
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d DeletedAt) ValueOrZero() time.Time {
+	return Time{d.NullTime}.ValueOrZero()
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns a *ScanError if value cannot be converted.
+func (d *DeletedAt) Scan(value any) error {
+	if err := d.NullTime.Scan(value); err != nil {
+		d.Valid = false
+		return newScanError("null.DeletedAt", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (d DeletedAt) Value() (driver.Value, error) {
+	return Time{d.NullTime}.Value()
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this DeletedAt is null.
+func (d DeletedAt) MarshalJSON() ([]byte, error) {
+	return Time{d.NullTime}.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (d *DeletedAt) UnmarshalJSON(data []byte) error {
+	t := Time{d.NullTime}
+	err := t.UnmarshalJSON(data)
+	d.NullTime = t.NullTime
+	return err
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns an empty string if this DeletedAt is null.
+func (d DeletedAt) MarshalText() ([]byte, error) {
+	return Time{d.NullTime}.MarshalText()
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (d DeletedAt) AppendText(buf []byte) ([]byte, error) {
+	return Time{d.NullTime}.AppendText(buf)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null DeletedAt if the input is blank or "null".
+func (d *DeletedAt) UnmarshalText(text []byte) error {
+	t := Time{d.NullTime}
+	err := t.UnmarshalText(text)
+	d.NullTime = t.NullTime
+	return err
+}
+
+// Ptr returns a pointer to this DeletedAt's value, or a nil pointer if this DeletedAt is null.
+func (d DeletedAt) Ptr() *time.Time {
+	return Time{d.NullTime}.Ptr()
+}
+
+// IsZero returns true if this DeletedAt is null, for potential future omitempty support.
+func (d DeletedAt) IsZero() bool {
+	return !d.Valid
+}
+
+// IsNull returns true if this DeletedAt is null.
+func (d DeletedAt) IsNull() bool {
+	return !d.Valid
+}
+
+// SetNull sets this DeletedAt to null and clears its value.
+func (d *DeletedAt) SetNull() {
+	d.Restore()
+}
+
+// Reset sets this DeletedAt to its zero value, which is null.
+func (d *DeletedAt) Reset() {
+	*d = DeletedAt{}
+}
+
+// When returns this DeletedAt if cond is true, otherwise a null DeletedAt.
+func (d DeletedAt) When(cond bool) DeletedAt {
+	if !cond {
+		d.SetNull()
+	}
+	return d
+}
+
+// Equal returns true if both DeletedAts are null, or set to the same instant.
+func (d DeletedAt) Equal(other DeletedAt) bool {
+	return Time{d.NullTime}.Equal(Time{other.NullTime})
+}
@@ -0,0 +1,81 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDeletedAt(t *testing.T) {
+	deleted := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	SetClock(func() time.Time { return deleted })
+	defer SetClock(nil)
+
+	var d DeletedAt
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "not deleted")
+
+	d.Delete()
+	if !d.IsDeleted() || !d.Time.Equal(deleted) {
+		t.Errorf("Delete: %+v", d)
+	}
+	data, err = json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2024-05-01T12:00:00Z"`, "deleted")
+
+	var got DeletedAt
+	err = json.Unmarshal(data, &got)
+	maybePanic(err)
+	if !got.Equal(d) {
+		t.Errorf("round trip: got %+v, want %+v", got, d)
+	}
+
+	d.Restore()
+	if d.IsDeleted() || !d.IsNull() {
+		t.Errorf("Restore: %+v", d)
+	}
+
+	err = d.Scan(deleted)
+	maybePanic(err)
+	if v, _ := d.Value(); v != deleted {
+		t.Errorf("Value = %v, want %v", v, deleted)
+	}
+	if err := d.Scan(42); !errors.Is(err, ErrScanType) || d.Valid {
+		t.Errorf("Scan(42): expected ErrScanType, got %v %+v", err, d)
+	}
+}
+
+func TestDeletedAtText(t *testing.T) {
+	deleted := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	d := NewDeletedAt(deleted, true)
+	text, err := d.MarshalText()
+	maybePanic(err)
+	if string(text) != "2024-05-01T12:00:00Z" {
+		t.Errorf("MarshalText = %q", text)
+	}
+	if buf, _ := d.AppendText([]byte("at ")); string(buf) != "at 2024-05-01T12:00:00Z" {
+		t.Errorf("AppendText = %q", buf)
+	}
+	var got DeletedAt
+	maybePanic(got.UnmarshalText(text))
+	if !got.Equal(d) || *got.Ptr() != deleted {
+		t.Errorf("UnmarshalText = %+v", got)
+	}
+	maybePanic(got.UnmarshalText([]byte("")))
+	if got.Valid || got.Ptr() != nil {
+		t.Errorf("UnmarshalText(blank) = %+v", got)
+	}
+	if text, _ := got.MarshalText(); len(text) != 0 {
+		t.Errorf("null MarshalText = %q", text)
+	}
+
+	if d.When(false).Valid || !d.When(true).Equal(d) {
+		t.Error("When: unexpected result")
+	}
+	d.Reset()
+	if d != (DeletedAt{}) {
+		t.Errorf("Reset = %+v", d)
+	}
+}
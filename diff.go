@@ -0,0 +1,115 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Change is a field that differs between two structs compared by Diff.
+type Change struct {
+	Old any
+	New any
+}
+
+// Diff compares two structs of the same type, or pointers to them, and returns
+// the fields whose validity or value changed, keyed by column name like SetMap.
+// Fields with an Equal method, such as the types in this package, are compared with it,
+// and other fields with reflect.DeepEqual.
+func Diff(old, new any) (map[string]Change, error) {
+	ov, nv := structValue(old), structValue(new)
+	if !ov.IsValid() || !nv.IsValid() {
+		return nil, fmt.Errorf("null: Diff of non-struct %T and %T", old, new)
+	}
+	if ov.Type() != nv.Type() {
+		return nil, fmt.Errorf("null: Diff of different types %s and %s", ov.Type(), nv.Type())
+	}
+
+	changes := make(map[string]Change)
+	for _, f := range dbFields(ov.Type()) {
+		a, b := ov.FieldByIndex(f.index), nv.FieldByIndex(f.index)
+		if !fieldsEqual(a, b) {
+			changes[f.name] = Change{Old: a.Interface(), New: b.Interface()}
+		}
+	}
+	return changes, nil
+}
+
+// structValue returns the struct v holds or points to, or the zero Value if there isn't one.
+func structValue(v any) reflect.Value {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return rv
+}
+
+func fieldsEqual(a, b reflect.Value) bool {
+	if eq := a.MethodByName("Equal"); eq.IsValid() {
+		t := eq.Type()
+		if t.NumIn() == 1 && t.In(0) == a.Type() && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Bool {
+			return eq.Call([]reflect.Value{b})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// patchOp is a JSON Patch (RFC 6902) operation.
+type patchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// GeneratePatch compares two structs of the same type, or pointers to them, and returns
+// a JSON Patch (RFC 6902) turning the JSON encoding of old into that of new.
+// Fields are named like Marshal, honoring the json and null struct tags, and compared like Diff.
+// Changed fields are replaced, so a field that becomes null is replaced with null.
+// Fields that Marshal omits, such as null omitnull fields, are added when they appear
+// and removed when they disappear.
+func GeneratePatch(old, new any) ([]byte, error) {
+	ov, nv := structValue(old), structValue(new)
+	if !ov.IsValid() || !nv.IsValid() {
+		return nil, fmt.Errorf("null: GeneratePatch of non-struct %T and %T", old, new)
+	}
+	if ov.Type() != nv.Type() {
+		return nil, fmt.Errorf("null: GeneratePatch of different types %s and %s", ov.Type(), nv.Type())
+	}
+	fields, err := cachedTagFields(ov.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	ops := []patchOp{}
+	for _, f := range fields {
+		a, b := ov.FieldByIndex(f.index), nv.FieldByIndex(f.index)
+		if fieldsEqual(a, b) {
+			continue
+		}
+		op := patchOp{Op: "replace", Path: "/" + pointerEscaper.Replace(f.name)}
+		switch {
+		case f.omitted(b):
+			op.Op = "remove"
+			ops = append(ops, op)
+			continue
+		case f.omitted(a):
+			op.Op = "add"
+		}
+		data, err := f.marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("null: field %s: %w", f.name, err)
+		}
+		op.Value = data
+		ops = append(ops, op)
+	}
+	return json.Marshal(ops)
+}
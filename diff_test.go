@@ -0,0 +1,99 @@
+package null
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	type account struct {
+		ID      int64  `db:"id"`
+		Email   String `db:"email"`
+		Phone   String `db:"phone"`
+		Balance Float
+		Updated Time
+		Tags    []string
+	}
+	at := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	old := account{
+		ID:      1,
+		Email:   StringFrom("a@example.com"),
+		Balance: FloatFrom(10),
+		Updated: TimeFrom(at),
+		Tags:    []string{"x"},
+	}
+	changed := old
+	changed.Email = NewString("", false)
+	changed.Phone = StringFrom("+66812345678")
+	changed.Updated = TimeFrom(at.In(time.FixedZone("ICT", 7*60*60)))
+	changed.Tags = []string{"x", "y"}
+
+	got, err := Diff(old, &changed)
+	maybePanic(err)
+	want := map[string]Change{
+		"email": {Old: StringFrom("a@example.com"), New: NewString("", false)},
+		"phone": {Old: NewString("", false), New: StringFrom("+66812345678")},
+		"Tags":  {Old: []string{"x"}, New: []string{"x", "y"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %v, want %v", got, want)
+	}
+
+	if _, err := Diff(old, 5); err == nil {
+		t.Error("Diff with a non-struct should fail")
+	}
+	if _, err := Diff(old, struct{}{}); err == nil {
+		t.Error("Diff of different types should fail")
+	}
+}
+
+func TestGeneratePatch(t *testing.T) {
+	type order struct {
+		ID     int64      `json:"id"`
+		Note   String     `json:"note"`
+		Coupon String     `json:"coupon" null:"omitnull"`
+		Total  Float      `json:"total"`
+		Ship   DateString `json:"ship/date" null:"format=02/01/2006"`
+		Same   Int        `json:"same"`
+		Gift   String     `json:"gift"`
+	}
+	old := order{ID: 1, Coupon: StringFrom("NEW10"), Total: FloatFrom(10), Same: IntFrom(1), Gift: StringFrom("wrap")}
+	changed := order{
+		ID:    2,
+		Note:  StringFrom("leave at door"),
+		Total: FloatFrom(9.5),
+		Ship:  DateStringFrom("2024-01-02"),
+		Same:  IntFrom(1),
+	}
+	data, err := GeneratePatch(&old, changed)
+	maybePanic(err)
+	want := `[{"op":"replace","path":"/id","value":2},` +
+		`{"op":"replace","path":"/note","value":"leave at door"},` +
+		`{"op":"remove","path":"/coupon"},` +
+		`{"op":"replace","path":"/total","value":9.5},` +
+		`{"op":"replace","path":"/ship~1date","value":"02/01/2024"},` +
+		`{"op":"replace","path":"/gift","value":null}]`
+	assertJSONEquals(t, data, want, "GeneratePatch")
+
+	data, err = GeneratePatch(old, old)
+	maybePanic(err)
+	assertJSONEquals(t, data, "[]", "GeneratePatch without changes")
+
+	if _, err := GeneratePatch(old, 1); err == nil {
+		t.Error("GeneratePatch with a non-struct should fail")
+	}
+}
+
+func TestGeneratePatchPointer(t *testing.T) {
+	type profile struct {
+		Nick *String `json:"nick,omitempty"`
+		Bio  *String `json:"bio,omitempty"`
+		Site *String `json:"site"`
+	}
+	bio := StringFrom("hello")
+	data, err := GeneratePatch(profile{Bio: &bio, Site: &bio}, profile{Nick: &bio})
+	maybePanic(err)
+	want := `[{"op":"add","path":"/nick","value":"hello"},{"op":"remove","path":"/bio"},{"op":"replace","path":"/site","value":null}]`
+	assertJSONEquals(t, data, want, "GeneratePatch of pointer fields")
+}
@@ -0,0 +1,340 @@
+//go:build nulleasyjson
+
+package null
+
+import (
+	"encoding/json"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func marshalEasyJSON(w *jwriter.Writer, m json.Marshaler) {
+	w.Raw(m.MarshalJSON())
+}
+
+func unmarshalEasyJSON(l *jlexer.Lexer, u json.Unmarshaler) {
+	if data := l.Raw(); l.Ok() {
+		l.AddError(u.UnmarshalJSON(data))
+	}
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (b Bool) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, b)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (b *Bool) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, b)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (b BoundedInt[B]) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, b)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (b *BoundedInt[B]) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, b)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (c Char) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, c)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (c *Char) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, c)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (c Color) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, c)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (c *Color) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, c)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (c CountryCode) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, c)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (c *CountryCode) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, c)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (c Cron) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, c)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (c *Cron) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, c)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (l CSVList) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, l)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (l *CSVList) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, l)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (s DateString) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, s)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (s *DateString) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, s)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (d DeletedAt) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, d)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (d *DeletedAt) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, d)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (e Encrypted) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, e)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (e *Encrypted) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, e)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (e Enum) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, e)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (e *Enum) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, e)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (f Flags) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, f)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (f *Flags) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, f)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (f Float) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, f)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (f *Float) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, f)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (g GzipBytes) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, g)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (g *GzipBytes) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, g)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (i Int) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, i)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (i *Int) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, i)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (i Interval) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, i)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (i *Interval) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, i)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (j JSON) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, j)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (j *JSON) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, j)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (l LanguageTag) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, l)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (l *LanguageTag) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, l)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (m Month) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, m)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (m *Month) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, m)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (n Number) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, n)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (n *Number) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, n)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (o Object[T]) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, o)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (o *Object[T]) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, o)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (p Password) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, p)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (p *Password) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, p)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (p Percent) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, p)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (p *Percent) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, p)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (p Phone) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, p)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (p *Phone) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, p)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (r Regexp) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, r)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (r *Regexp) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, r)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (s Secret) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, s)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (s *Secret) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, s)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (s String) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, s)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (s *String) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, s)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (t Time) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, t)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (t *Time) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, t)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (z TimeZone) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, z)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (z *TimeZone) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, z)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (u UnixMicro) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, u)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (u *UnixMicro) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, u)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (u UnixMilli) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, u)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (u *UnixMilli) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, u)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (d Weekday) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, d)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (d *Weekday) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, d)
+}
@@ -0,0 +1,45 @@
+//go:build nulleasyjson
+
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestEasyJSON(t *testing.T) {
+	values := []easyjson.MarshalerUnmarshaler{
+		&String{}, ptr(StringFrom("a")),
+		&Int{}, ptr(IntFrom(0)),
+		ptr(TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))),
+		ptr(DateStringFrom("2024-01-02")),
+		ptr(UnixMilliFrom(1700000000000)),
+		ptr(CSVListFrom([]string{"a", "b"})),
+		ptr(NumberFrom("0.10")),
+		ptr(BoundedIntFrom[testRating](3)),
+		ptr(ObjectFrom(struct{ A int }{1})),
+	}
+	for _, v := range values {
+		want, err := json.Marshal(v)
+		maybePanic(err)
+		got, err := easyjson.Marshal(v)
+		maybePanic(err)
+		if string(got) != string(want) {
+			t.Errorf("easyjson.Marshal(%T) = %s, want %s", v, got, want)
+		}
+		if err := easyjson.Unmarshal(want, v); err != nil {
+			t.Errorf("easyjson.Unmarshal(%s) into %T: %v", want, v, err)
+		}
+		if again, _ := json.Marshal(v); string(again) != string(want) {
+			t.Errorf("easyjson.Unmarshal(%s) into %T = %s", want, v, again)
+		}
+	}
+
+	var i Int
+	if err := easyjson.Unmarshal([]byte(`"x"`), &i); err == nil {
+		t.Error("expected error for invalid Int")
+	}
+}
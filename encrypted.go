@@ -0,0 +1,233 @@
+package null
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Cipher encrypts and decrypts the values of Encrypted columns, such as with AES-GCM or a KMS.
+// Set it with Options.Cipher. It must be safe for concurrent use.
+type Cipher interface {
+	Encrypt(plaintext []byte) ([]byte, error)
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
+// ErrNoCipher is returned by Encrypted's Scan and Value when Options.Cipher is not set.
+var ErrNoCipher = errors.New("null: no Cipher configured for Encrypted")
+
+// redacted is what MarshalJSON writes for a valid Encrypted when Options.RedactEncrypted is set.
+const redacted = "[REDACTED]"
+
+// Encrypted is a nullable string that is encrypted at rest, such as a column of personal data.
+// String holds the plaintext; Value encrypts it and Scan decrypts it with Options.Cipher.
+// It marshals to JSON as the plaintext, or as "[REDACTED]" if Options.RedactEncrypted is set,
+// and will marshal to null if null.
+type Encrypted struct {
+	sql.NullString
+}
+
+// NewEncrypted creates a new Encrypted.
+func NewEncrypted(s string, valid bool) Encrypted {
+	return Encrypted{
+		NullString: sql.NullString{
+			String: s,
+			Valid:  valid,
+		},
+	}
+}
+
+// EncryptedFrom creates a new Encrypted that will always be valid.
+func EncryptedFrom(s string) Encrypted {
+	return NewEncrypted(s, true)
+}
+
+// EncryptedFromPtr creates a new Encrypted that will be null if s is nil.
+func EncryptedFromPtr(s *string) Encrypted {
+	if s == nil {
+		return NewEncrypted("", false)
+	}
+	return NewEncrypted(*s, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (e Encrypted) ValueOrZero() string {
+	if !e.Valid {
+		return ""
+	}
+	return e.String
+}
+
+// Scan implements the sql.Scanner interface.
+// It decrypts string and []byte input with Options.Cipher, and nil produces a null Encrypted.
+func (e *Encrypted) Scan(value any) error {
+	var ciphertext []byte
+	switch x := value.(type) {
+	case nil:
+		e.String, e.Valid = "", false
+		return nil
+	case []byte:
+		ciphertext = x
+	case string:
+		ciphertext = []byte(x)
+	default:
+		e.String, e.Valid = "", false
+		return newScanError("null.Encrypted", value, nil)
+	}
+	e.String, e.Valid = "", false
+	c := config().Cipher
+	if c == nil {
+		return ErrNoCipher
+	}
+	plaintext, err := c.Decrypt(ciphertext)
+	if err != nil {
+		return fmt.Errorf("null: couldn't decrypt Encrypted: %w", err)
+	}
+	e.String, e.Valid = string(plaintext), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the ciphertext from Options.Cipher as []byte, or nil if null.
+func (e Encrypted) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	c := config().Cipher
+	if c == nil {
+		return nil, ErrNoCipher
+	}
+	ciphertext, err := c.Encrypt([]byte(e.String))
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't encrypt Encrypted: %w", err)
+	}
+	return ciphertext, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports plaintext string and null input.
+func (e *Encrypted) UnmarshalJSON(data []byte) error {
+	data = trimJSON("Encrypted", data)
+	if bytes.Equal(data, nullBytes) {
+		e.String, e.Valid = "", false
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	e.String, e.Valid = str, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Encrypted is null, and "[REDACTED]" if Options.RedactEncrypted is set.
+func (e Encrypted) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Encrypted", e.Valid, e.marshalJSON)
+}
+
+func (e Encrypted) marshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return []byte("null"), nil
+	}
+	if config().RedactEncrypted {
+		return marshalJSONString(redacted)
+	}
+	return marshalJSONString(e.String)
+}
+
+// SetValid changes this Encrypted's value and also sets it to be non-null.
+func (e *Encrypted) SetValid(v string) {
+	e.String = v
+	e.Valid = true
+}
+
+// Ptr returns a pointer to this Encrypted's value, or a nil pointer if this Encrypted is null.
+func (e Encrypted) Ptr() *string {
+	if !e.Valid {
+		return nil
+	}
+	return &e.String
+}
+
+// IsZero returns true for null Encrypted, for potential future omitempty support.
+func (e Encrypted) IsZero() bool {
+	return !e.Valid
+}
+
+// IsNull returns true if this Encrypted is null.
+func (e Encrypted) IsNull() bool {
+	return !e.Valid
+}
+
+// SetNull sets this Encrypted to null and clears its value.
+func (e *Encrypted) SetNull() {
+	e.String, e.Valid = "", false
+}
+
+// Reset sets this Encrypted to its zero value, which is null.
+func (e *Encrypted) Reset() {
+	*e = Encrypted{}
+}
+
+// Equal returns true if both Encrypted have the same plaintext or are both null.
+func (e Encrypted) Equal(other Encrypted) bool {
+	return e.Valid == other.Valid && (!e.Valid || e.String == other.String)
+}
+
+// Merge returns this Encrypted if it is valid, otherwise other.
+func (e Encrypted) Merge(other Encrypted) Encrypted {
+	if e.Valid {
+		return e
+	}
+	return other
+}
+
+// When returns this Encrypted if cond is true, otherwise a null Encrypted.
+func (e Encrypted) When(cond bool) Encrypted {
+	if !cond {
+		e.SetNull()
+	}
+	return e
+}
+
+// aesGCM is a Cipher using AES-GCM, with the random nonce prepended to the ciphertext.
+type aesGCM struct {
+	aead cipher.AEAD
+}
+
+// NewAESGCM returns a Cipher that encrypts with AES-GCM under key,
+// which must be 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256.
+func NewAESGCM(key []byte) (Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return aesGCM{aead: aead}, nil
+}
+
+func (c aesGCM) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(plaintext)+c.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (c aesGCM) Decrypt(ciphertext []byte) ([]byte, error) {
+	n := c.aead.NonceSize()
+	if len(ciphertext) < n {
+		return nil, errors.New("null: ciphertext too short")
+	}
+	return c.aead.Open(nil, ciphertext[:n], ciphertext[n:], nil)
+}
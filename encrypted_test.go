@@ -0,0 +1,70 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestEncrypted(t *testing.T) {
+	e := EncryptedFrom("1-2345-67890-12-3")
+	if _, err := e.Value(); !errors.Is(err, ErrNoCipher) {
+		t.Errorf("no cipher: expected ErrNoCipher, got %v", err)
+	}
+
+	c, err := NewAESGCM(bytes.Repeat([]byte{7}, 32))
+	maybePanic(err)
+	defer configured.Store(nil)
+	Configure(Options{Cipher: c})
+
+	v, err := e.Value()
+	maybePanic(err)
+	if bytes.Contains(v.([]byte), []byte("12345")) {
+		t.Errorf("Value should be encrypted: %q", v)
+	}
+	v2, err := e.Value()
+	maybePanic(err)
+	if bytes.Equal(v.([]byte), v2.([]byte)) {
+		t.Error("each Value should use a fresh nonce")
+	}
+
+	var got Encrypted
+	err = got.Scan(v)
+	maybePanic(err)
+	if !got.Equal(e) {
+		t.Errorf("Scan = %+v, want %+v", got, e)
+	}
+	if err := got.Scan([]byte("not encrypted")); err == nil || got.Valid {
+		t.Errorf("bad ciphertext: got %v %+v", err, got)
+	}
+	err = got.Scan(nil)
+	maybePanic(err)
+	if v, _ := got.Value(); v != nil || got.Valid {
+		t.Errorf("null: %+v", got)
+	}
+
+	data, err := json.Marshal(e)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"1-2345-67890-12-3"`, "plaintext")
+
+	Configure(Options{Cipher: c, RedactEncrypted: true})
+	data, err = json.Marshal(e)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"[REDACTED]"`, "redacted")
+	data, err = json.Marshal(NewEncrypted("", false))
+	maybePanic(err)
+	assertJSONEquals(t, data, `null`, "redacted null")
+
+	err = json.Unmarshal([]byte(`"secret"`), &got)
+	maybePanic(err)
+	if got.String != "secret" || !got.Valid {
+		t.Errorf("UnmarshalJSON = %+v", got)
+	}
+}
+
+func TestNewAESGCM(t *testing.T) {
+	if _, err := NewAESGCM([]byte("short")); err == nil {
+		t.Error("expected an error for an invalid key size")
+	}
+}
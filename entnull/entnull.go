@@ -0,0 +1,108 @@
+// Package entnull declares ent schema fields that hold the types in the null package.
+//
+//	func (User) Fields() []ent.Field {
+//		return []ent.Field{
+//			entnull.String("nickname"),
+//			entnull.Time("deleted_at"),
+//		}
+//	}
+//
+// The fields are optional, so their columns are nullable, and the generated code
+// uses the null types in entities, setters and predicates.
+package entnull
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/attapon-th/null"
+)
+
+// String returns an optional string field holding a null.String.
+func String(name string) ent.Field {
+	return field.String(name).GoType(null.String{}).Optional()
+}
+
+// Int returns an optional int64 field holding a null.Int.
+func Int(name string) ent.Field {
+	return field.Int64(name).GoType(null.Int{}).Optional()
+}
+
+// Float returns an optional float64 field holding a null.Float.
+func Float(name string) ent.Field {
+	return field.Float(name).GoType(null.Float{}).Optional()
+}
+
+// Bool returns an optional bool field holding a null.Bool.
+func Bool(name string) ent.Field {
+	return field.Bool(name).GoType(null.Bool{}).Optional()
+}
+
+// Time returns an optional time field holding a null.Time.
+func Time(name string) ent.Field {
+	return field.Time(name).GoType(null.Time{}).Optional()
+}
+
+// DateString returns an optional string field holding a null.DateString.
+func DateString(name string) ent.Field {
+	return field.String(name).GoType(null.DateString{}).Optional()
+}
+
+// ValueScanner returns a field.TypeValueScanner for a nullable type T,
+// for use with the ValueScanner option of ent fields whose GoType is T:
+//
+//	field.String("status").
+//		GoType(null.Enum{}).
+//		ValueScanner(entnull.ValueScanner[null.Enum]())
+//
+// The database value is converted with T's Value method and scanned with its Scan method.
+func ValueScanner[T driver.Valuer, PT interface {
+	*T
+	sql.Scanner
+}]() field.TypeValueScanner[T] {
+	return valueScanner[T, PT]{}
+}
+
+type valueScanner[T driver.Valuer, PT interface {
+	*T
+	sql.Scanner
+}] struct{}
+
+func (valueScanner[T, PT]) Value(v T) (driver.Value, error) {
+	return v.Value()
+}
+
+func (valueScanner[T, PT]) ScanValue() field.ValueScanner {
+	return new(rawValue)
+}
+
+func (valueScanner[T, PT]) FromValue(v driver.Value) (T, error) {
+	var t T
+	raw, ok := v.(*rawValue)
+	if !ok {
+		return t, fmt.Errorf("entnull: unexpected input for FromValue: %T", v)
+	}
+	err := PT(&t).Scan(raw.v)
+	return t, err
+}
+
+// rawValue holds a scanned database value until FromValue converts it.
+type rawValue struct {
+	v any
+}
+
+func (r *rawValue) Scan(src any) error {
+	if b, ok := src.([]byte); ok {
+		// drivers may reuse the buffer after Scan returns
+		src = append([]byte(nil), b...)
+	}
+	r.v = src
+	return nil
+}
+
+func (r *rawValue) Value() (driver.Value, error) {
+	return r.v, nil
+}
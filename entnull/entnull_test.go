@@ -0,0 +1,54 @@
+package entnull
+
+import (
+	"testing"
+
+	"entgo.io/ent"
+	"github.com/attapon-th/null"
+)
+
+func TestFields(t *testing.T) {
+	for _, f := range []ent.Field{
+		String("s"), Int("i"), Float("f"), Bool("b"), Time("t"), DateString("d"),
+	} {
+		desc := f.Descriptor()
+		if desc.Err != nil {
+			t.Errorf("%s: %v", desc.Name, desc.Err)
+		}
+		if !desc.Optional {
+			t.Errorf("%s: should be optional", desc.Name)
+		}
+		if desc.Info.RType == nil || desc.Info.RType.PkgPath != "github.com/attapon-th/null" {
+			t.Errorf("%s: GoType = %v, want a null type", desc.Name, desc.Info.RType)
+		}
+	}
+}
+
+func TestValueScanner(t *testing.T) {
+	vs := ValueScanner[null.Enum]()
+	v, err := vs.Value(null.NewEnum("a", "b").With("b"))
+	if err != nil || v != "b" {
+		t.Errorf("Value = %v, %v; want b", v, err)
+	}
+
+	sv := vs.ScanValue()
+	if err := sv.Scan([]byte("2024-01-02")); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ValueScanner[null.DateString]().FromValue(sv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(null.DateStringFrom("2024-01-02")) {
+		t.Errorf("FromValue = %v, want 2024-01-02", got)
+	}
+
+	sv = vs.ScanValue()
+	if err := sv.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	i, err := ValueScanner[null.Int]().FromValue(sv)
+	if err != nil || i.Valid {
+		t.Errorf("FromValue(nil) = %v, %v; want null", i, err)
+	}
+}
@@ -0,0 +1,267 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+var (
+	// EnumNullUnknown Set whether unknown Enum input produces null instead of an error
+	//
+	// Deprecated: Use Configure with Options.EnumNullUnknown. It is ignored once Configure has been called.
+	EnumNullUnknown = false
+)
+
+// enumSet holds the allowed values of an Enum, keyed by their lower case form.
+type enumSet struct {
+	values    []string
+	canonical map[string]string
+}
+
+// Enum is a nullable string restricted to a set of allowed values.
+// Create one with NewEnum and assign it to struct fields before decoding into them,
+// so that UnmarshalJSON, UnmarshalText and Scan can check the input.
+// Input is matched case-insensitively and stored in the casing given to NewEnum.
+// Unknown input returns an error, or produces null if EnumNullUnknown is set.
+// The zero Enum has no allowed set and accepts any value.
+// It will marshal to null if null.
+type Enum struct {
+	sql.NullString
+	set *enumSet
+}
+
+// NewEnum creates a null Enum that only accepts the given values.
+func NewEnum(allowed ...string) Enum {
+	set := &enumSet{
+		values:    append([]string(nil), allowed...),
+		canonical: make(map[string]string, len(allowed)),
+	}
+	for _, v := range allowed {
+		set.canonical[strings.ToLower(v)] = v
+	}
+	return Enum{set: set}
+}
+
+// With returns a copy of this Enum, sharing its allowed set, holding v in canonical casing.
+// It will be null if v is not allowed.
+func (e Enum) With(v string) Enum {
+	if canonical, ok := e.lookup(v); ok {
+		e.String, e.Valid = canonical, true
+		return e
+	}
+	e.String, e.Valid = "", false
+	return e
+}
+
+// Null returns a null copy of this Enum, sharing its allowed set.
+func (e Enum) Null() Enum {
+	e.String, e.Valid = "", false
+	return e
+}
+
+// Allowed returns the allowed values, or nil if this Enum accepts any value.
+func (e Enum) Allowed() []string {
+	if e.set == nil {
+		return nil
+	}
+	return append([]string(nil), e.set.values...)
+}
+
+// IsAllowed reports whether v is one of the allowed values, ignoring case.
+func (e Enum) IsAllowed(v string) bool {
+	_, ok := e.lookup(v)
+	return ok
+}
+
+func (e Enum) lookup(v string) (string, bool) {
+	if e.set == nil {
+		return v, true
+	}
+	canonical, ok := e.set.canonical[strings.ToLower(v)]
+	return canonical, ok
+}
+
+// Set changes this Enum's value to v in canonical casing.
+// It returns an error for unknown values, unless EnumNullUnknown is set.
+func (e *Enum) Set(v string) error {
+	if err := e.setValue(v); err != nil {
+		return fmt.Errorf("null: %w", err)
+	}
+	return nil
+}
+
+func (e *Enum) setValue(v string) error {
+	canonical, ok := e.lookup(v)
+	if !ok {
+		e.String, e.Valid = "", false
+		err := fmt.Errorf("%q is not one of %q", v, e.set.values)
+		if config().EnumNullUnknown {
+			coerced("Enum", "Set", v, err)
+			return nil
+		}
+		return err
+	}
+	e.String, e.Valid = canonical, true
+	return nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (e Enum) ValueOrZero() string {
+	if !e.Valid {
+		return ""
+	}
+	return e.String
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *Enum) Scan(value any) error {
+	if err := e.NullString.Scan(value); err != nil {
+		e.Valid = false
+		return newScanError("null.Enum", value, err)
+	}
+	if !e.Valid {
+		return nil
+	}
+	if err := e.setValue(e.String); err != nil {
+		return newScanError("null.Enum", value, err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (e *Enum) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "String")
+	data = trimJSON("Enum", data)
+	if bytes.Equal(data, nullBytes) {
+		e.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	if str == "" && config().EmptyStringAsNull {
+		e.String, e.Valid = "", false
+		return nil
+	}
+	return unmarshalError(ErrInvalidJSON, e.setValue(str))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Enum if the input is blank or "null".
+func (e *Enum) UnmarshalText(text []byte) error {
+	text = prepareText("Enum", text)
+	str := string(text)
+	if str == "" || str == "null" {
+		e.String, e.Valid = "", false
+		return nil
+	}
+	return unmarshalError(ErrInvalidText, e.setValue(str))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (e *Enum) UnmarshalParam(param string) error {
+	return e.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Enum is null.
+func (e Enum) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Enum", e.Valid, e.marshalJSON)
+}
+
+func (e Enum) marshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(e.String)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Enum is null.
+func (e Enum) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return []byte{}, nil
+	}
+	return []byte(e.String), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (e Enum) AppendText(buf []byte) ([]byte, error) {
+	if !e.Valid {
+		return buf, nil
+	}
+	return append(buf, e.String...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Enum to v under key, or nothing if null.
+func (e Enum) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, e.Valid, e.MarshalText)
+}
+
+// SetValid changes this Enum's value and also sets it to be non-null.
+// The value is stored as-is and is not checked against the allowed set.
+func (e *Enum) SetValid(v string) {
+	e.String = v
+	e.Valid = true
+}
+
+// Ptr returns a pointer to this Enum's value, or a nil pointer if this Enum is null.
+func (e Enum) Ptr() *string {
+	if !e.Valid {
+		return nil
+	}
+	return &e.String
+}
+
+// IsZero returns true for null Enums, for potential future omitempty support.
+func (e Enum) IsZero() bool {
+	return !e.Valid
+}
+
+// IsNull returns true if this Enum is null.
+func (e Enum) IsNull() bool {
+	return !e.Valid
+}
+
+// SetNull sets this Enum to null and clears its value. The allowed values are kept.
+func (e *Enum) SetNull() {
+	e.String, e.Valid = "", false
+}
+
+// Reset sets this Enum to its zero value, which is null.
+// Unlike SetNull, it also forgets the allowed values, so the Enum accepts any value.
+func (e *Enum) Reset() {
+	*e = Enum{}
+}
+
+// Equal returns true if both Enums have the same value or are both null.
+// The allowed sets are not compared.
+func (e Enum) Equal(other Enum) bool {
+	return e.Valid == other.Valid && (!e.Valid || e.String == other.String)
+}
+
+// Merge returns this Enum if it is valid, otherwise other.
+func (e Enum) Merge(other Enum) Enum {
+	if e.Valid {
+		return e
+	}
+	return other
+}
+
+// When returns this Enum if cond is true, otherwise a null Enum.
+func (e Enum) When(cond bool) Enum {
+	if !cond {
+		e.SetNull()
+	}
+	return e
+}
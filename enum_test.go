@@ -0,0 +1,124 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var testStatus = NewEnum("draft", "published", "archived")
+
+type postInStruct struct {
+	Status Enum `json:"status"`
+}
+
+func TestEnumWith(t *testing.T) {
+	e := testStatus.With("Published")
+	assertEnum(t, e, "published", "With()")
+
+	unknown := testStatus.With("deleted")
+	assertNullEnum(t, unknown, "With() unknown")
+	if !unknown.IsAllowed("DRAFT") {
+		t.Error("null copy should keep the allowed set")
+	}
+
+	if testStatus.Valid {
+		t.Error("NewEnum() should be null")
+	}
+	if got := testStatus.Allowed(); len(got) != 3 || got[0] != "draft" {
+		t.Errorf("bad Allowed(): %v", got)
+	}
+
+	var free Enum
+	if !free.IsAllowed("anything") || free.Allowed() != nil {
+		t.Error("zero Enum should accept any value")
+	}
+}
+
+func TestUnmarshalEnum(t *testing.T) {
+	post := postInStruct{Status: testStatus}
+	err := json.Unmarshal([]byte(`{"status":"ARCHIVED"}`), &post)
+	maybePanic(err)
+	assertEnum(t, post.Status, "archived", "enum json")
+
+	err = json.Unmarshal([]byte(`{"status":"deleted"}`), &post)
+	if err == nil {
+		t.Error("expected error for unknown value")
+	}
+	assertNullEnum(t, post.Status, "unknown enum json")
+
+	err = json.Unmarshal([]byte(`{"status":null}`), &post)
+	maybePanic(err)
+	assertNullEnum(t, post.Status, "null json")
+
+	err = json.Unmarshal([]byte(`{"status":1}`), &post)
+	if err == nil {
+		t.Error("expected error for wrong type")
+	}
+
+	text := testStatus.Null()
+	err = text.UnmarshalText([]byte("Draft"))
+	maybePanic(err)
+	assertEnum(t, text, "draft", "UnmarshalText()")
+
+	var free postInStruct
+	err = json.Unmarshal([]byte(`{"status":"whatever"}`), &free)
+	maybePanic(err)
+	assertEnum(t, free.Status, "whatever", "unrestricted enum json")
+}
+
+func TestEnumNullUnknown(t *testing.T) {
+	defer configured.Store(nil)
+	Configure(Options{EnumNullUnknown: true})
+
+	e := testStatus.With("draft")
+	err := e.UnmarshalJSON([]byte(`"deleted"`))
+	maybePanic(err)
+	assertNullEnum(t, e, "unknown enum json")
+}
+
+func TestMarshalEnum(t *testing.T) {
+	data, err := json.Marshal(postInStruct{Status: testStatus.With("PUBLISHED")})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"status":"published"}`, "enum json marshal")
+
+	data, err = json.Marshal(postInStruct{Status: testStatus})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"status":null}`, "null enum json marshal")
+}
+
+func TestEnumScanValue(t *testing.T) {
+	e := testStatus
+	err := e.Scan([]byte("Draft"))
+	maybePanic(err)
+	assertEnum(t, e, "draft", "scanned enum")
+	if v, err := e.Value(); v != "draft" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	err = e.Scan("gone")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullEnum(t, e, "scanned unknown")
+
+	err = e.Scan(nil)
+	maybePanic(err)
+	assertNullEnum(t, e, "scanned null")
+}
+
+func assertEnum(t *testing.T, e Enum, want string, from string) {
+	t.Helper()
+	if e.String != want {
+		t.Errorf("bad %s enum: %s ≠ %s\n", from, e.String, want)
+	}
+	if !e.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullEnum(t *testing.T, e Enum, from string) {
+	t.Helper()
+	if e.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
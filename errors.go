@@ -0,0 +1,205 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Errors returned by this package wrap one of these sentinel errors
+// so callers can check for them with errors.Is.
+var (
+	// ErrInvalidJSON is returned when JSON input cannot be decoded into a null type.
+	ErrInvalidJSON = errors.New("null: couldn't unmarshal JSON")
+	// ErrInvalidText is returned when text input cannot be decoded into a null type.
+	ErrInvalidText = errors.New("null: couldn't unmarshal text")
+	// ErrInvalidBinary is returned when binary input cannot be decoded into a null type.
+	ErrInvalidBinary = errors.New("null: couldn't unmarshal binary")
+	// ErrInvalidDate is returned when time input is not a valid date or time.
+	ErrInvalidDate = errors.New("null: invalid date")
+	// ErrScanType is returned when Scan receives a source type it does not support.
+	ErrScanType = errors.New("null: cannot scan type")
+	// ErrInvalidCursor is returned when DecodeCursor receives a cursor it did not produce.
+	ErrInvalidCursor = errors.New("null: invalid cursor")
+)
+
+// unmarshalError wraps a validation error of an Unmarshal method with sentinel,
+// or returns nil if err is nil.
+func unmarshalError(sentinel, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", sentinel, err)
+}
+
+// scanPreviewLen is the maximum length of ScanError.Preview before it is truncated.
+const scanPreviewLen = 64
+
+// ScanError is returned when Scan receives a source value it cannot store.
+// It wraps ErrScanType and the underlying conversion error, if any.
+type ScanError struct {
+	// Dest is the destination type, such as "null.Int".
+	Dest string
+	// Src is the Go type of the source value, such as "[]uint8".
+	Src string
+	// Preview is the source value formatted for display, truncated if long.
+	Preview string
+	// Err is the underlying conversion error, or nil if the source type is not supported at all.
+	Err error
+}
+
+func newScanError(dest string, value any, err error) *ScanError {
+	return &ScanError{
+		Dest:    dest,
+		Src:     fmt.Sprintf("%T", value),
+		Preview: previewValue(value),
+		Err:     err,
+	}
+}
+
+func previewValue(value any) string {
+	var s string
+	switch x := value.(type) {
+	case string:
+		s = x
+	case []byte:
+		s = string(x)
+	default:
+		s = fmt.Sprintf("%v", value)
+	}
+	truncated := len(s) > scanPreviewLen
+	if truncated {
+		s = s[:scanPreviewLen]
+	}
+	s = strconv.Quote(s)
+	if truncated {
+		s += "..."
+	}
+	return s
+}
+
+func (e *ScanError) Error() string {
+	msg := fmt.Sprintf("%s %s into %s: %s", ErrScanType, e.Src, e.Dest, e.Preview)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns ErrScanType and the underlying conversion error.
+func (e *ScanError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{ErrScanType}
+	}
+	return []error{ErrScanType, e.Err}
+}
+
+// ValidationError is a field of decoded input that could not be stored, such as a
+// malformed date in a request body. Unmarshal, BindJSON and ApplyMergePatch collect them
+// into ValidationErrors, so API layers can report every invalid field at once.
+type ValidationError struct {
+	// Field is the json name of the field, such as "birth_date".
+	Field string
+	// Raw is the input value, without quotes if it was a JSON string.
+	Raw string
+	// Reason describes the problem in English, such as "not a valid date".
+	Reason string
+	// Kind classifies the problem, and is the key of its message in Messages.
+	Kind ErrorKind
+	// Err is the underlying error.
+	Err error
+}
+
+func newValidationError(field string, raw []byte, err error) *ValidationError {
+	var str string
+	if json.Unmarshal(raw, &str) != nil {
+		str = string(raw)
+	}
+	return &ValidationError{
+		Field:  field,
+		Raw:    str,
+		Reason: validationReason(err),
+		Kind:   errorKind(err),
+		Err:    err,
+	}
+}
+
+func validationReason(err error) string {
+	min, max, outOfRange := rangeBounds(err)
+	switch {
+	case errors.Is(err, ErrInvalidDate):
+		return "not a valid date"
+	case outOfRange:
+		return fmt.Sprintf("must be between %v and %v", min, max)
+	case errors.Is(err, ErrInvalidJSON), errors.Is(err, ErrInvalidText), errors.Is(err, ErrInvalidBinary):
+		return "not a valid value"
+	}
+	return strings.TrimPrefix(err.Error(), "null: ")
+}
+
+// errorKind classifies err like validationReason.
+func errorKind(err error) ErrorKind {
+	_, _, outOfRange := rangeBounds(err)
+	switch {
+	case errors.Is(err, ErrInvalidDate):
+		return KindInvalidDate
+	case outOfRange:
+		return KindOutOfRange
+	case errors.Is(err, ErrInvalidJSON), errors.Is(err, ErrInvalidText), errors.Is(err, ErrInvalidBinary):
+		return KindInvalidValue
+	}
+	return KindOther
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s (got %s)", e.Field, e.Reason, e.Raw)
+}
+
+// Unwrap returns the underlying error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors is a list of invalid fields, in the order of the struct's fields.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns each ValidationError.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// errOrNil returns e, or nil if it is empty.
+func (e ValidationErrors) errOrNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// rangeBounds returns the bounds of the *RangeError or *FloatRangeError wrapped by err.
+// ok is false if err wraps neither.
+func rangeBounds(err error) (min, max any, ok bool) {
+	var rangeErr *RangeError
+	if errors.As(err, &rangeErr) {
+		return rangeErr.Min, rangeErr.Max, true
+	}
+	var floatErr *FloatRangeError
+	if errors.As(err, &floatErr) {
+		return floatErr.Min, floatErr.Max, true
+	}
+	return nil, nil, false
+}
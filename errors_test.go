@@ -0,0 +1,286 @@
+package null
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestErrInvalidJSON(t *testing.T) {
+	var i Int
+	err := json.Unmarshal([]byte(`{"a": 1}`), &i)
+	if !errors.Is(err, ErrInvalidJSON) {
+		t.Errorf("Int: expected ErrInvalidJSON, got %v", err)
+	}
+	err = i.UnmarshalJSON([]byte(`"abc"`))
+	if !errors.Is(err, ErrInvalidJSON) {
+		t.Errorf("Int string: expected ErrInvalidJSON, got %v", err)
+	}
+
+	var s String
+	err = json.Unmarshal([]byte(`123`), &s)
+	if !errors.Is(err, ErrInvalidJSON) {
+		t.Errorf("String: expected ErrInvalidJSON, got %v", err)
+	}
+
+	var ti Time
+	err = json.Unmarshal([]byte(`123`), &ti)
+	if !errors.Is(err, ErrInvalidJSON) {
+		t.Errorf("Time: expected ErrInvalidJSON, got %v", err)
+	}
+}
+
+func TestErrInvalidJSONValidation(t *testing.T) {
+	enum := NewEnum("draft", "published")
+	tests := []struct {
+		name string
+		dst  any
+		json string
+		text string
+	}{
+		{"LanguageTag", new(LanguageTag), `"not a tag!"`, "not a tag!"},
+		{"CountryCode", new(CountryCode), `"XX1"`, "XX1"},
+		{"Weekday", new(Weekday), `9`, "Someday"},
+		{"Month", new(Month), `"Smarch"`, "Smarch"},
+		{"Color", new(Color), `"#12"`, "#12"},
+		{"Char", new(Char), `"ab"`, "ab"},
+		{"TimeZone", new(TimeZone), `"Mars/Olympus"`, "Mars/Olympus"},
+		{"Enum", &enum, `"deleted"`, "deleted"},
+		{"Interval", new(Interval), `"forever"`, "forever"},
+		{"Cron", new(Cron), `"* *"`, "* *"},
+		{"Regexp", new(Regexp), `"("`, "("},
+	}
+	for _, tt := range tests {
+		if err := json.Unmarshal([]byte(tt.json), tt.dst); !errors.Is(err, ErrInvalidJSON) {
+			t.Errorf("%s JSON: expected ErrInvalidJSON, got %v", tt.name, err)
+		}
+		err := tt.dst.(encoding.TextUnmarshaler).UnmarshalText([]byte(tt.text))
+		if !errors.Is(err, ErrInvalidText) || errors.Is(err, ErrInvalidJSON) {
+			t.Errorf("%s text: expected ErrInvalidText, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestErrInvalidText(t *testing.T) {
+	var i Int
+	if err := i.UnmarshalText([]byte("abc")); !errors.Is(err, ErrInvalidText) {
+		t.Errorf("Int: expected ErrInvalidText, got %v", err)
+	}
+	var f Float
+	if err := f.UnmarshalText([]byte("abc")); !errors.Is(err, ErrInvalidText) {
+		t.Errorf("Float: expected ErrInvalidText, got %v", err)
+	}
+	var b Bool
+	if err := b.UnmarshalText([]byte("yes")); !errors.Is(err, ErrInvalidText) {
+		t.Errorf("Bool: expected ErrInvalidText, got %v", err)
+	}
+}
+
+func TestErrInvalidBinary(t *testing.T) {
+	var i Int
+	for _, data := range [][]byte{nil, {7}, {binaryNull, 1}, {binaryValid, 1}} {
+		if err := i.UnmarshalBinary(data); !errors.Is(err, ErrInvalidBinary) || errors.Is(err, ErrInvalidJSON) {
+			t.Errorf("Int %v: expected ErrInvalidBinary, got %v", data, err)
+		}
+	}
+}
+
+func TestErrInvalidDate(t *testing.T) {
+	var ti Time
+	err := json.Unmarshal([]byte(`"not a time"`), &ti)
+	if !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("Time JSON: expected ErrInvalidDate, got %v", err)
+	}
+	err = ti.UnmarshalText([]byte("2024-13-01"))
+	if !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("Time text: expected ErrInvalidDate, got %v", err)
+	}
+}
+
+func TestErrScanType(t *testing.T) {
+	var m Month
+	err := m.Scan(1.5)
+	if !errors.Is(err, ErrScanType) {
+		t.Errorf("Month: expected ErrScanType, got %v", err)
+	}
+	var re Regexp
+	err = re.Scan(int64(1))
+	if !errors.Is(err, ErrScanType) {
+		t.Errorf("Regexp: expected ErrScanType, got %v", err)
+	}
+
+	enum := NewEnum("draft", "published")
+	for name, dst := range map[string]interface{ Scan(any) error }{
+		"LanguageTag": new(LanguageTag),
+		"CountryCode": new(CountryCode),
+		"Weekday":     new(Weekday),
+		"Color":       new(Color),
+		"Char":        new(Char),
+		"TimeZone":    new(TimeZone),
+		"Enum":        &enum,
+	} {
+		err := dst.Scan("not valid!")
+		if !errors.Is(err, ErrScanType) || errors.Is(err, ErrInvalidJSON) {
+			t.Errorf("%s: expected ErrScanType, got %v", name, err)
+		}
+	}
+	var p Percent
+	if err := p.Scan(float64(150)); !errors.Is(err, ErrScanType) {
+		t.Errorf("Percent: expected ErrScanType, got %v", err)
+	}
+}
+
+func TestScanError(t *testing.T) {
+	var i Int
+	i.Valid = true
+	err := i.Scan("abc")
+	var scanErr *ScanError
+	if !errors.As(err, &scanErr) {
+		t.Fatalf("Int: expected *ScanError, got %T: %v", err, err)
+	}
+	if scanErr.Dest != "null.Int" || scanErr.Src != "string" || scanErr.Preview != `"abc"` || scanErr.Err == nil {
+		t.Errorf("bad ScanError: %+v", scanErr)
+	}
+	if !errors.Is(err, ErrScanType) {
+		t.Error("ScanError should wrap ErrScanType")
+	}
+	if i.Valid {
+		t.Error("Int should be null after a failed Scan")
+	}
+
+	var b Bool
+	err = b.Scan([]byte("not a bool"))
+	if !errors.As(err, &scanErr) || scanErr.Src != "[]uint8" || scanErr.Preview != `"not a bool"` {
+		t.Errorf("Bool: bad ScanError: %v", err)
+	}
+
+	var m Month
+	err = m.Scan(true)
+	if !errors.As(err, &scanErr) || scanErr.Dest != "null.Month" || scanErr.Err != nil {
+		t.Errorf("Month: bad ScanError: %v", err)
+	}
+	if want := "null: cannot scan type bool into null.Month: \"true\""; err.Error() != want {
+		t.Errorf("bad message: %q ≠ %q", err.Error(), want)
+	}
+
+	long := make([]byte, 100)
+	for n := range long {
+		long[n] = 'x'
+	}
+	err = i.Scan(long)
+	if !errors.As(err, &scanErr) || len(scanErr.Preview) != scanPreviewLen+5 {
+		t.Errorf("long preview should be truncated: %q", scanErr.Preview)
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	type signup struct {
+		Name      String                 `json:"name"`
+		BirthDate DateString             `json:"birth_date"`
+		Age       Int                    `json:"age"`
+		Rating    BoundedInt[testRating] `json:"rating"`
+		Email     String                 `json:"email"`
+	}
+	var s signup
+	err := Unmarshal([]byte(`{"birth_date": "2024-13-40", "age": "old", "rating": 9, "email": "a@example.com"}`), &s)
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 3 {
+		t.Fatalf("got %v, want 3 ValidationErrors", err)
+	}
+	want := []string{
+		"birth_date: not a valid date (got 2024-13-40)",
+		"age: not a valid value (got old)",
+		"rating: must be between 1 and 5 (got 9)",
+	}
+	for i, e := range errs {
+		if e.Error() != want[i] {
+			t.Errorf("errs[%d] = %q, want %q", i, e.Error(), want[i])
+		}
+	}
+	if errs[0].Field != "birth_date" || errs[0].Raw != "2024-13-40" || errs[0].Kind != KindInvalidDate {
+		t.Errorf("errs[0] = %+v", errs[0])
+	}
+	if !errors.Is(err, ErrInvalidDate) || !errors.Is(err, ErrInvalidJSON) {
+		t.Error("ValidationErrors should wrap the underlying errors")
+	}
+	if s.Email != StringFrom("a@example.com") {
+		t.Error("valid fields should still be decoded")
+	}
+}
+
+func TestValidationErrorsMergePatch(t *testing.T) {
+	type address struct {
+		Zip Int `json:"zip"`
+	}
+	type user struct {
+		Address address `json:"address"`
+		Age     Int     `json:"age"`
+	}
+	var u user
+	err := ApplyMergePatch(&u, []byte(`{"address": {"zip": "x"}, "age": 5}`))
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Field != "address.zip" {
+		t.Fatalf("got %v, want a ValidationError for address.zip", err)
+	}
+	if u.Age != IntFrom(5) {
+		t.Error("valid fields should still be applied")
+	}
+}
+
+func TestValidationErrorLocalize(t *testing.T) {
+	var v struct {
+		Rating BoundedInt[testRating] `json:"rating"`
+		Age    Int                    `json:"age"`
+		Score  Percent                `json:"score"`
+	}
+	err := Unmarshal([]byte(`{"rating":9,"age":"old","score":150}`), &v)
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected ValidationErrors, got %v", err)
+	}
+
+	en := []string{
+		"rating: must be between 1 and 5 (got 9)",
+		"age: not a valid value (got old)",
+		"score: must be between 0 and 100 (got 150)",
+	}
+	th := []string{
+		"rating: ต้องอยู่ระหว่าง 1 ถึง 5 (ได้รับ 9)",
+		"age: ค่าไม่ถูกต้อง (ได้รับ old)",
+		"score: ต้องอยู่ระหว่าง 0 ถึง 100 (ได้รับ 150)",
+	}
+	if errs[2].Kind != KindOutOfRange {
+		t.Errorf("Percent: got kind %q, want %q", errs[2].Kind, KindOutOfRange)
+	}
+	for _, tc := range []struct {
+		tag  language.Tag
+		want []string
+	}{
+		{language.English, en},
+		{language.MustParse("th-TH"), th},
+		{language.Japanese, en},
+	} {
+		got := errs.Localize(tc.tag)
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("%v: got %q, want %q", tc.tag, got, tc.want)
+		}
+	}
+
+	dateErr := &ValidationError{Field: "birth", Raw: "x", Kind: KindInvalidDate}
+	if got, want := dateErr.Localize(language.Thai), "birth: ไม่ใช่วันที่ที่ถูกต้อง (ได้รับ x)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	defer configured.Store(nil)
+	Configure(Options{Translator: func(tag language.Tag, e *ValidationError) string {
+		return tag.String() + ":" + string(e.Kind)
+	}})
+	if got, want := dateErr.Localize(language.Thai), "th:invalid_date"; got != want {
+		t.Errorf("custom translator: got %q, want %q", got, want)
+	}
+}
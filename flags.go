@@ -0,0 +1,338 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/bits"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// flagNames maps single bits to names, in both directions.
+type flagNames struct {
+	byName map[string]uint64
+	byBit  [64]string
+}
+
+// Flags is a nullable uint64 bitmask. It supports SQL (BIGINT) and JSON serialization.
+// Without names it marshals to a JSON number. Flags created with NewFlags marshal
+// to an array of names, and unmarshal both numbers and name arrays.
+// It will marshal to null if null.
+type Flags struct {
+	Flags uint64
+	Valid bool
+	names *flagNames
+}
+
+// NewFlags creates a null Flags whose bits are named by names, which maps each name to a single bit.
+// It panics if a value is not a single bit or a bit is named twice.
+// Assign it to struct fields before decoding into them so the names are known.
+func NewFlags(names map[string]uint64) Flags {
+	fn := &flagNames{byName: make(map[string]uint64, len(names))}
+	for name, bit := range names {
+		if bits.OnesCount64(bit) != 1 {
+			panic(fmt.Sprintf("null: flag %q is not a single bit: %#x", name, bit))
+		}
+		i := bits.TrailingZeros64(bit)
+		if fn.byBit[i] != "" {
+			panic(fmt.Sprintf("null: flags %q and %q share bit %#x", fn.byBit[i], name, bit))
+		}
+		fn.byBit[i] = name
+		fn.byName[name] = bit
+	}
+	return Flags{names: fn}
+}
+
+// FlagsFrom creates a new Flags that will always be valid.
+func FlagsFrom(f uint64) Flags {
+	return Flags{Flags: f, Valid: true}
+}
+
+// FlagsFromPtr creates a new Flags that will be null if f is nil.
+func FlagsFromPtr(f *uint64) Flags {
+	if f == nil {
+		return Flags{}
+	}
+	return FlagsFrom(*f)
+}
+
+// With returns a valid copy of this Flags, sharing its names, holding f.
+func (f Flags) With(v uint64) Flags {
+	f.Flags, f.Valid = v, true
+	return f
+}
+
+// Has reports whether all bits of mask are set. It returns false if this Flags is null.
+func (f Flags) Has(mask uint64) bool {
+	return f.Valid && f.Flags&mask == mask
+}
+
+// Set sets the bits of mask and also sets this Flags to be non-null.
+func (f *Flags) Set(mask uint64) {
+	if !f.Valid {
+		f.Flags = 0
+	}
+	f.Flags |= mask
+	f.Valid = true
+}
+
+// Clear clears the bits of mask. It does not change whether this Flags is null.
+func (f *Flags) Clear(mask uint64) {
+	f.Flags &^= mask
+}
+
+// Names returns the names of the set bits in bit order.
+// It returns an error if a set bit has no name, and nil if this Flags is null.
+func (f Flags) Names() ([]string, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	if f.names == nil {
+		return nil, errors.New("null: flags have no names")
+	}
+	out := make([]string, 0, bits.OnesCount64(f.Flags))
+	for v := f.Flags; v != 0; v &= v - 1 {
+		i := bits.TrailingZeros64(v)
+		name := f.names.byBit[i]
+		if name == "" {
+			return nil, fmt.Errorf("null: flag bit %#x has no name", uint64(1)<<i)
+		}
+		out = append(out, name)
+	}
+	return out, nil
+}
+
+func (f *Flags) setNames(names []string) error {
+	if f.names == nil {
+		f.Valid = false
+		return errors.New("null: flags have no names")
+	}
+	var v uint64
+	for _, name := range names {
+		bit, ok := f.names.byName[name]
+		if !ok {
+			f.Valid = false
+			known := make([]string, 0, len(f.names.byName))
+			for n := range f.names.byName {
+				known = append(known, n)
+			}
+			sort.Strings(known)
+			return fmt.Errorf("null: unknown flag %q, need one of %q", name, known)
+		}
+		v |= bit
+	}
+	f.Flags, f.Valid = v, true
+	return nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (f Flags) ValueOrZero() uint64 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Flags
+}
+
+// Scan implements the sql.Scanner interface.
+// BIGINT columns are signed, so the high bit is stored as a negative number.
+func (f *Flags) Scan(value any) error {
+	switch x := value.(type) {
+	case nil:
+		f.Flags, f.Valid = 0, false
+		return nil
+	case int64:
+		f.Flags, f.Valid = uint64(x), true
+		return nil
+	case string:
+		return f.UnmarshalText([]byte(x))
+	case []byte:
+		return f.UnmarshalText(x)
+	}
+	f.Valid = false
+	return newScanError("null.Flags", value, nil)
+}
+
+// Value implements the driver Valuer interface.
+func (f Flags) Value() (driver.Value, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return int64(f.Flags), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, array of names, and null input.
+func (f *Flags) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "Flags")
+	data = trimJSON("Flags", data)
+	if bytes.Equal(data, nullBytes) {
+		f.Valid = false
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '[' {
+		var names []string
+		if err := json.Unmarshal(data, &names); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+		}
+		return f.setNames(names)
+	}
+
+	if err := json.Unmarshal(data, &f.Flags); err != nil {
+		f.Valid = false
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	f.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports a number or a comma-separated list of names,
+// and will unmarshal to a null Flags if the input is blank or "null".
+func (f *Flags) UnmarshalText(text []byte) error {
+	text = prepareText("Flags", text)
+	str := string(text)
+	if str == "" || str == "null" {
+		f.Valid = false
+		return nil
+	}
+	if n, err := strconv.ParseUint(str, 10, 64); err == nil {
+		f.Flags, f.Valid = n, true
+		return nil
+	}
+	return f.setNames(strings.Split(str, ","))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (f *Flags) UnmarshalParam(param string) error {
+	return f.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Flags is null, an array of names if it has names,
+// and a number otherwise.
+func (f Flags) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Flags", f.Valid, f.marshalJSON)
+}
+
+func (f Flags) marshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+	if f.names == nil {
+		return []byte(strconv.FormatUint(f.Flags, 10)), nil
+	}
+	names, err := f.Names()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(names)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Flags is null, comma-separated names
+// if it has names, and a number otherwise.
+func (f Flags) MarshalText() ([]byte, error) {
+	if !f.Valid {
+		return []byte{}, nil
+	}
+	if f.names == nil {
+		return []byte(strconv.FormatUint(f.Flags, 10)), nil
+	}
+	names, err := f.Names()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strings.Join(names, ",")), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (f Flags) AppendText(buf []byte) ([]byte, error) {
+	if !f.Valid {
+		return buf, nil
+	}
+	if f.names == nil {
+		return strconv.AppendUint(buf, f.Flags, 10), nil
+	}
+	names, err := f.Names()
+	if err != nil {
+		return buf, err
+	}
+	for n, name := range names {
+		if n > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, name...)
+	}
+	return buf, nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Flags to v under key, or nothing if null.
+func (f Flags) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, f.Valid, f.MarshalText)
+}
+
+// SetValid changes this Flags' value and also sets it to be non-null.
+func (f *Flags) SetValid(v uint64) {
+	f.Flags = v
+	f.Valid = true
+}
+
+// Ptr returns a pointer to this Flags' value, or a nil pointer if this Flags is null.
+func (f Flags) Ptr() *uint64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Flags
+}
+
+// IsZero returns true for null Flags, for potential future omitempty support.
+// A non-null Flags with no bits set will not be considered zero.
+func (f Flags) IsZero() bool {
+	return !f.Valid
+}
+
+// IsNull returns true if this Flags is null.
+func (f Flags) IsNull() bool {
+	return !f.Valid
+}
+
+// SetNull sets this Flags to null and clears its value. The flag names are kept.
+func (f *Flags) SetNull() {
+	f.Flags, f.Valid = 0, false
+}
+
+// Reset sets this Flags to its zero value, which is null.
+// Unlike SetNull, it also forgets the flag names.
+func (f *Flags) Reset() {
+	*f = Flags{}
+}
+
+// Equal returns true if both Flags have the same bits set or are both null.
+func (f Flags) Equal(other Flags) bool {
+	return f.Valid == other.Valid && (!f.Valid || f.Flags == other.Flags)
+}
+
+// Merge returns this Flags if it is valid, otherwise other.
+func (f Flags) Merge(other Flags) Flags {
+	if f.Valid {
+		return f
+	}
+	return other
+}
+
+// When returns this Flags if cond is true, otherwise a null Flags.
+func (f Flags) When(cond bool) Flags {
+	if !cond {
+		f.SetNull()
+	}
+	return f
+}
@@ -0,0 +1,151 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const (
+	testFlagRead uint64 = 1 << iota
+	testFlagWrite
+	testFlagAdmin
+)
+
+var testPerms = NewFlags(map[string]uint64{
+	"read":  testFlagRead,
+	"write": testFlagWrite,
+	"admin": testFlagAdmin,
+})
+
+type permsInStruct struct {
+	Perms Flags `json:"perms"`
+}
+
+func TestFlagsHelpers(t *testing.T) {
+	var f Flags
+	if f.Has(0) {
+		t.Error("null Flags should not have any bits")
+	}
+	f.Set(testFlagRead | testFlagWrite)
+	if !f.Valid || !f.Has(testFlagRead) || !f.Has(testFlagRead|testFlagWrite) || f.Has(testFlagAdmin) {
+		t.Errorf("bad flags after Set(): %b %t", f.Flags, f.Valid)
+	}
+	f.Clear(testFlagWrite)
+	if f.Has(testFlagWrite) || !f.Has(testFlagRead) {
+		t.Errorf("bad flags after Clear(): %b", f.Flags)
+	}
+
+	stale := NewFlags(nil)
+	stale.Flags = 0xff
+	stale.Set(testFlagAdmin)
+	if stale.Flags != testFlagAdmin {
+		t.Errorf("Set() on null Flags should start from zero, got %b", stale.Flags)
+	}
+
+	if !FlagsFrom(3).Equal(testPerms.With(3)) || FlagsFromPtr(nil).Valid {
+		t.Error("bad FlagsFrom or FlagsFromPtr")
+	}
+}
+
+func TestNewFlagsPanics(t *testing.T) {
+	for _, names := range []map[string]uint64{
+		{"both": 3},
+		{"a": 1, "b": 1},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewFlags(%v) should panic", names)
+				}
+			}()
+			NewFlags(names)
+		}()
+	}
+}
+
+func TestUnmarshalFlags(t *testing.T) {
+	p := permsInStruct{Perms: testPerms}
+	err := json.Unmarshal([]byte(`{"perms":["read","admin"]}`), &p)
+	maybePanic(err)
+	if !p.Perms.Valid || p.Perms.Flags != testFlagRead|testFlagAdmin {
+		t.Errorf("bad flags from names: %b", p.Perms.Flags)
+	}
+
+	err = json.Unmarshal([]byte(`{"perms":2}`), &p)
+	maybePanic(err)
+	if p.Perms.Flags != testFlagWrite {
+		t.Errorf("bad flags from number: %b", p.Perms.Flags)
+	}
+
+	err = json.Unmarshal([]byte(`{"perms":["delete"]}`), &p)
+	if err == nil || p.Perms.Valid {
+		t.Error("expected error and null for unknown name")
+	}
+
+	err = json.Unmarshal([]byte(`{"perms":null}`), &p)
+	maybePanic(err)
+	if p.Perms.Valid {
+		t.Error("null json should produce null Flags")
+	}
+
+	var unnamed Flags
+	err = unnamed.UnmarshalJSON([]byte(`["read"]`))
+	if err == nil {
+		t.Error("names should require NewFlags")
+	}
+
+	text := testPerms
+	err = text.UnmarshalText([]byte("write,read"))
+	maybePanic(err)
+	if text.Flags != testFlagRead|testFlagWrite {
+		t.Errorf("bad flags from text: %b", text.Flags)
+	}
+}
+
+func TestMarshalFlags(t *testing.T) {
+	data, err := json.Marshal(permsInStruct{Perms: testPerms.With(testFlagAdmin | testFlagRead)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"perms":["read","admin"]}`, "named flags json marshal")
+
+	data, err = json.Marshal(FlagsFrom(5))
+	maybePanic(err)
+	assertJSONEquals(t, data, `5`, "numeric flags json marshal")
+
+	data, err = json.Marshal(testPerms)
+	maybePanic(err)
+	assertJSONEquals(t, data, `null`, "null flags json marshal")
+
+	data, err = testPerms.With(testFlagWrite | testFlagAdmin).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "write,admin", "named flags text marshal")
+
+	_, err = json.Marshal(testPerms.With(1 << 10))
+	if err == nil {
+		t.Error("expected error for unnamed bit")
+	}
+}
+
+func TestFlagsScanValue(t *testing.T) {
+	var f Flags
+	err := f.Scan(int64(-1))
+	maybePanic(err)
+	if f.Flags != ^uint64(0) {
+		t.Errorf("bad scanned flags: %x", f.Flags)
+	}
+	if v, err := f.Value(); v != int64(-1) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null Flags
+	err = null.Scan(nil)
+	maybePanic(err)
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong Flags
+	err = wrong.Scan(1.5)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
@@ -3,10 +3,12 @@ package null
 import (
 	"bytes"
 	"database/sql"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"net/url"
 	"reflect"
 	"strconv"
 )
@@ -49,10 +51,29 @@ func (f Float) ValueOrZero() float64 {
 	return f.Float64
 }
 
+// Scan implements the sql.Scanner interface.
+// It returns a *ScanError if value cannot be converted.
+func (f *Float) Scan(value any) error {
+	if b, ok := value.([]byte); ok {
+		// parse text columns without copying them; errors are reported by NullFloat64 below
+		if n, err := strconv.ParseFloat(viewString(b), 64); err == nil {
+			f.Float64, f.Valid = n, true
+			return nil
+		}
+	}
+	if err := f.NullFloat64.Scan(value); err != nil {
+		f.Valid = false
+		return newScanError("null.Float", value, err)
+	}
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will not be considered a null Float.
 func (f *Float) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "Float64")
+	data = trimJSON("Float", data)
 	if bytes.Equal(data, nullBytes) {
 		f.Valid = false
 		return nil
@@ -63,21 +84,21 @@ func (f *Float) UnmarshalJSON(data []byte) error {
 		if errors.As(err, &typeError) {
 			// special case: accept string input
 			if typeError.Value != "string" {
-				return fmt.Errorf("null: JSON input is invalid type (need float or string): %w", err)
+				return fmt.Errorf("%w: invalid type (need float or string): %w", ErrInvalidJSON, err)
 			}
 			var str string
 			if err := json.Unmarshal(data, &str); err != nil {
-				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+				return fmt.Errorf("%w: invalid number string: %w", ErrInvalidJSON, err)
 			}
 			n, err := strconv.ParseFloat(str, 64)
 			if err != nil {
-				return fmt.Errorf("null: couldn't convert string to float: %w", err)
+				return fmt.Errorf("%w: couldn't convert string to float: %w", ErrInvalidJSON, err)
 			}
 			f.Float64 = n
 			f.Valid = true
 			return nil
 		}
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
 	}
 
 	f.Valid = true
@@ -88,23 +109,34 @@ func (f *Float) UnmarshalJSON(data []byte) error {
 // It will unmarshal to a null Float if the input is blank.
 // It will return an error if the input is not an integer, blank, or "null".
 func (f *Float) UnmarshalText(text []byte) error {
-	str := string(text)
+	text = prepareText("Float", text)
+	str := viewString(text)
 	if str == "" || str == "null" {
 		f.Valid = false
 		return nil
 	}
 	var err error
-	f.Float64, err = strconv.ParseFloat(string(text), 64)
+	f.Float64, err = strconv.ParseFloat(str, 64)
 	if err != nil {
-		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidText, err)
 	}
 	f.Valid = true
 	return err
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (f *Float) UnmarshalParam(param string) error {
+	return f.UnmarshalText(viewBytes(param))
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Float is null.
 func (f Float) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Float", f.Valid, f.marshalJSON)
+}
+
+func (f Float) marshalJSON() ([]byte, error) {
 	if !f.Valid {
 		return []byte("null"), nil
 	}
@@ -114,7 +146,7 @@ func (f Float) MarshalJSON() ([]byte, error) {
 			Str:   strconv.FormatFloat(f.Float64, 'g', -1, 64),
 		}
 	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+	return marshalPooled(f.AppendText)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -123,7 +155,50 @@ func (f Float) MarshalText() ([]byte, error) {
 	if !f.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+	return marshalPooled(f.AppendText)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (f Float) AppendText(buf []byte) ([]byte, error) {
+	if !f.Valid {
+		return buf, nil
+	}
+	return strconv.AppendFloat(buf, f.Float64, 'f', -1, 64), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Float to v under key, or nothing if null.
+func (f Float) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, f.Valid, f.MarshalText)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (f Float) MarshalBinary() ([]byte, error) {
+	return f.AppendBinary(make([]byte, 0, 9))
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// It appends the same bytes as MarshalBinary to buf.
+func (f Float) AppendBinary(buf []byte) ([]byte, error) {
+	buf = appendBinaryFlag(buf, f.Valid)
+	if !f.Valid {
+		return buf, nil
+	}
+	return binary.BigEndian.AppendUint64(buf, math.Float64bits(f.Float64)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *Float) UnmarshalBinary(data []byte) error {
+	payload, valid, err := binaryPayload("Float", data, 8)
+	if err != nil {
+		return err
+	}
+	f.Float64, f.Valid = 0, valid
+	if valid {
+		f.Float64 = math.Float64frombits(binary.BigEndian.Uint64(payload))
+	}
+	return nil
 }
 
 // SetValid changes this Float's value and also sets it to be non-null.
@@ -146,6 +221,21 @@ func (f Float) IsZero() bool {
 	return !f.Valid
 }
 
+// IsNull returns true if this Float is null.
+func (f Float) IsNull() bool {
+	return !f.Valid
+}
+
+// SetNull sets this Float to null and clears its value.
+func (f *Float) SetNull() {
+	f.Float64, f.Valid = 0, false
+}
+
+// Reset sets this Float to its zero value, which is null.
+func (f *Float) Reset() {
+	*f = Float{}
+}
+
 // Equal returns true if both floats have the same value or are both null.
 // Warning: calculations using floating point numbers can result in different ways
 // the numbers are stored in memory. Therefore, this function is not suitable to
@@ -154,3 +244,19 @@ func (f Float) IsZero() bool {
 func (f Float) Equal(other Float) bool {
 	return f.Valid == other.Valid && (!f.Valid || f.Float64 == other.Float64)
 }
+
+// Merge returns this Float if it is valid, otherwise other.
+func (f Float) Merge(other Float) Float {
+	if f.Valid {
+		return f
+	}
+	return other
+}
+
+// When returns this Float if cond is true, otherwise a null Float.
+func (f Float) When(cond bool) Float {
+	if !cond {
+		f.SetNull()
+	}
+	return f
+}
@@ -1,3 +1,16 @@
 module github.com/attapon-th/null
 
-go 1.21.4
+go 1.24
+
+require (
+	github.com/google/go-querystring v1.1.0
+	github.com/mailru/easyjson v0.7.7
+	github.com/tinylib/msgp v1.2.5
+	golang.org/x/text v0.19.0
+)
+
+require (
+	github.com/google/go-cmp v0.6.0 // indirect
+	github.com/josharian/intern v1.0.0 // indirect
+	github.com/philhofer/fwd v1.1.3-0.20240916144458-20a13a1f6b7c // indirect
+)
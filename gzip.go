@@ -0,0 +1,210 @@
+package null
+
+import (
+	"bytes"
+	"compress/gzip"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// defaultGzipThreshold is the default of Options.GzipThreshold.
+const defaultGzipThreshold = 1024
+
+// defaultGzipMaxSize is the default of Options.GzipMaxSize.
+const defaultGzipMaxSize = 64 << 20
+
+// gzipMagic starts every gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// GzipBytes is a nullable byte slice that is stored compressed, such as a large text or blob column.
+// Value compresses Bytes with gzip when it is longer than Options.GzipThreshold,
+// and Scan decompresses gzip input, so Go code only sees the plain bytes.
+// Columns can hold a mix of compressed and uncompressed values, which are told apart by
+// the gzip header; values that start with the bytes 0x1f 0x8b are always compressed.
+// Scan rejects values that decompress to more than Options.GzipMaxSize bytes.
+// It marshals to JSON as a base64 string, like []byte, and will marshal to null if null.
+type GzipBytes struct {
+	Bytes []byte
+	Valid bool
+}
+
+// NewGzipBytes creates a new GzipBytes.
+func NewGzipBytes(b []byte, valid bool) GzipBytes {
+	return GzipBytes{
+		Bytes: b,
+		Valid: valid,
+	}
+}
+
+// GzipBytesFrom creates a new GzipBytes that will be null if b is nil.
+func GzipBytesFrom(b []byte) GzipBytes {
+	return NewGzipBytes(b, b != nil)
+}
+
+// GzipBytesFromPtr creates a new GzipBytes that will be null if b is nil.
+func GzipBytesFromPtr(b *[]byte) GzipBytes {
+	if b == nil {
+		return NewGzipBytes(nil, false)
+	}
+	return GzipBytesFrom(*b)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (g GzipBytes) ValueOrZero() []byte {
+	if !g.Valid {
+		return nil
+	}
+	return g.Bytes
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil input, and decompresses input that starts with a gzip header.
+// It returns an error if the input decompresses to more than Options.GzipMaxSize bytes.
+func (g *GzipBytes) Scan(value any) error {
+	var data []byte
+	switch x := value.(type) {
+	case nil:
+		g.Bytes, g.Valid = nil, false
+		return nil
+	case []byte:
+		data = x
+	case string:
+		data = []byte(x)
+	default:
+		g.Bytes, g.Valid = nil, false
+		return newScanError("null.GzipBytes", value, nil)
+	}
+	if !bytes.HasPrefix(data, gzipMagic) {
+		g.Bytes, g.Valid = bytes.Clone(data), true
+		return nil
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err == nil {
+		g.Bytes, err = readGzip(r, config().GzipMaxSize)
+	}
+	if err != nil {
+		g.Bytes, g.Valid = nil, false
+		return fmt.Errorf("null: couldn't decompress GzipBytes: %w", err)
+	}
+	g.Valid = true
+	return nil
+}
+
+// readGzip reads all of r, failing if it holds more than max bytes, unless max is negative.
+func readGzip(r io.Reader, max int64) ([]byte, error) {
+	if max < 0 {
+		return io.ReadAll(r)
+	}
+	b, err := io.ReadAll(io.LimitReader(r, max+1))
+	if err == nil && int64(len(b)) > max {
+		err = fmt.Errorf("decompressed size exceeds %d bytes", max)
+	}
+	return b, err
+}
+
+// Value implements the driver Valuer interface.
+// It returns Bytes compressed with gzip if it is longer than Options.GzipThreshold
+// or starts with a gzip header, otherwise as-is, or nil if null.
+func (g GzipBytes) Value() (driver.Value, error) {
+	if !g.Valid {
+		return nil, nil
+	}
+	if len(g.Bytes) <= config().GzipThreshold && !bytes.HasPrefix(g.Bytes, gzipMagic) {
+		return g.Bytes, nil
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(g.Bytes); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports base64 string and null input.
+func (g *GzipBytes) UnmarshalJSON(data []byte) error {
+	data = trimJSON("GzipBytes", data)
+	if bytes.Equal(data, nullBytes) {
+		g.Bytes, g.Valid = nil, false
+		return nil
+	}
+	var b []byte
+	if err := json.Unmarshal(data, &b); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	g.Bytes, g.Valid = b, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this GzipBytes is null.
+func (g GzipBytes) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("GzipBytes", g.Valid, g.marshalJSON)
+}
+
+func (g GzipBytes) marshalJSON() ([]byte, error) {
+	if !g.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(g.Bytes)
+}
+
+// SetValid changes this GzipBytes's value and also sets it to be non-null.
+func (g *GzipBytes) SetValid(v []byte) {
+	g.Bytes = v
+	g.Valid = true
+}
+
+// Ptr returns a pointer to this GzipBytes's value, or a nil pointer if this GzipBytes is null.
+func (g GzipBytes) Ptr() *[]byte {
+	if !g.Valid {
+		return nil
+	}
+	return &g.Bytes
+}
+
+// IsZero returns true for null GzipBytes, for potential future omitempty support.
+func (g GzipBytes) IsZero() bool {
+	return !g.Valid
+}
+
+// IsNull returns true if this GzipBytes is null.
+func (g GzipBytes) IsNull() bool {
+	return !g.Valid
+}
+
+// SetNull sets this GzipBytes to null and clears its value.
+func (g *GzipBytes) SetNull() {
+	g.Bytes, g.Valid = nil, false
+}
+
+// Reset sets this GzipBytes to its zero value, which is null.
+func (g *GzipBytes) Reset() {
+	*g = GzipBytes{}
+}
+
+// Equal returns true if both GzipBytes hold the same plain bytes or are both null.
+func (g GzipBytes) Equal(other GzipBytes) bool {
+	return g.Valid == other.Valid && (!g.Valid || bytes.Equal(g.Bytes, other.Bytes))
+}
+
+// Merge returns this GzipBytes if it is valid, otherwise other.
+func (g GzipBytes) Merge(other GzipBytes) GzipBytes {
+	if g.Valid {
+		return g
+	}
+	return other
+}
+
+// When returns this GzipBytes if cond is true, otherwise a null GzipBytes.
+func (g GzipBytes) When(cond bool) GzipBytes {
+	if !cond {
+		g.SetNull()
+	}
+	return g
+}
@@ -0,0 +1,104 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestGzipBytesSQL(t *testing.T) {
+	large := []byte(strings.Repeat("nullable ", 500))
+	g := GzipBytesFrom(large)
+	v, err := g.Value()
+	maybePanic(err)
+	stored := v.([]byte)
+	if !bytes.HasPrefix(stored, gzipMagic) || len(stored) >= len(large) {
+		t.Errorf("large value should be compressed: %d bytes", len(stored))
+	}
+
+	var got GzipBytes
+	err = got.Scan(stored)
+	maybePanic(err)
+	if !got.Valid || !bytes.Equal(got.Bytes, large) {
+		t.Errorf("Scan should decompress: %d bytes", len(got.Bytes))
+	}
+
+	small := GzipBytesFrom([]byte("short"))
+	v, err = small.Value()
+	maybePanic(err)
+	if string(v.([]byte)) != "short" {
+		t.Errorf("small value should be stored as-is: %q", v)
+	}
+	err = got.Scan("short")
+	maybePanic(err)
+	if string(got.Bytes) != "short" || !got.Valid {
+		t.Errorf("Scan plain = %+v", got)
+	}
+
+	err = got.Scan(nil)
+	maybePanic(err)
+	if got.Valid {
+		t.Errorf("Scan(nil) = %+v", got)
+	}
+	if v, _ := got.Value(); v != nil {
+		t.Errorf("null Value = %v", v)
+	}
+	if err := got.Scan([]byte{0x1f, 0x8b, 0x00}); err == nil || got.Valid {
+		t.Errorf("corrupt gzip: got %v %+v", err, got)
+	}
+
+	magic := GzipBytesFrom([]byte{0x1f, 0x8b, 'x'})
+	v, err = magic.Value()
+	maybePanic(err)
+	maybePanic(got.Scan(v))
+	if !got.Equal(magic) {
+		t.Errorf("plain value with a gzip header should round trip: %+v", got)
+	}
+
+	defer configured.Store(nil)
+	Configure(Options{GzipThreshold: -1})
+	v, err = small.Value()
+	maybePanic(err)
+	if !bytes.HasPrefix(v.([]byte), gzipMagic) {
+		t.Error("negative threshold should compress every value")
+	}
+}
+
+func TestGzipBytesMaxSize(t *testing.T) {
+	defer configured.Store(nil)
+
+	v, err := GzipBytesFrom(make([]byte, 1<<20)).Value()
+	maybePanic(err)
+	var got GzipBytes
+	Configure(Options{GzipMaxSize: 1 << 10})
+	if err := got.Scan(v); err == nil || got.Valid {
+		t.Errorf("oversized value: got %v %+v", err, got)
+	}
+	Configure(Options{GzipMaxSize: 1 << 20})
+	if err := got.Scan(v); err != nil || len(got.Bytes) != 1<<20 {
+		t.Errorf("value at the limit: got %v, %d bytes", err, len(got.Bytes))
+	}
+	Configure(Options{GzipMaxSize: -1})
+	if err := got.Scan(v); err != nil || len(got.Bytes) != 1<<20 {
+		t.Errorf("no limit: got %v, %d bytes", err, len(got.Bytes))
+	}
+}
+
+func TestGzipBytesJSON(t *testing.T) {
+	data, err := json.Marshal(GzipBytesFrom([]byte("hi")))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"aGk="`, "GzipBytes")
+
+	var g GzipBytes
+	err = json.Unmarshal(data, &g)
+	maybePanic(err)
+	if !g.Equal(GzipBytesFrom([]byte("hi"))) {
+		t.Errorf("round trip: %+v", g)
+	}
+	err = json.Unmarshal([]byte("null"), &g)
+	maybePanic(err)
+	if g.Valid {
+		t.Errorf("null: %+v", g)
+	}
+}
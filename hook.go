@@ -0,0 +1,35 @@
+package null
+
+import "sync/atomic"
+
+// Event describes input that was silently coerced to null instead of returning an error.
+type Event struct {
+	// Type is the null type that received the input, such as "DateString".
+	Type string
+	// Op is the operation that received the input, such as "From", "Scan" or "UnmarshalJSON".
+	Op string
+	// Input is the rejected input.
+	Input string
+	// Err is the reason the input was rejected, if known.
+	Err error
+}
+
+var hook atomic.Pointer[func(Event)]
+
+// SetHook sets a function to call whenever input is silently coerced to null, such as
+// a malformed date passed to DateStringFrom. It can be used to log or count data-quality problems.
+// The hook may be called concurrently and should return quickly. Pass nil to remove the hook.
+func SetHook(fn func(Event)) {
+	if fn == nil {
+		hook.Store(nil)
+		return
+	}
+	hook.Store(&fn)
+}
+
+// coerced reports input that was coerced to null to the hook, if one is set.
+func coerced(typ, op, input string, err error) {
+	if fn := hook.Load(); fn != nil {
+		(*fn)(Event{Type: typ, Op: op, Input: input, Err: err})
+	}
+}
@@ -0,0 +1,82 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSetHook(t *testing.T) {
+	var events []Event
+	SetHook(func(e Event) {
+		events = append(events, e)
+	})
+	defer SetHook(nil)
+
+	DateStringFrom("2024-02-30")
+	var ds DateString
+	err := json.Unmarshal([]byte(`"tomorrow"`), &ds)
+	maybePanic(err)
+	err = ds.UnmarshalText([]byte("yesterday"))
+	maybePanic(err)
+	LanguageTagFrom("not a tag")
+	ParseMonth("Smarch")
+
+	// valid and blank input is not reported
+	DateStringFrom("2024-02-29")
+	err = ds.UnmarshalText([]byte(""))
+	maybePanic(err)
+	ParseWeekday("")
+
+	want := []Event{
+		{Type: "DateString", Op: "From", Input: "2024-02-30"},
+		{Type: "DateString", Op: "UnmarshalJSON", Input: "tomorrow"},
+		{Type: "DateString", Op: "UnmarshalText", Input: "yesterday"},
+		{Type: "LanguageTag", Op: "From", Input: "not a tag"},
+		{Type: "Month", Op: "Parse", Input: "Smarch"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, e := range events {
+		if e.Type != want[i].Type || e.Op != want[i].Op || e.Input != want[i].Input {
+			t.Errorf("event %d: got %+v, want %+v", i, e, want[i])
+		}
+	}
+	if events[0].Err == nil {
+		t.Error("DateString event should carry the parse error")
+	}
+
+	// errors returned to the caller are not reported
+	events = nil
+	var m Month
+	if err := m.UnmarshalText([]byte("Smarch")); err == nil {
+		t.Error("expected error")
+	}
+	if len(events) != 0 {
+		t.Errorf("unexpected events: %+v", events)
+	}
+
+	SetHook(nil)
+	DateStringFrom("bad")
+	if len(events) != 0 {
+		t.Errorf("removed hook should not be called: %+v", events)
+	}
+}
+
+func TestSetHookNullOutOfRange(t *testing.T) {
+	var events []Event
+	SetHook(func(e Event) {
+		events = append(events, e)
+	})
+	defer SetHook(nil)
+
+	defer configured.Store(nil)
+	Configure(Options{PercentNullOutOfRange: true})
+
+	var p Percent
+	err := json.Unmarshal([]byte(`150`), &p)
+	maybePanic(err)
+	if len(events) != 1 || events[0].Type != "Percent" || events[0].Op != "UnmarshalJSON" || events[0].Input != "150" {
+		t.Errorf("bad events: %+v", events)
+	}
+}
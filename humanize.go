@@ -0,0 +1,72 @@
+package null
+
+import (
+	"strconv"
+	"time"
+)
+
+// DateLocalizer renders the distance in days from today to a date, such as "yesterday"
+// for -1 or "in 3 days" for 3. Set it with Options.LocalizeDate.
+type DateLocalizer func(days int) string
+
+// LocalizeDateEnglish is the default DateLocalizer.
+// It returns "today", "yesterday", "tomorrow", "in N days" or "N days ago".
+func LocalizeDateEnglish(days int) string {
+	switch {
+	case days == 0:
+		return "today"
+	case days == -1:
+		return "yesterday"
+	case days == 1:
+		return "tomorrow"
+	case days > 0:
+		return "in " + strconv.Itoa(days) + " days"
+	default:
+		return strconv.Itoa(-days) + " days ago"
+	}
+}
+
+// LocalizeDateThai is a DateLocalizer for Thai.
+// It returns "วันนี้", "เมื่อวาน", "พรุ่งนี้", "อีก N วัน" or "N วันที่แล้ว".
+func LocalizeDateThai(days int) string {
+	switch {
+	case days == 0:
+		return "วันนี้"
+	case days == -1:
+		return "เมื่อวาน"
+	case days == 1:
+		return "พรุ่งนี้"
+	case days > 0:
+		return "อีก " + strconv.Itoa(days) + " วัน"
+	default:
+		return strconv.Itoa(-days) + " วันที่แล้ว"
+	}
+}
+
+// Humanize describes this DateString relative to today, such as "yesterday" or "in 3 days",
+// using Options.LocalizeDate. Today is the date of now in its location,
+// defaulting to the clock set with SetClock.
+// It returns a blank string if this DateString is null or its value is not a date.
+func (s DateString) Humanize(now ...time.Time) string {
+	t, ok := s.date()
+	if !ok {
+		return ""
+	}
+	return config().LocalizeDate(daysBetween(nowOr(now), t))
+}
+
+// nowOr returns t[0], or the current time if t is empty.
+func nowOr(t []time.Time) time.Time {
+	if len(t) > 0 {
+		return t[0]
+	}
+	return now()
+}
+
+// daysBetween returns the number of calendar days from the date of a to the date of b.
+// It counts Unix seconds rather than using Sub, whose Duration saturates after about 292 years.
+func daysBetween(a, b time.Time) int {
+	a = time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	b = time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int((b.Unix() - a.Unix()) / 86400)
+}
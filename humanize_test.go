@@ -0,0 +1,45 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateStringHumanize(t *testing.T) {
+	now := time.Date(2024, 3, 1, 23, 30, 0, 0, time.FixedZone("ICT", 7*60*60))
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2024-03-01", "today"},
+		{"2024-02-29", "yesterday"},
+		{"2024-03-02", "tomorrow"},
+		{"2024-03-04", "in 3 days"},
+		{"2024-02-19", "11 days ago"},
+		{"2023-03-01", "366 days ago"},
+		// more than the 292 years a time.Duration can hold
+		{"1700-03-01", "118339 days ago"},
+		{"2400-03-01", "in 137331 days"},
+	}
+	for _, tc := range tests {
+		if got := DateStringFrom(tc.date).Humanize(now); got != tc.want {
+			t.Errorf("Humanize(%s) = %q, want %q", tc.date, got, tc.want)
+		}
+	}
+
+	if got := NewDateString("", false).Humanize(now); got != "" {
+		t.Errorf("null: got %q, want blank", got)
+	}
+	if got := DateStringFrom(time.Now().Format("2006-01-02")).Humanize(); got != "today" {
+		t.Errorf("default now: got %q, want today", got)
+	}
+
+	defer configured.Store(nil)
+	Configure(Options{LocalizeDate: LocalizeDateThai})
+	if got := DateStringFrom("2024-03-04").Humanize(now); got != "อีก 3 วัน" {
+		t.Errorf("Thai: got %q", got)
+	}
+	if got := DateStringFrom("2024-02-29").Humanize(now); got != "เมื่อวาน" {
+		t.Errorf("Thai: got %q", got)
+	}
+}
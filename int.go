@@ -3,9 +3,11 @@ package null
 import (
 	"bytes"
 	"database/sql"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
 )
 
@@ -47,10 +49,29 @@ func (i Int) ValueOrZero() int64 {
 	return i.Int64
 }
 
+// Scan implements the sql.Scanner interface.
+// It returns a *ScanError if value cannot be converted.
+func (i *Int) Scan(value any) error {
+	if b, ok := value.([]byte); ok {
+		// parse text columns without copying them; errors are reported by NullInt64 below
+		if n, err := strconv.ParseInt(viewString(b), 10, 64); err == nil {
+			i.Int64, i.Valid = n, true
+			return nil
+		}
+	}
+	if err := i.NullInt64.Scan(value); err != nil {
+		i.Valid = false
+		return newScanError("null.Int", value, err)
+	}
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number, string, and null input.
 // 0 will not be considered a null Int.
 func (i *Int) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "Int64")
+	data = trimJSON("Int", data)
 	if bytes.Equal(data, nullBytes) {
 		i.Valid = false
 		return nil
@@ -61,21 +82,21 @@ func (i *Int) UnmarshalJSON(data []byte) error {
 		if errors.As(err, &typeError) {
 			// special case: accept string input
 			if typeError.Value != "string" {
-				return fmt.Errorf("null: JSON input is invalid type (need int or string): %w", err)
+				return fmt.Errorf("%w: invalid type (need int or string): %w", ErrInvalidJSON, err)
 			}
 			var str string
 			if err := json.Unmarshal(data, &str); err != nil {
-				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+				return fmt.Errorf("%w: invalid number string: %w", ErrInvalidJSON, err)
 			}
 			n, err := strconv.ParseInt(str, 10, 64)
 			if err != nil {
-				return fmt.Errorf("null: couldn't convert string to int: %w", err)
+				return fmt.Errorf("%w: couldn't convert string to int: %w", ErrInvalidJSON, err)
 			}
 			i.Int64 = n
 			i.Valid = true
 			return nil
 		}
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
 	}
 
 	i.Valid = true
@@ -86,27 +107,38 @@ func (i *Int) UnmarshalJSON(data []byte) error {
 // It will unmarshal to a null Int if the input is blank.
 // It will return an error if the input is not an integer, blank, or "null".
 func (i *Int) UnmarshalText(text []byte) error {
-	str := string(text)
+	text = prepareText("Int", text)
+	str := viewString(text)
 	if str == "" || str == "null" {
 		i.Valid = false
 		return nil
 	}
 	var err error
-	i.Int64, err = strconv.ParseInt(string(text), 10, 64)
+	i.Int64, err = strconv.ParseInt(str, 10, 64)
 	if err != nil {
-		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidText, err)
 	}
 	i.Valid = true
 	return nil
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (i *Int) UnmarshalParam(param string) error {
+	return i.UnmarshalText(viewBytes(param))
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Int is null.
 func (i Int) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Int", i.Valid, i.marshalJSON)
+}
+
+func (i Int) marshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	return marshalPooled(i.AppendText)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -115,7 +147,50 @@ func (i Int) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	return marshalPooled(i.AppendText)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (i Int) AppendText(buf []byte) ([]byte, error) {
+	if !i.Valid {
+		return buf, nil
+	}
+	return strconv.AppendInt(buf, i.Int64, 10), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Int to v under key, or nothing if null.
+func (i Int) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, i.Valid, i.MarshalText)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Int) MarshalBinary() ([]byte, error) {
+	return i.AppendBinary(make([]byte, 0, 9))
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// It appends the same bytes as MarshalBinary to buf.
+func (i Int) AppendBinary(buf []byte) ([]byte, error) {
+	buf = appendBinaryFlag(buf, i.Valid)
+	if !i.Valid {
+		return buf, nil
+	}
+	return binary.BigEndian.AppendUint64(buf, uint64(i.Int64)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int) UnmarshalBinary(data []byte) error {
+	payload, valid, err := binaryPayload("Int", data, 8)
+	if err != nil {
+		return err
+	}
+	i.Int64, i.Valid = 0, valid
+	if valid {
+		i.Int64 = int64(binary.BigEndian.Uint64(payload))
+	}
+	return nil
 }
 
 // SetValid changes this Int's value and also sets it to be non-null.
@@ -138,7 +213,38 @@ func (i Int) IsZero() bool {
 	return !i.Valid
 }
 
+// IsNull returns true if this Int is null.
+func (i Int) IsNull() bool {
+	return !i.Valid
+}
+
+// SetNull sets this Int to null and clears its value.
+func (i *Int) SetNull() {
+	i.Int64, i.Valid = 0, false
+}
+
+// Reset sets this Int to its zero value, which is null.
+func (i *Int) Reset() {
+	*i = Int{}
+}
+
 // Equal returns true if both ints have the same value or are both null.
 func (i Int) Equal(other Int) bool {
 	return i.Valid == other.Valid && (!i.Valid || i.Int64 == other.Int64)
 }
+
+// Merge returns this Int if it is valid, otherwise other.
+func (i Int) Merge(other Int) Int {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// When returns this Int if cond is true, otherwise a null Int.
+func (i Int) When(cond bool) Int {
+	if !cond {
+		i.SetNull()
+	}
+	return i
+}
@@ -0,0 +1,58 @@
+package null
+
+import (
+	"database/sql"
+	"sync"
+)
+
+// Interner returns a string equal to b, sharing the storage of an earlier equal string where it can,
+// so repeated values such as status codes are not stored for every row.
+// Enable it for String and DateString scans with Options.Intern.
+type Interner func(b []byte) string
+
+// NewInterner returns a concurrency-safe Interner that keeps up to max distinct strings,
+// after which other strings are copied as usual. A max of zero or less keeps every distinct string,
+// which suits columns with few distinct values. The strings are kept until the Interner is discarded.
+func NewInterner(max int) Interner {
+	var mu sync.Mutex
+	seen := make(map[string]string)
+	return func(b []byte) string {
+		mu.Lock()
+		defer mu.Unlock()
+		// the compiler does not allocate for map lookups with string(b)
+		if s, ok := seen[string(b)]; ok {
+			return s
+		}
+		s := string(b)
+		if max <= 0 || len(seen) < max {
+			seen[s] = s
+		}
+		return s
+	}
+}
+
+// scanInterned scans value into ns like ns.Scan,
+// passing text through Options.Intern if it is set.
+func scanInterned(ns *sql.NullString, value any) error {
+	intern := config().Intern
+	if intern == nil {
+		return ns.Scan(value)
+	}
+	switch x := value.(type) {
+	case []byte:
+		ns.String, ns.Valid = intern(x), true
+	case string:
+		ns.String, ns.Valid = intern([]byte(x)), true
+	default:
+		return ns.Scan(value)
+	}
+	return nil
+}
+
+// internString returns s passed through Options.Intern if it is set, otherwise s.
+func internString(s string) string {
+	if intern := config().Intern; intern != nil {
+		return intern([]byte(s))
+	}
+	return s
+}
@@ -0,0 +1,52 @@
+package null
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func sameStorage(a, b string) bool {
+	return unsafe.StringData(a) == unsafe.StringData(b)
+}
+
+func TestInterner(t *testing.T) {
+	intern := NewInterner(2)
+	a := intern([]byte("active"))
+	if b := intern([]byte("active")); b != "active" || !sameStorage(a, b) {
+		t.Errorf("equal strings should share storage: %q", b)
+	}
+	intern([]byte("closed"))
+	c := intern([]byte("pending"))
+	if d := intern([]byte("pending")); d != "pending" || sameStorage(c, d) {
+		t.Error("strings beyond max should not be kept")
+	}
+}
+
+func TestScanInterned(t *testing.T) {
+	defer configured.Store(nil)
+
+	var s1, s2 String
+	maybePanic(s1.Scan([]byte("TH")))
+	maybePanic(s2.Scan([]byte("TH")))
+	if sameStorage(s1.String, s2.String) {
+		t.Error("strings should not be interned by default")
+	}
+
+	Configure(Options{Intern: NewInterner(0)})
+	maybePanic(s1.Scan([]byte("TH")))
+	maybePanic(s2.Scan("TH"))
+	if s2.String != "TH" || !s2.Valid || !sameStorage(s1.String, s2.String) {
+		t.Errorf("String scans should share storage: %+v", s2)
+	}
+
+	var d1, d2 DateString
+	maybePanic(d1.Scan([]byte("2024-01-02")))
+	maybePanic(d2.Scan("2024-01-02T00:00:00Z"))
+	if d2.String != "2024-01-02" || !sameStorage(d1.String, d2.String) {
+		t.Errorf("DateString scans should share storage: %+v", d2)
+	}
+
+	if err := s1.Scan(nil); err != nil || s1.Valid {
+		t.Errorf("Scan(nil) = %v %+v", err, s1)
+	}
+}
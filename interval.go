@@ -0,0 +1,472 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interval is a nullable calendar interval in the style of SQL INTERVAL.
+// Months and days are kept separate from the clock time because their length
+// depends on the date they are applied to.
+// It unmarshals ISO 8601 durations ("P1Y2M3DT4H") and Postgres interval output
+// ("1 year 2 mons 3 days 04:00:00"), and marshals to ISO 8601.
+// It will marshal to null if null.
+type Interval struct {
+	Months int
+	Days   int
+	// Time is the hours, minutes and seconds part of the interval.
+	Time  time.Duration
+	Valid bool
+}
+
+// NewInterval creates a new Interval.
+func NewInterval(months, days int, t time.Duration, valid bool) Interval {
+	return Interval{
+		Months: months,
+		Days:   days,
+		Time:   t,
+		Valid:  valid,
+	}
+}
+
+// IntervalFrom creates a new Interval that will always be valid.
+func IntervalFrom(months, days int, t time.Duration) Interval {
+	return NewInterval(months, days, t, true)
+}
+
+// IntervalFromDuration creates a new Interval holding only a clock time that will always be valid.
+func IntervalFromDuration(d time.Duration) Interval {
+	return NewInterval(0, 0, d, true)
+}
+
+// IntervalFromPtr creates a new Interval from a duration that will be null if d is nil.
+func IntervalFromPtr(d *time.Duration) Interval {
+	if d == nil {
+		return NewInterval(0, 0, 0, false)
+	}
+	return IntervalFromDuration(*d)
+}
+
+// ParseInterval parses an ISO 8601 duration or a Postgres interval.
+// A blank string produces a null Interval.
+func ParseInterval(s string) (Interval, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Interval{}, nil
+	}
+	var i Interval
+	var err error
+	if strings.HasPrefix(s, "P") || strings.HasPrefix(s, "-P") || strings.HasPrefix(s, "+P") {
+		err = i.parseISO(s)
+	} else {
+		err = i.parsePostgres(s)
+	}
+	if err != nil {
+		return Interval{}, fmt.Errorf("null: invalid interval %q: %w", s, err)
+	}
+	i.Valid = true
+	return i, nil
+}
+
+func (i *Interval) parseISO(s string) error {
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	s = s[1:] // P
+	if s == "" {
+		return errors.New("empty duration")
+	}
+	inTime := false
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime {
+				return errors.New("repeated T")
+			}
+			inTime = true
+			s = s[1:]
+			if s == "" {
+				return errors.New("empty time part")
+			}
+			continue
+		}
+		end := 0
+		for end < len(s) && (s[end] == '-' || s[end] == '+' || s[end] == '.' || s[end] == ',' || (s[end] >= '0' && s[end] <= '9')) {
+			end++
+		}
+		if end == 0 || end == len(s) {
+			return errors.New("expected number followed by a designator")
+		}
+		num, unit := strings.Replace(s[:end], ",", ".", 1), s[end]
+		s = s[end+1:]
+
+		if inTime {
+			d, err := time.ParseDuration(num + strings.ToLower(string(unit)))
+			if err != nil || (unit != 'H' && unit != 'M' && unit != 'S') {
+				return fmt.Errorf("bad time component %s%c", num, unit)
+			}
+			i.Time += d
+			continue
+		}
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			return fmt.Errorf("bad date component %s%c", num, unit)
+		}
+		switch unit {
+		case 'Y':
+			i.Months += n * 12
+		case 'M':
+			i.Months += n
+		case 'W':
+			i.Days += n * 7
+		case 'D':
+			i.Days += n
+		default:
+			return fmt.Errorf("unknown designator %c", unit)
+		}
+	}
+	if neg {
+		i.Months, i.Days, i.Time = -i.Months, -i.Days, -i.Time
+	}
+	return nil
+}
+
+// parsePostgres parses the "postgres" and "postgres_verbose" IntervalStyle outputs,
+// such as "1 year 2 mons -3 days +04:05:06.5" or "@ 1 year 2 mons 4 hours ago".
+func (i *Interval) parsePostgres(s string) error {
+	fields := strings.Fields(s)
+	ago := false
+	if len(fields) > 0 && fields[0] == "@" {
+		fields = fields[1:]
+	}
+	if len(fields) > 0 && fields[len(fields)-1] == "ago" {
+		ago = true
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) == 0 {
+		return errors.New("empty interval")
+	}
+	for len(fields) > 0 {
+		f := fields[0]
+		if strings.Contains(f, ":") {
+			d, err := parseClock(f)
+			if err != nil {
+				return err
+			}
+			i.Time += d
+			fields = fields[1:]
+			continue
+		}
+		if len(fields) < 2 {
+			return fmt.Errorf("missing unit after %q", f)
+		}
+		rawUnit := fields[1]
+		unit := strings.TrimSuffix(strings.ToLower(rawUnit), "s")
+		fields = fields[2:]
+		switch unit {
+		case "year", "mon", "month", "day", "week":
+			n, err := strconv.Atoi(f)
+			if err != nil {
+				return fmt.Errorf("bad %s count %q", unit, f)
+			}
+			switch unit {
+			case "year":
+				i.Months += n * 12
+			case "mon", "month":
+				i.Months += n
+			case "week":
+				i.Days += n * 7
+			case "day":
+				i.Days += n
+			}
+		case "hour", "min", "minute", "sec", "second":
+			u := map[string]string{"hour": "h", "min": "m", "minute": "m", "sec": "s", "second": "s"}[unit]
+			d, err := time.ParseDuration(f + u)
+			if err != nil {
+				return fmt.Errorf("bad %s count %q", unit, f)
+			}
+			i.Time += d
+		default:
+			return fmt.Errorf("unknown unit %q", rawUnit)
+		}
+	}
+	if ago {
+		i.Months, i.Days, i.Time = -i.Months, -i.Days, -i.Time
+	}
+	return nil
+}
+
+// parseClock parses [+-]hh:mm[:ss[.frac]].
+func parseClock(s string) (time.Duration, error) {
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("bad time %q", s)
+	}
+	units := []string{"h", "m", "s"}
+	var d time.Duration
+	for n, p := range parts {
+		if p == "" || strings.ContainsAny(p, "+-") {
+			return 0, fmt.Errorf("bad time %q", s)
+		}
+		part, err := time.ParseDuration(p + units[n])
+		if err != nil {
+			return 0, fmt.Errorf("bad time %q", s)
+		}
+		d += part
+	}
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
+// ISO8601 formats the interval as an ISO 8601 duration, such as "P1Y2M3DT4H5M6.5S".
+// Negative components carry their own sign. It returns a blank string if null.
+func (i Interval) ISO8601() string {
+	if !i.Valid {
+		return ""
+	}
+	if i.Months == 0 && i.Days == 0 && i.Time == 0 {
+		return "PT0S"
+	}
+	b := []byte{'P'}
+	if y := i.Months / 12; y != 0 {
+		b = strconv.AppendInt(b, int64(y), 10)
+		b = append(b, 'Y')
+	}
+	if m := i.Months % 12; m != 0 {
+		b = strconv.AppendInt(b, int64(m), 10)
+		b = append(b, 'M')
+	}
+	if i.Days != 0 {
+		b = strconv.AppendInt(b, int64(i.Days), 10)
+		b = append(b, 'D')
+	}
+	if i.Time != 0 {
+		b = append(b, 'T')
+		t := i.Time
+		neg := t < 0
+		if neg {
+			t = -t
+		}
+		sign := func() {
+			if neg {
+				b = append(b, '-')
+			}
+		}
+		if h := t / time.Hour; h != 0 {
+			sign()
+			b = strconv.AppendInt(b, int64(h), 10)
+			b = append(b, 'H')
+		}
+		if m := t % time.Hour / time.Minute; m != 0 {
+			sign()
+			b = strconv.AppendInt(b, int64(m), 10)
+			b = append(b, 'M')
+		}
+		if sec := t % time.Minute; sec != 0 {
+			sign()
+			b = strconv.AppendFloat(b, sec.Seconds(), 'f', -1, 64)
+			b = append(b, 'S')
+		}
+	}
+	return string(b)
+}
+
+// Duration returns the interval as a time.Duration.
+// ok is false if the interval is null or has month or day components,
+// whose length is not fixed.
+func (i Interval) Duration() (d time.Duration, ok bool) {
+	if !i.Valid || i.Months != 0 || i.Days != 0 {
+		return 0, false
+	}
+	return i.Time, true
+}
+
+// AddTo returns t shifted by the interval, applying months and days in t's location
+// before the clock time. It returns t unchanged if the interval is null.
+func (i Interval) AddTo(t time.Time) time.Time {
+	if !i.Valid {
+		return t
+	}
+	return t.AddDate(0, i.Months, i.Days).Add(i.Time)
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports text columns in ISO 8601 or Postgres interval format.
+func (i *Interval) Scan(value any) error {
+	var str string
+	switch x := value.(type) {
+	case nil:
+		*i = Interval{}
+		return nil
+	case string:
+		str = x
+	case []byte:
+		str = string(x)
+	default:
+		i.Valid = false
+		return newScanError("null.Interval", value, nil)
+	}
+	parsed, err := ParseInterval(str)
+	if err != nil {
+		i.Valid = false
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It encodes the interval as an ISO 8601 string, which Postgres accepts as interval input.
+func (i Interval) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return i.ISO8601(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (i *Interval) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "Value")
+	data = trimJSON("Interval", data)
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	parsed, err := ParseInterval(str)
+	if err != nil {
+		i.Valid = false
+		return unmarshalError(ErrInvalidJSON, err)
+	}
+	*i = parsed
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Interval if the input is blank or "null".
+func (i *Interval) UnmarshalText(text []byte) error {
+	text = prepareText("Interval", text)
+	str := string(text)
+	if str == "null" {
+		str = ""
+	}
+	parsed, err := ParseInterval(str)
+	if err != nil {
+		i.Valid = false
+		return unmarshalError(ErrInvalidText, err)
+	}
+	*i = parsed
+	return nil
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (i *Interval) UnmarshalParam(param string) error {
+	return i.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Interval is null.
+func (i Interval) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Interval", i.Valid, i.marshalJSON)
+}
+
+func (i Interval) marshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(i.ISO8601())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Interval is null.
+func (i Interval) MarshalText() ([]byte, error) {
+	return []byte(i.ISO8601()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (i Interval) AppendText(buf []byte) ([]byte, error) {
+	return append(buf, i.ISO8601()...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Interval to v under key, or nothing if null.
+func (i Interval) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, i.Valid, i.MarshalText)
+}
+
+// SetValid changes this Interval's value and also sets it to be non-null.
+func (i *Interval) SetValid(months, days int, t time.Duration) {
+	*i = IntervalFrom(months, days, t)
+}
+
+// IsZero returns true for null Intervals, for potential future omitempty support.
+// A non-null zero-length Interval will not be considered zero.
+func (i Interval) IsZero() bool {
+	return !i.Valid
+}
+
+// IsNull returns true if this Interval is null.
+func (i Interval) IsNull() bool {
+	return !i.Valid
+}
+
+// SetNull sets this Interval to null and clears its value.
+func (i *Interval) SetNull() {
+	i.Months, i.Days, i.Time, i.Valid = 0, 0, 0, false
+}
+
+// Reset sets this Interval to its zero value, which is null.
+func (i *Interval) Reset() {
+	*i = Interval{}
+}
+
+// Equal returns true if both intervals have the same components or are both null.
+// Intervals of equal length but different components, such as "P1D" and "PT24H", are not equal.
+func (i Interval) Equal(other Interval) bool {
+	return i.Valid == other.Valid && (!i.Valid ||
+		(i.Months == other.Months && i.Days == other.Days && i.Time == other.Time))
+}
+
+// Merge returns this Interval if it is valid, otherwise other.
+func (i Interval) Merge(other Interval) Interval {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// When returns this Interval if cond is true, otherwise a null Interval.
+func (i Interval) When(cond bool) Interval {
+	if !cond {
+		i.SetNull()
+	}
+	return i
+}
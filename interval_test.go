@@ -0,0 +1,148 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Interval
+	}{
+		{"P1Y2M3DT4H", IntervalFrom(14, 3, 4*time.Hour)},
+		{"P2W", IntervalFrom(0, 14, 0)},
+		{"PT1H30M0.5S", IntervalFrom(0, 0, 90*time.Minute+500*time.Millisecond)},
+		{"PT0,25S", IntervalFrom(0, 0, 250*time.Millisecond)},
+		{"-P1DT2H", IntervalFrom(0, -1, -2*time.Hour)},
+		{"P-1M", IntervalFrom(-1, 0, 0)},
+		{"1 year 2 mons 3 days 04:05:06.5", IntervalFrom(14, 3, 4*time.Hour+5*time.Minute+6500*time.Millisecond)},
+		{"-1 days +02:00:00", IntervalFrom(0, -1, 2*time.Hour)},
+		{"00:00:01", IntervalFrom(0, 0, time.Second)},
+		{"-00:30:00", IntervalFrom(0, 0, -30*time.Minute)},
+		{"@ 1 year 4 hours 5 mins 6.5 secs ago", IntervalFrom(-12, 0, -(4*time.Hour + 5*time.Minute + 6500*time.Millisecond))},
+		{"", Interval{}},
+	}
+	for _, tc := range tests {
+		got, err := ParseInterval(tc.in)
+		if err != nil {
+			t.Errorf("ParseInterval(%q): %v", tc.in, err)
+			continue
+		}
+		if !got.Equal(tc.want) {
+			t.Errorf("ParseInterval(%q) = %+v, want %+v", tc.in, got, tc.want)
+		}
+	}
+
+	for _, bad := range []string{"P", "PT", "P1H", "PT1D", "P1.5Y", "PXD", "1 fortnight", "3 days 1", "1::2", "P1DT2HT3M"} {
+		if i, err := ParseInterval(bad); err == nil {
+			t.Errorf("ParseInterval(%q) should fail, got %+v", bad, i)
+		}
+	}
+}
+
+func TestIntervalISO8601(t *testing.T) {
+	tests := []struct {
+		in   Interval
+		want string
+	}{
+		{IntervalFrom(14, 3, 4*time.Hour), "P1Y2M3DT4H"},
+		{IntervalFrom(0, 0, 90*time.Minute+500*time.Millisecond), "PT1H30M0.5S"},
+		{IntervalFrom(0, -1, -2*time.Hour-time.Second), "P-1DT-2H-1S"},
+		{IntervalFrom(0, 0, 0), "PT0S"},
+		{Interval{}, ""},
+	}
+	for _, tc := range tests {
+		if got := tc.in.ISO8601(); got != tc.want {
+			t.Errorf("%+v.ISO8601() = %q, want %q", tc.in, got, tc.want)
+		}
+		if !tc.in.Valid {
+			continue
+		}
+		back, err := ParseInterval(tc.want)
+		maybePanic(err)
+		if !back.Equal(tc.in) {
+			t.Errorf("round trip of %q = %+v, want %+v", tc.want, back, tc.in)
+		}
+	}
+}
+
+func TestIntervalDuration(t *testing.T) {
+	if d, ok := IntervalFromDuration(time.Minute).Duration(); !ok || d != time.Minute {
+		t.Errorf("bad Duration(): %v %t", d, ok)
+	}
+	if _, ok := IntervalFrom(0, 1, 0).Duration(); ok {
+		t.Error("interval with days should not convert to a Duration")
+	}
+	if _, ok := IntervalFromPtr(nil).Duration(); ok {
+		t.Error("null interval should not convert to a Duration")
+	}
+
+	base := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	got := IntervalFrom(1, 1, time.Hour).AddTo(base)
+	if want := time.Date(2024, 3, 3, 13, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("AddTo() = %v, want %v", got, want)
+	}
+}
+
+func TestIntervalJSON(t *testing.T) {
+	var i Interval
+	err := json.Unmarshal([]byte(`"P1DT12H"`), &i)
+	maybePanic(err)
+	if !i.Equal(IntervalFrom(0, 1, 12*time.Hour)) {
+		t.Errorf("bad unmarshaled interval: %+v", i)
+	}
+
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"P1DT12H"`, "interval json marshal")
+
+	var null Interval
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should produce a null interval")
+	}
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	var bad Interval
+	err = json.Unmarshal([]byte(`"soon"`), &bad)
+	if err == nil || bad.Valid {
+		t.Error("expected error and null interval")
+	}
+
+	var text Interval
+	err = text.UnmarshalText([]byte("2 days"))
+	maybePanic(err)
+	if !text.Equal(IntervalFrom(0, 2, 0)) {
+		t.Errorf("bad UnmarshalText(): %+v", text)
+	}
+}
+
+func TestIntervalScanValue(t *testing.T) {
+	var i Interval
+	err := i.Scan([]byte("1 mon 00:15:00"))
+	maybePanic(err)
+	if !i.Equal(IntervalFrom(1, 0, 15*time.Minute)) {
+		t.Errorf("bad scanned interval: %+v", i)
+	}
+	if v, err := i.Value(); v != "P1MT15M" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null Interval
+	err = null.Scan(nil)
+	maybePanic(err)
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong Interval
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
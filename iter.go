@@ -0,0 +1,24 @@
+package null
+
+import "iter"
+
+// DaysBetween returns an iterator over each date from from to to, inclusive.
+// It yields nothing if either DateString is null or not a date, or if to is before from.
+func DaysBetween(from, to DateString) iter.Seq[DateString] {
+	return func(yield func(DateString) bool) {
+		start, ok := from.date()
+		if !ok {
+			return
+		}
+		end, ok := to.date()
+		if !ok {
+			return
+		}
+		layout := config().DateFormat
+		for t := start; !t.After(end); t = t.AddDate(0, 0, 1) {
+			if !yield(NewDateString(t.Format(layout), true)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package null
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDaysBetween(t *testing.T) {
+	var got []string
+	for d := range DaysBetween(DateStringFrom("2024-02-27"), DateStringFrom("2024-03-01")) {
+		got = append(got, d.String)
+	}
+	want := []string{"2024-02-27", "2024-02-28", "2024-02-29", "2024-03-01"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for _, tc := range [][2]DateString{
+		{DateStringFrom("2024-03-02"), DateStringFrom("2024-03-01")},
+		{NewDateString("", false), DateStringFrom("2024-03-01")},
+		{DateStringFrom("2024-03-01"), NewDateString("", false)},
+	} {
+		for d := range DaysBetween(tc[0], tc[1]) {
+			t.Errorf("DaysBetween(%+v, %+v) yielded %+v", tc[0], tc[1], d)
+		}
+	}
+
+	n := 0
+	for range DaysBetween(DateStringFrom("2024-01-01"), DateStringFrom("2024-12-31")) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("break: got %d iterations", n)
+	}
+}
@@ -0,0 +1,330 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSON is a nullable raw JSON document, such as a Postgres json or jsonb column
+// whose shape is not known in advance. It keeps the encoded bytes; to decode into a
+// Go type, use Object instead.
+// It will marshal to null if null.
+type JSON struct {
+	JSON  json.RawMessage
+	Valid bool
+}
+
+// NewJSON creates a new JSON. The value is stored as-is and is not validated.
+func NewJSON(data []byte, valid bool) JSON {
+	return JSON{
+		JSON:  data,
+		Valid: valid,
+	}
+}
+
+// JSONFrom creates a new JSON from data.
+// It will be null if data is not valid JSON or is the JSON null literal.
+func JSONFrom(data []byte) JSON {
+	var j JSON
+	if err := j.set(data); err != nil {
+		coerced("JSON", "From", string(data), err)
+	}
+	return j
+}
+
+// JSONFromPtr creates a new JSON that will be null if data is nil.
+func JSONFromPtr(data *[]byte) JSON {
+	if data == nil {
+		return NewJSON(nil, false)
+	}
+	return JSONFrom(*data)
+}
+
+// set copies data into this JSON, which becomes null for JSON null or invalid input.
+func (j *JSON) set(data []byte) error {
+	j.JSON, j.Valid = nil, false
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, nullBytes) {
+		return nil
+	}
+	if !json.Valid(trimmed) {
+		return fmt.Errorf("%w: invalid JSON document", ErrInvalidJSON)
+	}
+	j.JSON, j.Valid = bytes.Clone(trimmed), true
+	return nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (j JSON) ValueOrZero() json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	return j.JSON
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil input. SQL NULL and JSON null produce a null JSON.
+// It returns an error wrapping ErrInvalidJSON if the input is not valid JSON.
+func (j *JSON) Scan(value any) error {
+	switch x := value.(type) {
+	case nil:
+		j.JSON, j.Valid = nil, false
+		return nil
+	case string:
+		return j.set([]byte(x))
+	case []byte:
+		return j.set(x)
+	default:
+		j.JSON, j.Valid = nil, false
+		return newScanError("null.JSON", value, nil)
+	}
+}
+
+// Value implements the driver Valuer interface.
+// It returns the JSON document as []byte, or nil if null.
+func (j JSON) Value() (driver.Value, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	return []byte(j.JSON), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It decodes null to a null JSON and keeps any other input as-is.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	data = trimJSON("JSON", data)
+	return j.set(data)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this JSON is null.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("JSON", j.Valid, j.marshalJSON)
+}
+
+func (j JSON) marshalJSON() ([]byte, error) {
+	if !j.Valid {
+		return []byte("null"), nil
+	}
+	return j.JSON, nil
+}
+
+// SetValid changes this JSON's value and also sets it to be non-null.
+// The value is stored as-is and is not validated.
+func (j *JSON) SetValid(v json.RawMessage) {
+	j.JSON = v
+	j.Valid = true
+}
+
+// Ptr returns a pointer to this JSON's value, or a nil pointer if this JSON is null.
+func (j JSON) Ptr() *json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	return &j.JSON
+}
+
+// IsZero returns true for null JSON, for potential future omitempty support.
+func (j JSON) IsZero() bool {
+	return !j.Valid
+}
+
+// IsNull returns true if this JSON is null.
+func (j JSON) IsNull() bool {
+	return !j.Valid
+}
+
+// SetNull sets this JSON to null and clears its value.
+func (j *JSON) SetNull() {
+	j.JSON, j.Valid = nil, false
+}
+
+// Reset sets this JSON to its zero value, which is null.
+func (j *JSON) Reset() {
+	*j = JSON{}
+}
+
+// Equal returns true if both JSON documents have the same bytes or are both null.
+func (j JSON) Equal(other JSON) bool {
+	return j.Valid == other.Valid && (!j.Valid || bytes.Equal(j.JSON, other.JSON))
+}
+
+// Merge returns this JSON if it is valid, otherwise other.
+func (j JSON) Merge(other JSON) JSON {
+	if j.Valid {
+		return j
+	}
+	return other
+}
+
+// When returns this JSON if cond is true, otherwise a null JSON.
+func (j JSON) When(cond bool) JSON {
+	if !cond {
+		j.SetNull()
+	}
+	return j
+}
+
+// GetString returns the string at path, such as "address.city" or the JSON Pointer "/address/city".
+// It is null if this JSON is null, or if path is missing or does not hold a string.
+func (j JSON) GetString(path string) String {
+	if s, ok := j.get(path).(string); ok {
+		return StringFrom(s)
+	}
+	return NewString("", false)
+}
+
+// GetInt returns the integer at path, such as "items.0.qty" or the JSON Pointer "/items/0/qty".
+// It is null if this JSON is null, or if path is missing or does not hold an integer.
+func (j JSON) GetInt(path string) Int {
+	if n, ok := j.get(path).(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			return IntFrom(i)
+		}
+	}
+	return NewInt(0, false)
+}
+
+// GetDate returns the date at path, such as "profile.birth_date", parsed like DateStringFrom.
+// It is null if this JSON is null, or if path is missing or does not hold a date string.
+func (j JSON) GetDate(path string) DateString {
+	if s, ok := j.get(path).(string); ok {
+		return DateStringFrom(s)
+	}
+	return NewDateString("", false)
+}
+
+// Canonical returns this JSON re-encoded in a canonical form, so documents that differ only in
+// key order, whitespace or number spelling have the same bytes: object keys are sorted,
+// insignificant whitespace is removed, and numbers are written like encoding/json writes a float64,
+// except integers that fit in an int64, which are kept exact.
+// A null JSON is returned unchanged.
+func (j JSON) Canonical() (JSON, error) {
+	if !j.Valid {
+		return j, nil
+	}
+	v, err := j.decode()
+	if err != nil {
+		return JSON{}, err
+	}
+	v, err = canonicalNumbers(v)
+	if err != nil {
+		return JSON{}, err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return JSON{}, err
+	}
+	return NewJSON(bytes.TrimSuffix(buf.Bytes(), []byte("\n")), true), nil
+}
+
+// canonicalNumbers rewrites the json.Numbers in v in canonical form.
+func canonicalNumbers(v any) (any, error) {
+	switch x := v.(type) {
+	case json.Number:
+		if i, err := strconv.ParseInt(string(x), 10, 64); err == nil {
+			return json.Number(strconv.FormatInt(i, 10)), nil
+		}
+		f, err := x.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+		}
+		data, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+		return json.Number(data), nil
+	case map[string]any:
+		for k, elem := range x {
+			c, err := canonicalNumbers(elem)
+			if err != nil {
+				return nil, err
+			}
+			x[k] = c
+		}
+	case []any:
+		for i, elem := range x {
+			c, err := canonicalNumbers(elem)
+			if err != nil {
+				return nil, err
+			}
+			x[i] = c
+		}
+	}
+	return v, nil
+}
+
+// DeepEqual returns true if both JSON documents are null, or have the same Canonical form.
+// Documents that cannot be canonicalized are compared byte for byte.
+func (j JSON) DeepEqual(other JSON) bool {
+	if !j.Valid || !other.Valid {
+		return j.Valid == other.Valid
+	}
+	a, aerr := j.Canonical()
+	b, berr := other.Canonical()
+	if aerr != nil || berr != nil {
+		return bytes.Equal(j.JSON, other.JSON)
+	}
+	return bytes.Equal(a.JSON, b.JSON)
+}
+
+// decode decodes this JSON with numbers as json.Number.
+func (j JSON) decode() (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(j.JSON))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	return v, nil
+}
+
+// get decodes this JSON and returns the value at path, or nil if there is none.
+// Numbers are returned as json.Number.
+func (j JSON) get(path string) any {
+	if !j.Valid {
+		return nil
+	}
+	v, err := j.decode()
+	if err != nil {
+		return nil
+	}
+	for _, key := range splitJSONPath(path) {
+		switch x := v.(type) {
+		case map[string]any:
+			v = x[key]
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(x) {
+				return nil
+			}
+			v = x[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// splitJSONPath splits a JSON Pointer, which starts with "/", or a dotted path into its keys.
+func splitJSONPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+	if !strings.HasPrefix(path, "/") {
+		return strings.Split(path, ".")
+	}
+	keys := strings.Split(path[1:], "/")
+	for i, key := range keys {
+		keys[i] = pointerUnescaper.Replace(key)
+	}
+	return keys
+}
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
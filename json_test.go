@@ -0,0 +1,163 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var profileJSON = []byte(`{
+	"name": "Somchai",
+	"age": 41,
+	"birth_date": "1983-04-13",
+	"address": {"city": "Chiang Mai", "zip/code": "50200"},
+	"phones": [{"number": "+66812345678"}],
+	"score": 4.5,
+	"nickname": null
+}`)
+
+func TestJSONFrom(t *testing.T) {
+	j := JSONFrom(profileJSON)
+	if !j.Valid || !json.Valid(j.JSON) {
+		t.Errorf("JSONFrom: %+v", j)
+	}
+	for _, in := range []string{"", "null", " null ", "{bad"} {
+		if j := JSONFrom([]byte(in)); j.Valid {
+			t.Errorf("JSONFrom(%q): expected null, got %s", in, j.JSON)
+		}
+	}
+	if j := JSONFromPtr(nil); j.Valid {
+		t.Errorf("JSONFromPtr(nil): %+v", j)
+	}
+}
+
+func TestJSONMarshal(t *testing.T) {
+	var v struct {
+		Doc   JSON `json:"doc"`
+		Empty JSON `json:"empty"`
+	}
+	err := json.Unmarshal([]byte(`{"doc": {"a": [1, 2]}, "empty": null}`), &v)
+	maybePanic(err)
+	if !v.Doc.Valid || string(v.Doc.JSON) != `{"a": [1, 2]}` {
+		t.Errorf("doc = %+v", v.Doc)
+	}
+	if v.Empty.Valid {
+		t.Errorf("empty = %+v", v.Empty)
+	}
+	data, err := json.Marshal(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"doc":{"a":[1,2]},"empty":null}`, "JSON")
+}
+
+func TestJSONSQL(t *testing.T) {
+	var j JSON
+	src := []byte(`{"a":1}`)
+	err := j.Scan(src)
+	maybePanic(err)
+	src[1] = 'X'
+	if string(j.JSON) != `{"a":1}` {
+		t.Errorf("Scan should copy its input: %s", j.JSON)
+	}
+	v, err := j.Value()
+	maybePanic(err)
+	if string(v.([]byte)) != `{"a":1}` {
+		t.Errorf("Value = %s", v)
+	}
+
+	err = j.Scan(nil)
+	maybePanic(err)
+	if v, _ := j.Value(); v != nil || j.Valid {
+		t.Errorf("Scan(nil): %+v", j)
+	}
+	if err := j.Scan("{bad"); !errors.Is(err, ErrInvalidJSON) {
+		t.Errorf("bad JSON: expected ErrInvalidJSON, got %v", err)
+	}
+	if err := j.Scan(1); !errors.Is(err, ErrScanType) {
+		t.Errorf("int: expected ErrScanType, got %v", err)
+	}
+}
+
+func TestJSONGet(t *testing.T) {
+	j := JSONFrom(profileJSON)
+
+	for _, path := range []string{"address.city", "/address/city"} {
+		if got := j.GetString(path); got.String != "Chiang Mai" || !got.Valid {
+			t.Errorf("GetString(%q) = %+v", path, got)
+		}
+	}
+	if got := j.GetString("/address/zip~1code"); got.String != "50200" {
+		t.Errorf("escaped pointer: %+v", got)
+	}
+	if got := j.GetString("phones.0.number"); got.String != "+66812345678" {
+		t.Errorf("array index: %+v", got)
+	}
+	for _, path := range []string{"nickname", "missing", "age", "phones.1.number", "name.first", "phones.x"} {
+		if got := j.GetString(path); got.Valid {
+			t.Errorf("GetString(%q): expected null, got %+v", path, got)
+		}
+	}
+
+	if got := j.GetInt("age"); got.Int64 != 41 || !got.Valid {
+		t.Errorf("GetInt(age) = %+v", got)
+	}
+	for _, path := range []string{"score", "name", "nickname"} {
+		if got := j.GetInt(path); got.Valid {
+			t.Errorf("GetInt(%q): expected null, got %+v", path, got)
+		}
+	}
+
+	if got := j.GetDate("birth_date"); got.String != "1983-04-13" || !got.Valid {
+		t.Errorf("GetDate = %+v", got)
+	}
+	if got := j.GetDate("name"); got.Valid {
+		t.Errorf("GetDate(name): expected null, got %+v", got)
+	}
+
+	if got := (JSON{}).GetString("name"); got.Valid {
+		t.Errorf("null JSON: %+v", got)
+	}
+}
+
+func TestJSONCanonical(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`{"b": 1, "a": {"d": [3, 2], "c": "<x>"}}`, `{"a":{"c":"<x>","d":[3,2]},"b":1}`},
+		{`[1.0, 1e2, 0.50, -0, 12345678901234567890]`, `[1,100,0.5,0,12345678901234567000]`},
+		{`9007199254740993`, `9007199254740993`},
+		{` "text" `, `"text"`},
+	}
+	for _, tc := range tests {
+		got, err := JSONFrom([]byte(tc.in)).Canonical()
+		maybePanic(err)
+		if string(got.JSON) != tc.want || !got.Valid {
+			t.Errorf("Canonical(%s) = %s, want %s", tc.in, got.JSON, tc.want)
+		}
+	}
+
+	if got, err := (JSON{}).Canonical(); err != nil || got.Valid {
+		t.Errorf("null: got %+v, %v", got, err)
+	}
+	if _, err := NewJSON([]byte("{bad"), true).Canonical(); !errors.Is(err, ErrInvalidJSON) {
+		t.Errorf("bad JSON: expected ErrInvalidJSON, got %v", err)
+	}
+}
+
+func TestJSONDeepEqual(t *testing.T) {
+	a := JSONFrom([]byte(`{"id": 1, "tags": ["x", "y"], "price": 10.50}`))
+	b := JSONFrom([]byte(`{"price":10.5,"tags":["x","y"],"id":1.0}`))
+	if !a.DeepEqual(b) {
+		t.Error("documents differing in key order, whitespace and number spelling should be equal")
+	}
+	if a.Equal(b) {
+		t.Error("Equal should compare bytes")
+	}
+	c := JSONFrom([]byte(`{"id": 1, "tags": ["y", "x"], "price": 10.5}`))
+	if a.DeepEqual(c) {
+		t.Error("array order is significant")
+	}
+	if a.DeepEqual(JSON{}) || !(JSON{}).DeepEqual(JSON{}) {
+		t.Error("null handling")
+	}
+}
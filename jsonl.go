@@ -0,0 +1,154 @@
+package null
+
+import (
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// JSONLWriter writes values as JSON Lines, one JSON document per line, such as for data exports.
+// Structs are encoded like Marshal, honoring the json and null struct tags. String, Int, Float,
+// Bool, Time and DateString fields are appended to a reused buffer without allocating,
+// so encoding a pointer to a struct of them costs no allocations once the buffer has grown.
+// A JSONLWriter is not safe for concurrent use.
+type JSONLWriter struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewJSONLWriter returns a JSONLWriter that writes to w, with one Write call per line.
+// Wrap w in a bufio.Writer to batch small lines.
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	return &JSONLWriter{w: w}
+}
+
+// Encode writes the JSON encoding of v followed by a newline.
+// Pass a pointer to a struct to avoid copying it.
+func (w *JSONLWriter) Encode(v any) error {
+	buf, err := appendJSONLine(w.buf[:0], v)
+	if err != nil {
+		return err
+	}
+	buf = append(buf, '\n')
+	w.buf = buf
+	_, err = w.w.Write(buf)
+	return err
+}
+
+func appendJSONLine(buf []byte, v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return append(buf, "null"...), nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		data, err := Marshal(v)
+		return append(buf, data...), err
+	}
+	if !rv.CanAddr() {
+		// the fast paths take the address of each field
+		p := reflect.New(rv.Type())
+		p.Elem().Set(rv)
+		rv = p.Elem()
+	}
+	fields, err := cachedTagFields(rv.Type())
+	if err != nil {
+		return buf, err
+	}
+
+	opts := config()
+	buf = append(buf, '{')
+	first := true
+	for _, f := range fields {
+		fv := rv.FieldByIndex(f.index)
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		if f.omitNull && isNullValue(fv) {
+			continue
+		}
+		if !first {
+			buf = append(buf, ',')
+		}
+		first = false
+		buf = appendJSONString(buf, f.name)
+		buf = append(buf, ':')
+		if buf, err = appendJSONField(buf, f, fv, &opts); err != nil {
+			return buf, err
+		}
+	}
+	return append(buf, '}'), nil
+}
+
+// appendJSONField appends the encoding of the field fv to buf, as marshal would return it.
+func appendJSONField(buf []byte, f tagField, fv reflect.Value, opts *Options) ([]byte, error) {
+	if f.format == "" && !f.emptyAsZero {
+		if out, ok := appendJSONValue(buf, fv.Addr().Interface(), opts); ok {
+			return out, nil
+		}
+	}
+	data, err := f.marshal(fv)
+	return append(buf, data...), err
+}
+
+// appendJSONValue appends the plain JSON encoding of the value p points to.
+// It reports false for types without an append path, values in the JSONObject shape,
+// and values that MarshalJSON rejects, leaving the encoding or the error to MarshalJSON.
+func appendJSONValue(buf []byte, p any, opts *Options) ([]byte, bool) {
+	var name string
+	var valid bool
+	switch x := p.(type) {
+	case *String:
+		name, valid = "String", x.Valid
+	case *Int:
+		name, valid = "Int", x.Valid
+	case *Float:
+		name, valid = "Float", x.Valid
+	case *Bool:
+		name, valid = "Bool", x.Valid
+	case *Time:
+		name, valid = "Time", x.Valid
+	case *DateString:
+		name, valid = "DateString", x.Valid
+	default:
+		return buf, false
+	}
+	mode, ok := opts.JSONMarshalModeOf[name]
+	if !ok {
+		mode = opts.JSONMarshalMode
+	}
+	if mode == JSONObject {
+		return buf, false
+	}
+	if !valid {
+		return append(buf, "null"...), true
+	}
+
+	switch x := p.(type) {
+	case *String:
+		buf = appendJSONString(buf, x.String)
+	case *Int:
+		buf = strconv.AppendInt(buf, x.Int64, 10)
+	case *Float:
+		if math.IsInf(x.Float64, 0) || math.IsNaN(x.Float64) {
+			return buf, false
+		}
+		buf, _ = x.AppendText(buf)
+	case *Bool:
+		buf = strconv.AppendBool(buf, x.Bool)
+	case *Time:
+		if y := x.Time.Year(); y < 0 || y > 9999 {
+			return buf, false
+		}
+		buf = append(buf, '"')
+		buf = x.Time.AppendFormat(buf, time.RFC3339Nano)
+		buf = append(buf, '"')
+	case *DateString:
+		buf = appendJSONString(buf, x.String)
+	}
+	return buf, true
+}
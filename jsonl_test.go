@@ -0,0 +1,122 @@
+package null
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+type jsonlRow struct {
+	Name     String     `json:"name"`
+	Age      Int        `json:"age,omitempty"`
+	Score    Float      `json:"score"`
+	Active   Bool       `json:"active"`
+	Created  Time       `json:"created"`
+	Birthday DateString `json:"birthday" null:"omitnull"`
+	Due      DateString `json:"due" null:"format=02/01/2006"`
+	Tags     []string   `json:"tags"`
+	Internal String     `json:"-"`
+	Plain    int
+}
+
+func TestJSONLWriter(t *testing.T) {
+	rows := []any{
+		&jsonlRow{
+			Name:     StringFrom(`<"Somchai">`),
+			Age:      IntFrom(30),
+			Score:    FloatFrom(1.5),
+			Active:   BoolFrom(false),
+			Created:  TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 6, time.FixedZone("", 7*60*60))),
+			Birthday: DateStringFrom("1990-02-03"),
+			Due:      DateStringFrom("2024-03-04"),
+			Tags:     []string{"a"},
+			Plain:    1,
+		},
+		jsonlRow{},
+		(*jsonlRow)(nil),
+		StringFrom("x"),
+	}
+	var buf bytes.Buffer
+	w := NewJSONLWriter(&buf)
+	var want strings.Builder
+	for _, row := range rows {
+		if err := w.Encode(row); err != nil {
+			t.Fatal(err)
+		}
+		data, err := Marshal(row)
+		maybePanic(err)
+		want.Write(data)
+		want.WriteByte('\n')
+	}
+	if buf.String() != want.String() {
+		t.Errorf("JSONLWriter wrote\n%s\nwant\n%s", buf.String(), want.String())
+	}
+}
+
+func TestJSONLWriterObjectMode(t *testing.T) {
+	t.Cleanup(func() { configured.Store(nil) })
+	opts := CurrentOptions()
+	opts.JSONMarshalModeOf = map[string]JSONMode{"Int": JSONObject}
+	Configure(opts)
+
+	var buf bytes.Buffer
+	err := NewJSONLWriter(&buf).Encode(struct {
+		I Int
+		S String
+	}{IntFrom(1), StringFrom("x")})
+	maybePanic(err)
+	if want := `{"I":{"Value":1,"Valid":true},"S":"x"}` + "\n"; buf.String() != want {
+		t.Errorf("JSONLWriter wrote %q, want %q", buf.String(), want)
+	}
+}
+
+func TestJSONLWriterErrors(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewJSONLWriter(&buf)
+	if err := w.Encode(struct{ F Float }{FloatFrom(math.NaN())}); err == nil {
+		t.Error("expected error for NaN")
+	}
+	if err := w.Encode(struct{ T Time }{TimeFrom(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC))}); err == nil {
+		t.Error("expected error for year 10000")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("JSONLWriter wrote %q after errors", buf.String())
+	}
+
+	errWrite := errors.New("write failed")
+	w = NewJSONLWriter(failingWriter{errWrite})
+	if err := w.Encode(&jsonlRow{}); !errors.Is(err, errWrite) {
+		t.Errorf("Encode() error = %v, want %v", err, errWrite)
+	}
+}
+
+type failingWriter struct{ err error }
+
+func (w failingWriter) Write([]byte) (int, error) { return 0, w.err }
+
+func TestJSONLWriterAllocs(t *testing.T) {
+	row := &struct {
+		Name    String     `json:"name"`
+		Age     Int        `json:"age"`
+		Score   Float      `json:"score"`
+		Active  Bool       `json:"active"`
+		Created Time       `json:"created"`
+		Date    DateString `json:"date"`
+	}{
+		StringFrom("Somchai"), IntFrom(30), FloatFrom(1.5), BoolFrom(true),
+		TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)), DateStringFrom("2024-01-02"),
+	}
+	var buf bytes.Buffer
+	w := NewJSONLWriter(&buf)
+	maybePanic(w.Encode(row))
+	allocs := testing.AllocsPerRun(100, func() {
+		buf.Reset()
+		_ = w.Encode(row)
+	})
+	if allocs != 0 {
+		t.Errorf("JSONLWriter.Encode allocated %v times", allocs)
+	}
+}
@@ -0,0 +1,241 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"golang.org/x/text/language"
+)
+
+// LanguageTag is a nullable BCP 47 language tag, such as "en-US" or "th".
+// Input is validated and stored in its canonical form.
+// It will marshal to null if null.
+type LanguageTag struct {
+	sql.NullString
+}
+
+// NewLanguageTag creates a new LanguageTag.
+// The value is stored as-is and is not validated.
+func NewLanguageTag(s string, valid bool) LanguageTag {
+	return LanguageTag{
+		NullString: sql.NullString{
+			String: s,
+			Valid:  valid,
+		},
+	}
+}
+
+// LanguageTagFrom creates a new LanguageTag from s in its canonical form.
+// It will be null if s is not a well-formed language tag.
+func LanguageTagFrom(s string) LanguageTag {
+	canonical, err := parseLanguageTag(s)
+	if err != nil {
+		coerced("LanguageTag", "From", s, err)
+		return NewLanguageTag("", false)
+	}
+	return NewLanguageTag(canonical, true)
+}
+
+// LanguageTagFromPtr creates a new LanguageTag that will be null if s is nil
+// or not a well-formed language tag.
+func LanguageTagFromPtr(s *string) LanguageTag {
+	if s == nil {
+		return NewLanguageTag("", false)
+	}
+	return LanguageTagFrom(*s)
+}
+
+func parseLanguageTag(s string) (string, error) {
+	tag, err := language.Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return tag.String(), nil
+}
+
+// Tag returns the parsed language.Tag and whether this LanguageTag is valid.
+func (l LanguageTag) Tag() (language.Tag, bool) {
+	if !l.Valid {
+		return language.Und, false
+	}
+	tag, err := language.Parse(l.String)
+	if err != nil {
+		return language.Und, false
+	}
+	return tag, true
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (l LanguageTag) ValueOrZero() string {
+	if !l.Valid {
+		return ""
+	}
+	return l.String
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if the column holds a malformed language tag.
+func (l *LanguageTag) Scan(value any) error {
+	if err := l.NullString.Scan(value); err != nil {
+		l.Valid = false
+		return newScanError("null.LanguageTag", value, err)
+	}
+	if !l.Valid {
+		return nil
+	}
+	canonical, err := parseLanguageTag(l.String)
+	if err != nil {
+		l.Valid = false
+		return newScanError("null.LanguageTag", value, err)
+	}
+	l.String = canonical
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input. Blank string input produces a null LanguageTag.
+// It returns an error if the input is not a well-formed language tag.
+func (l *LanguageTag) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "String")
+	data = trimJSON("LanguageTag", data)
+	if bytes.Equal(data, nullBytes) {
+		l.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	return unmarshalError(ErrInvalidJSON, l.set(str))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null LanguageTag if the input is blank or "null".
+// It returns an error if the input is not a well-formed language tag.
+func (l *LanguageTag) UnmarshalText(text []byte) error {
+	text = prepareText("LanguageTag", text)
+	str := string(text)
+	if str == "null" {
+		str = ""
+	}
+	return unmarshalError(ErrInvalidText, l.set(str))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (l *LanguageTag) UnmarshalParam(param string) error {
+	return l.UnmarshalText([]byte(param))
+}
+
+func (l *LanguageTag) set(str string) error {
+	if str == "" {
+		l.String = ""
+		l.Valid = false
+		return nil
+	}
+	canonical, err := parseLanguageTag(str)
+	if err != nil {
+		l.Valid = false
+		return fmt.Errorf("invalid language tag %q: %w", str, err)
+	}
+	l.String = canonical
+	l.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this LanguageTag is null.
+func (l LanguageTag) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("LanguageTag", l.Valid, l.marshalJSON)
+}
+
+func (l LanguageTag) marshalJSON() ([]byte, error) {
+	if !l.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(l.String)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this LanguageTag is null.
+func (l LanguageTag) MarshalText() ([]byte, error) {
+	if !l.Valid {
+		return []byte{}, nil
+	}
+	return []byte(l.String), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (l LanguageTag) AppendText(buf []byte) ([]byte, error) {
+	if !l.Valid {
+		return buf, nil
+	}
+	return append(buf, l.String...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this LanguageTag to v under key, or nothing if null.
+func (l LanguageTag) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, l.Valid, l.MarshalText)
+}
+
+// SetValid changes this LanguageTag's value and also sets it to be non-null.
+// The value is stored as-is and is not validated.
+func (l *LanguageTag) SetValid(v string) {
+	l.String = v
+	l.Valid = true
+}
+
+// Ptr returns a pointer to this LanguageTag's value, or a nil pointer if this LanguageTag is null.
+func (l LanguageTag) Ptr() *string {
+	if !l.Valid {
+		return nil
+	}
+	return &l.String
+}
+
+// IsZero returns true for null language tags, for potential future omitempty support.
+func (l LanguageTag) IsZero() bool {
+	return !l.Valid
+}
+
+// IsNull returns true if this LanguageTag is null.
+func (l LanguageTag) IsNull() bool {
+	return !l.Valid
+}
+
+// SetNull sets this LanguageTag to null and clears its value.
+func (l *LanguageTag) SetNull() {
+	l.String, l.Valid = "", false
+}
+
+// Reset sets this LanguageTag to its zero value, which is null.
+func (l *LanguageTag) Reset() {
+	*l = LanguageTag{}
+}
+
+// Equal returns true if both language tags have the same value or are both null.
+func (l LanguageTag) Equal(other LanguageTag) bool {
+	return l.Valid == other.Valid && (!l.Valid || l.String == other.String)
+}
+
+// Merge returns this LanguageTag if it is valid, otherwise other.
+func (l LanguageTag) Merge(other LanguageTag) LanguageTag {
+	if l.Valid {
+		return l
+	}
+	return other
+}
+
+// When returns this LanguageTag if cond is true, otherwise a null LanguageTag.
+func (l LanguageTag) When(cond bool) LanguageTag {
+	if !cond {
+		l.SetNull()
+	}
+	return l
+}
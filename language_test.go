@@ -0,0 +1,151 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestLanguageTagFrom(t *testing.T) {
+	tag := LanguageTagFrom("en-us")
+	assertLanguageTag(t, tag, "en-US", "LanguageTagFrom()")
+
+	bad := LanguageTagFrom("not a tag")
+	assertNullLanguageTag(t, bad, "LanguageTagFrom() bad input")
+
+	s := "th"
+	ptr := LanguageTagFromPtr(&s)
+	assertLanguageTag(t, ptr, "th", "LanguageTagFromPtr()")
+
+	null := LanguageTagFromPtr(nil)
+	assertNullLanguageTag(t, null, "LanguageTagFromPtr(nil)")
+}
+
+func TestUnmarshalLanguageTag(t *testing.T) {
+	var tag LanguageTag
+	err := json.Unmarshal([]byte(`"zh-hant-tw"`), &tag)
+	maybePanic(err)
+	assertLanguageTag(t, tag, "zh-Hant-TW", "language tag json")
+
+	var null LanguageTag
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullLanguageTag(t, null, "null json")
+
+	var blank LanguageTag
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullLanguageTag(t, blank, "blank json")
+
+	var bad LanguageTag
+	err = json.Unmarshal([]byte(`"en_US!"`), &bad)
+	if err == nil {
+		t.Error("expected error for malformed tag")
+	}
+	assertNullLanguageTag(t, bad, "malformed json")
+
+	var badType LanguageTag
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error for wrong type")
+	}
+	assertNullLanguageTag(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalLanguageTag(t *testing.T) {
+	var tag LanguageTag
+	err := tag.UnmarshalText([]byte("EN-gb"))
+	maybePanic(err)
+	assertLanguageTag(t, tag, "en-GB", "UnmarshalText()")
+
+	var null LanguageTag
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullLanguageTag(t, null, `UnmarshalText() "null"`)
+
+	var bad LanguageTag
+	err = bad.UnmarshalText([]byte("1234567890"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullLanguageTag(t, bad, "UnmarshalText() bad input")
+}
+
+func TestMarshalLanguageTag(t *testing.T) {
+	tag := LanguageTagFrom("en-US")
+	data, err := json.Marshal(tag)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"en-US"`, "non-empty json marshal")
+	data, err = tag.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "en-US", "non-empty text marshal")
+
+	null := LanguageTagFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestLanguageTagScan(t *testing.T) {
+	var tag LanguageTag
+	err := tag.Scan("en-us")
+	maybePanic(err)
+	assertLanguageTag(t, tag, "en-US", "scanned language tag")
+
+	var null LanguageTag
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullLanguageTag(t, null, "scanned null")
+
+	var bad LanguageTag
+	err = bad.Scan("??")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullLanguageTag(t, bad, "scanned bad tag")
+}
+
+func TestLanguageTagTag(t *testing.T) {
+	tag, ok := LanguageTagFrom("th-TH").Tag()
+	if !ok || tag != language.MustParse("th-TH") {
+		t.Errorf("unexpected Tag(): %v %t", tag, ok)
+	}
+
+	tag, ok = LanguageTagFromPtr(nil).Tag()
+	if ok || tag != language.Und {
+		t.Errorf("unexpected Tag() for null: %v %t", tag, ok)
+	}
+}
+
+func TestLanguageTagEqual(t *testing.T) {
+	if !LanguageTagFrom("en-us").Equal(LanguageTagFrom("en-US")) {
+		t.Error("canonical forms should be equal")
+	}
+	if LanguageTagFrom("en").Equal(LanguageTagFromPtr(nil)) {
+		t.Error("valid and null should not be equal")
+	}
+	if !LanguageTagFromPtr(nil).Equal(NewLanguageTag("en", false)) {
+		t.Error("nulls should be equal")
+	}
+}
+
+func assertLanguageTag(t *testing.T, l LanguageTag, want string, from string) {
+	t.Helper()
+	if l.String != want {
+		t.Errorf("bad %s language tag: %s ≠ %s\n", from, l.String, want)
+	}
+	if !l.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullLanguageTag(t *testing.T, l LanguageTag, from string) {
+	t.Helper()
+	if l.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
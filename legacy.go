@@ -0,0 +1,95 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// JSONMode is the JSON shape produced by MarshalJSON.
+type JSONMode int
+
+const (
+	// JSONPlain encodes the value itself, or null.
+	JSONPlain JSONMode = iota
+	// JSONObject encodes an object in the style of sql.NullXXX, such as
+	// {"Value":"2024-01-01","Valid":true} or {"Value":null,"Valid":false}.
+	JSONObject
+)
+
+var (
+	// AcceptLegacyJSON Set whether UnmarshalJSON also accepts the object shape produced by
+	// marshaling a sql.NullXXX directly, such as {"String":"2024-01-01","Valid":true},
+	// or by the JSONObject mode, such as {"Value":"2024-01-01","Valid":true}
+	//
+	// Deprecated: Use Configure with Options.AcceptLegacyJSON. It is ignored once Configure has been called.
+	AcceptLegacyJSON = false
+
+	// JSONMarshalMode Set the JSON shape produced by MarshalJSON for all types, defaults to JSONPlain
+	//
+	// Deprecated: Use Configure with Options.JSONMarshalMode. It is ignored once Configure has been called.
+	JSONMarshalMode = JSONPlain
+	// JSONMarshalModeOf Set the JSON shape for individual types, keyed by type name (e.g. "DateString").
+	// Entries take precedence over JSONMarshalMode.
+	//
+	// Deprecated: Use Configure with Options.JSONMarshalModeOf. It is ignored once Configure has been called.
+	JSONMarshalModeOf = map[string]JSONMode{}
+)
+
+// marshalJSONShape encodes a value of the named type in its configured JSON shape,
+// using plain for the encoding of the value itself.
+func marshalJSONShape(name string, valid bool, plain func() ([]byte, error)) ([]byte, error) {
+	opts := config()
+	mode, ok := opts.JSONMarshalModeOf[name]
+	if !ok {
+		mode = opts.JSONMarshalMode
+	}
+	if mode != JSONObject {
+		return plain()
+	}
+	if !valid {
+		return []byte(`{"Value":null,"Valid":false}`), nil
+	}
+	data, err := plain()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, len(data)+len(`{"Value":,"Valid":true}`))
+	out = append(out, `{"Value":`...)
+	out = append(out, data...)
+	out = append(out, `,"Valid":true}`...)
+	return out, nil
+}
+
+// legacyJSON unwraps data if legacy input is accepted and data is an object holding
+// a "Valid" key and a "Value" key or a key named field, matched case-insensitively like encoding/json.
+// It returns the inner value, or JSON null if Valid is false.
+// Any other input is returned unchanged and left to the regular decoding path.
+func legacyJSON(data []byte, field string) []byte {
+	if !config().AcceptLegacyJSON || len(data) == 0 || data[0] != '{' {
+		return data
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return data
+	}
+	var valid, value json.RawMessage
+	for k, v := range obj {
+		switch {
+		case strings.EqualFold(k, "Valid"):
+			valid = v
+		case strings.EqualFold(k, field), strings.EqualFold(k, "Value"):
+			value = v
+		}
+	}
+	var ok bool
+	if valid == nil || json.Unmarshal(valid, &ok) != nil {
+		return data
+	}
+	if !ok {
+		return nullBytes
+	}
+	if value == nil {
+		return data
+	}
+	return value
+}
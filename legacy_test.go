@@ -0,0 +1,133 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLegacyJSON(t *testing.T) {
+	defer configured.Store(nil)
+
+	var ds DateString
+	if err := json.Unmarshal([]byte(`{"String":"2024-01-01","Valid":true}`), &ds); err == nil {
+		t.Error("legacy JSON should be rejected by default")
+	}
+
+	Configure(Options{AcceptLegacyJSON: true})
+
+	err := json.Unmarshal([]byte(`{"String":"2024-01-01","Valid":true}`), &ds)
+	maybePanic(err)
+	if !ds.Valid || ds.String != "2024-01-01" {
+		t.Errorf("bad legacy DateString: %+v", ds)
+	}
+
+	var str String
+	err = json.Unmarshal(nullStringJSON, &str)
+	maybePanic(err)
+	assertStr(t, str, "legacy string json")
+
+	err = json.Unmarshal([]byte(`{"String":"test","Valid":false}`), &str)
+	maybePanic(err)
+	assertNullStr(t, str, "legacy null string json")
+
+	var i Int
+	err = json.Unmarshal(nullIntJSON, &i)
+	maybePanic(err)
+	assertInt(t, i, "legacy int json")
+
+	var b Bool
+	err = json.Unmarshal(nullBoolJSON, &b)
+	maybePanic(err)
+	assertBool(t, b, "legacy bool json")
+
+	var f Float
+	err = json.Unmarshal(nullFloatJSON, &f)
+	maybePanic(err)
+	assertFloat(t, f, "legacy float json")
+
+	var ti Time
+	err = json.Unmarshal(timeObject, &ti)
+	maybePanic(err)
+	assertTime(t, ti, "legacy time json")
+
+	err = json.Unmarshal(nullObject, &ti)
+	maybePanic(err)
+	assertNullTime(t, ti, "legacy null time json")
+
+	var lower String
+	err = json.Unmarshal([]byte(`{"string":"test","valid":true}`), &lower)
+	maybePanic(err)
+	assertStr(t, lower, "legacy lower case json")
+
+	var bad Time
+	err = json.Unmarshal(badObject, &bad)
+	if err == nil {
+		t.Error("objects without Valid should still be rejected")
+	}
+
+	var missing String
+	err = json.Unmarshal([]byte(`{"Valid":true}`), &missing)
+	if err == nil {
+		t.Error("valid legacy objects without a value should be rejected")
+	}
+}
+
+func TestJSONMarshalMode(t *testing.T) {
+	defer configured.Store(nil)
+
+	Configure(Options{JSONMarshalMode: JSONObject})
+	data, err := json.Marshal(StringFrom("test"))
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"Value":"test","Valid":true}`, "object string json")
+
+	data, err = json.Marshal(NewInt(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"Value":null,"Valid":false}`, "object null int json")
+
+	data, err = json.Marshal(DateStringFrom("2024-01-02"))
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"Value":"2024-01-02","Valid":true}`, "object date json")
+
+	data, err = json.Marshal(PercentFrom(50))
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"Value":50,"Valid":true}`, "object percent json")
+
+	Configure(Options{JSONMarshalModeOf: map[string]JSONMode{"DateString": JSONObject}})
+	data, err = json.Marshal(struct {
+		D DateString
+		S String
+	}{DateStringFrom("2024-01-02"), StringFrom("x")})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"D":{"Value":"2024-01-02","Valid":true},"S":"x"}`, "per-type object json")
+
+	Configure(Options{JSONMarshalModeOf: map[string]JSONMode{"Float": JSONObject}})
+	_, err = json.Marshal(FloatFrom(math.Inf(1)))
+	if err == nil {
+		t.Error("expected error for unsupported float in object mode")
+	}
+}
+
+func TestJSONObjectRoundTrip(t *testing.T) {
+	defer configured.Store(nil)
+	Configure(Options{JSONMarshalMode: JSONObject, AcceptLegacyJSON: true})
+
+	in := IntervalFrom(1, 2, time.Hour)
+	data, err := json.Marshal(in)
+	maybePanic(err)
+	var out Interval
+	err = json.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.Equal(in) {
+		t.Errorf("round trip of %s = %+v, want %+v", data, out, in)
+	}
+
+	data, err = json.Marshal(NewTime(time.Time{}, false))
+	maybePanic(err)
+	var null Time
+	null.Valid = true
+	err = json.Unmarshal(data, &null)
+	maybePanic(err)
+	assertNullTime(t, null, "object null time round trip")
+}
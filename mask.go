@@ -0,0 +1,216 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// maskChar replaces the hidden characters of masked values.
+const maskChar = "*"
+
+// Masker hides sensitive parts of a value for display, such as all but the last digits of a card number.
+// Set the policy used by Secret with Options.Mask.
+type Masker func(s string) string
+
+// MaskKeepLast returns a Masker that replaces all but the last n characters of a value with "*".
+// Values of n characters or fewer are masked entirely, so that short values are not revealed.
+func MaskKeepLast(n int) Masker {
+	return func(s string) string {
+		return maskKeepLast(s, n)
+	}
+}
+
+func maskKeepLast(s string, n int) string {
+	runes := []rune(s)
+	if n < 0 || len(runes) <= n {
+		n = 0
+	}
+	hidden := len(runes) - n
+	return strings.Repeat(maskChar, hidden) + string(runes[hidden:])
+}
+
+// defaultMask is the default of Options.Mask.
+var defaultMask = MaskKeepLast(4)
+
+// Masked returns this String with all but the last keepLast characters replaced by "*",
+// or a blank string if null.
+func (s String) Masked(keepLast int) string {
+	if !s.Valid {
+		return ""
+	}
+	return maskKeepLast(s.String, keepLast)
+}
+
+// Secret is a nullable string that is masked when printed or logged, such as a card number or a
+// national ID. String, GoString and LogValue hide it with Options.Mask; it is stored in SQL and
+// marshaled to JSON as-is. It will marshal to null if null.
+type Secret struct {
+	Text  string
+	Valid bool
+}
+
+// NewSecret creates a new Secret.
+func NewSecret(s string, valid bool) Secret {
+	return Secret{
+		Text:  s,
+		Valid: valid,
+	}
+}
+
+// SecretFrom creates a new Secret that will always be valid.
+func SecretFrom(s string) Secret {
+	return NewSecret(s, true)
+}
+
+// SecretFromPtr creates a new Secret that will be null if s is nil.
+func SecretFromPtr(s *string) Secret {
+	if s == nil {
+		return NewSecret("", false)
+	}
+	return NewSecret(*s, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (s Secret) ValueOrZero() string {
+	if !s.Valid {
+		return ""
+	}
+	return s.Text
+}
+
+// Masked returns this Secret with all but the last keepLast characters replaced by "*",
+// or a blank string if null.
+func (s Secret) Masked(keepLast int) string {
+	if !s.Valid {
+		return ""
+	}
+	return maskKeepLast(s.Text, keepLast)
+}
+
+// String implements fmt.Stringer. It returns this Secret masked with Options.Mask,
+// or a blank string if null.
+func (s Secret) String() string {
+	if !s.Valid {
+		return ""
+	}
+	return config().Mask(s.Text)
+}
+
+// GoString implements fmt.GoStringer, so that %#v is masked too.
+func (s Secret) GoString() string {
+	return fmt.Sprintf("null.Secret(%q)", s.String())
+}
+
+// LogValue implements slog.LogValuer. It logs the same text as String.
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns a *ScanError if value cannot be converted.
+func (s *Secret) Scan(value any) error {
+	var str sql.NullString
+	if err := str.Scan(value); err != nil {
+		s.Text, s.Valid = "", false
+		return newScanError("null.Secret", value, err)
+	}
+	s.Text, s.Valid = str.String, str.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (s Secret) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.Text, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (s *Secret) UnmarshalJSON(data []byte) error {
+	data = trimJSON("Secret", data)
+	if bytes.Equal(data, nullBytes) {
+		s.Text, s.Valid = "", false
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	s.Text, s.Valid = str, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Secret is null.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Secret", s.Valid, s.marshalJSON)
+}
+
+func (s Secret) marshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(s.Text)
+}
+
+// SetValid changes this Secret's value and also sets it to be non-null.
+func (s *Secret) SetValid(v string) {
+	s.Text = v
+	s.Valid = true
+}
+
+// Ptr returns a pointer to this Secret's value, or a nil pointer if this Secret is null.
+func (s Secret) Ptr() *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.Text
+}
+
+// IsZero returns true for null Secrets, for potential future omitempty support.
+func (s Secret) IsZero() bool {
+	return !s.Valid
+}
+
+// IsNull returns true if this Secret is null.
+func (s Secret) IsNull() bool {
+	return !s.Valid
+}
+
+// SetNull sets this Secret to null and clears its value.
+func (s *Secret) SetNull() {
+	s.Text, s.Valid = "", false
+}
+
+// Reset sets this Secret to its zero value, which is null.
+func (s *Secret) Reset() {
+	*s = Secret{}
+}
+
+// Equal returns true if both Secrets have the same value or are both null.
+func (s Secret) Equal(other Secret) bool {
+	return s.Valid == other.Valid && (!s.Valid || s.Text == other.Text)
+}
+
+// Merge returns this Secret if it is valid, otherwise other.
+func (s Secret) Merge(other Secret) Secret {
+	if s.Valid {
+		return s
+	}
+	return other
+}
+
+// When returns this Secret if cond is true, otherwise a null Secret.
+func (s Secret) When(cond bool) Secret {
+	if !cond {
+		s.SetNull()
+	}
+	return s
+}
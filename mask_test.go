@@ -0,0 +1,75 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestMasked(t *testing.T) {
+	tests := []struct {
+		in       string
+		keepLast int
+		want     string
+	}{
+		{"4111111111111111", 4, "************1111"},
+		{"1234", 4, "****"},
+		{"123", 4, "***"},
+		{"สวัสดีครับ", 2, "********ับ"},
+		{"abc", 0, "***"},
+	}
+	for _, tc := range tests {
+		if got := StringFrom(tc.in).Masked(tc.keepLast); got != tc.want {
+			t.Errorf("Masked(%q, %d) = %q, want %q", tc.in, tc.keepLast, got, tc.want)
+		}
+	}
+	if got := NewString("", false).Masked(4); got != "" {
+		t.Errorf("null: %q", got)
+	}
+}
+
+func TestSecret(t *testing.T) {
+	s := SecretFrom("1103700012345")
+	if got := s.String(); got != "*********2345" {
+		t.Errorf("String = %q", got)
+	}
+	for _, format := range []string{"%v", "%s", "%+v", "%#v"} {
+		if out := fmt.Sprintf(format, s); strings.Contains(out, "11037") {
+			t.Errorf("%s reveals the secret: %s", format, out)
+		}
+	}
+	if out := fmt.Sprintf("%+v", struct{ ID Secret }{s}); strings.Contains(out, "11037") {
+		t.Errorf("nested %%+v reveals the secret: %s", out)
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("signup", "national_id", s)
+	if !strings.Contains(buf.String(), "national_id=*********2345") {
+		t.Errorf("LogValue: %s", buf.String())
+	}
+
+	data, err := json.Marshal(s)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"1103700012345"`, "Secret")
+	var got Secret
+	err = json.Unmarshal(data, &got)
+	maybePanic(err)
+	if !got.Equal(s) {
+		t.Errorf("round trip: %#v", got)
+	}
+
+	err = got.Scan(nil)
+	maybePanic(err)
+	if got.Valid || got.String() != "" {
+		t.Errorf("Scan(nil): %#v", got)
+	}
+
+	defer configured.Store(nil)
+	Configure(Options{Mask: func(string) string { return "[hidden]" }})
+	if got := s.String(); got != "[hidden]" {
+		t.Errorf("custom Mask: %q", got)
+	}
+}
@@ -0,0 +1,162 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Merge fills the null fields of the struct base points to with the fields of overlay,
+// a struct of the same type or a pointer to one, such as for layered configuration.
+// Fields are nullable if they have an IsNull method, like the types in this package.
+// Valid fields of base and fields that are not nullable are left unchanged,
+// except that nested structs are merged field by field.
+func Merge(base, overlay any) error {
+	bv := reflect.ValueOf(base)
+	if bv.Kind() != reflect.Pointer || bv.IsNil() || bv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("null: Merge base must be a pointer to a struct, not %T", base)
+	}
+	bv = bv.Elem()
+	ov := structValue(overlay)
+	if !ov.IsValid() || ov.Type() != bv.Type() {
+		return fmt.Errorf("null: Merge overlay must be a %s, not %T", bv.Type(), overlay)
+	}
+	mergeFields(bv, ov)
+	return nil
+}
+
+func mergeFields(base, overlay reflect.Value) {
+	t := base.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() && !t.Field(i).Anonymous {
+			continue
+		}
+		bf, of := base.Field(i), overlay.Field(i)
+		if !bf.CanInterface() {
+			// fields of an unexported embedded struct are still promoted
+			if bf.Kind() == reflect.Struct {
+				mergeFields(bf, of)
+			}
+			continue
+		}
+		if n, ok := bf.Interface().(interface{ IsNull() bool }); ok {
+			// a nil pointer to a nullable type is null, and calling IsNull on it would panic
+			if (bf.Kind() == reflect.Pointer && bf.IsNil() || n.IsNull()) && bf.CanSet() {
+				bf.Set(of)
+			}
+			continue
+		}
+		if bf.Kind() == reflect.Struct {
+			mergeFields(bf, of)
+		}
+	}
+}
+
+// ApplyMergePatch applies a JSON merge patch (RFC 7386) to the struct dst points to.
+// Keys are matched to fields like Unmarshal, honoring the json and null struct tags.
+// A null value sets the field to null with SetNull, or to its zero value if it is not nullable,
+// and keys absent from the patch leave their fields unchanged.
+// Objects are merged into nested structs and Objects field by field, and other values are
+// unmarshaled into their fields. Fields that cannot be decoded are reported as ValidationErrors
+// named by their path, such as "address.zip", after the other fields have been applied.
+func ApplyMergePatch(dst any, patch []byte) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("null: ApplyMergePatch destination must be a pointer to a struct, not %T", dst)
+	}
+	return applyMergePatch(rv.Elem(), patch)
+}
+
+func applyMergePatch(rv reflect.Value, patch []byte) error {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &obj); err != nil {
+		return fmt.Errorf("%w: merge patch must be an object: %w", ErrInvalidJSON, err)
+	}
+	fields, err := cachedTagFields(rv.Type())
+	if err != nil {
+		return err
+	}
+	var errs ValidationErrors
+	for _, f := range fields {
+		msg, ok := lookupKey(obj, f.name)
+		if !ok {
+			continue
+		}
+		err := f.applyMergePatch(rv.FieldByIndex(f.index), msg)
+		var nested ValidationErrors
+		switch {
+		case err == nil:
+		case errors.As(err, &nested):
+			for _, e := range nested {
+				e.Field = f.name + "." + e.Field
+			}
+			errs = append(errs, nested...)
+		default:
+			errs = append(errs, newValidationError(f.name, msg, err))
+		}
+	}
+	return errs.errOrNil()
+}
+
+// patchTarget is implemented by Object, whose value is merged into rather than replaced.
+type patchTarget interface {
+	patchTarget() any
+}
+
+func (f tagField) applyMergePatch(fv reflect.Value, msg json.RawMessage) error {
+	msg = bytes.TrimSpace(msg)
+	ptr := fv.Addr().Interface()
+	if bytes.Equal(msg, nullBytes) {
+		if n, ok := ptr.(Nuller); ok {
+			n.SetNull()
+		} else {
+			fv.SetZero()
+		}
+		return nil
+	}
+	if len(msg) > 0 && msg[0] == '{' {
+		if pt, ok := ptr.(patchTarget); ok {
+			target := reflect.ValueOf(pt.patchTarget()).Elem()
+			if target.Kind() == reflect.Struct {
+				return applyMergePatch(target, msg)
+			}
+		} else if fv.Kind() == reflect.Struct && !fv.Type().Implements(isZeroerType) &&
+			!reflect.PointerTo(fv.Type()).Implements(unmarshalerType) {
+			return applyMergePatch(fv, msg)
+		}
+	}
+	return f.unmarshal(msg, fv)
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// lookupKey returns the value of the key of obj matching name, case-insensitively
+// if there is no exact match, like encoding/json.
+func lookupKey(obj map[string]json.RawMessage, name string) (json.RawMessage, bool) {
+	if msg, ok := obj[name]; ok {
+		return msg, true
+	}
+	for k, msg := range obj {
+		if strings.EqualFold(k, name) {
+			return msg, true
+		}
+	}
+	return nil, false
+}
+
+func findTagField(fields []tagField, key string) (tagField, bool) {
+	for _, f := range fields {
+		if f.name == key {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, key) {
+			return f, true
+		}
+	}
+	return tagField{}, false
+}
@@ -0,0 +1,148 @@
+package null
+
+import (
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	type limits struct {
+		Requests Int
+		Burst    Int
+	}
+	type base struct {
+		Region String
+	}
+	type settings struct {
+		base
+		Name    String
+		Debug   Bool
+		Ratio   Percent
+		Limits  limits
+		Version int
+	}
+	cfg := settings{
+		Name:    StringFrom("app"),
+		Limits:  limits{Requests: IntFrom(10)},
+		Version: 1,
+	}
+	defaults := settings{
+		base:    base{Region: StringFrom("ap-southeast-1")},
+		Name:    StringFrom("default"),
+		Debug:   BoolFrom(false),
+		Ratio:   PercentFrom(50),
+		Limits:  limits{Requests: IntFrom(100), Burst: IntFrom(20)},
+		Version: 2,
+	}
+	maybePanic(Merge(&cfg, defaults))
+
+	want := settings{
+		base:    base{Region: StringFrom("ap-southeast-1")},
+		Name:    StringFrom("app"),
+		Debug:   BoolFrom(false),
+		Ratio:   PercentFrom(50),
+		Limits:  limits{Requests: IntFrom(10), Burst: IntFrom(20)},
+		Version: 1,
+	}
+	if cfg != want {
+		t.Errorf("Merge() = %+v, want %+v", cfg, want)
+	}
+
+	if err := Merge(cfg, defaults); err == nil {
+		t.Error("Merge into a non-pointer should fail")
+	}
+	if err := Merge(&cfg, limits{}); err == nil {
+		t.Error("Merge of different types should fail")
+	}
+}
+
+func TestMergePointer(t *testing.T) {
+	type settings struct {
+		Name  *String
+		Debug *Bool
+		Port  *int
+	}
+	name, debug, port := StringFrom("default"), BoolFrom(true), 8080
+	cfg := settings{Debug: &Bool{}}
+	defaults := settings{Name: &name, Debug: &debug, Port: &port}
+	maybePanic(Merge(&cfg, defaults))
+
+	if cfg.Name != defaults.Name {
+		t.Errorf("Merge() Name = %v, want %v", cfg.Name, defaults.Name)
+	}
+	if cfg.Debug != defaults.Debug {
+		t.Errorf("Merge() Debug = %v, want %v", cfg.Debug, defaults.Debug)
+	}
+	if cfg.Port != nil {
+		t.Errorf("Merge() Port = %v, want nil", cfg.Port)
+	}
+}
+
+func TestMergeValue(t *testing.T) {
+	assertStr(t, NewString("", false).Merge(StringFrom("test")), "null String.Merge")
+	assertStr(t, StringFrom("test").Merge(StringFrom("other")), "valid String.Merge")
+	assertNullInt(t, NewInt(0, false).Merge(NewInt(0, false)), "null Int.Merge null")
+	if got := NewPercent(0, false).Merge(PercentFrom(5)); got != PercentFrom(5) {
+		t.Errorf("Percent.Merge = %v, want 5", got)
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	type profile struct {
+		Bio     String `json:"bio"`
+		Website String `json:"website"`
+	}
+	type user struct {
+		Name    String              `json:"name"`
+		Email   String              `json:"email"`
+		Age     Int                 `json:"age"`
+		Born    DateString          `json:"born" null:"format=02/01/2006"`
+		Profile profile             `json:"profile"`
+		Address Object[testAddress] `json:"address"`
+		Tags    []string            `json:"tags"`
+	}
+	u := user{
+		Name:    StringFrom("Attapon"),
+		Email:   StringFrom("a@example.com"),
+		Age:     IntFrom(30),
+		Profile: profile{Bio: StringFrom("hi"), Website: StringFrom("example.com")},
+		Tags:    []string{"a"},
+	}
+	patch := `{
+		"email": null,
+		"age": 31,
+		"born": "02/01/2000",
+		"profile": {"website": null},
+		"address": {"city": "Bangkok"},
+		"tags": null,
+		"unknown": 1
+	}`
+	maybePanic(ApplyMergePatch(&u, []byte(patch)))
+
+	assertNullStr(t, u.Email, "patched email")
+	if u.Name != StringFrom("Attapon") || u.Age != IntFrom(31) || u.Born.String != "2000-01-02" {
+		t.Errorf("patched user = %+v", u)
+	}
+	if u.Profile.Bio != StringFrom("hi") || u.Profile.Website.Valid {
+		t.Errorf("patched profile = %+v", u.Profile)
+	}
+	if !u.Address.Valid || u.Address.V.City != StringFrom("Bangkok") || u.Address.V.Street.Valid {
+		t.Errorf("patched address = %+v", u.Address)
+	}
+	if u.Tags != nil {
+		t.Errorf("patched tags = %v, want nil", u.Tags)
+	}
+
+	maybePanic(ApplyMergePatch(&u, []byte(`{"address": {"street": "Sukhumvit"}}`)))
+	if u.Address.V.City != StringFrom("Bangkok") || u.Address.V.Street != StringFrom("Sukhumvit") {
+		t.Errorf("address should be merged into, got %+v", u.Address)
+	}
+	maybePanic(ApplyMergePatch(&u, []byte(`{"address": null}`)))
+	assertNullObject(t, u.Address, "patched null address")
+
+	if err := ApplyMergePatch(&u, []byte(`[1]`)); err == nil {
+		t.Error("non-object patch should fail")
+	}
+	if err := ApplyMergePatch(&u, []byte(`{"age": "x"}`)); err == nil {
+		t.Error("bad field value should fail")
+	}
+}
@@ -0,0 +1,82 @@
+package null
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// ErrorKind classifies a ValidationError, such as a malformed date or an out-of-range number.
+type ErrorKind string
+
+// Kinds of ValidationError.
+const (
+	// KindInvalidDate is input that is not a valid date or time.
+	KindInvalidDate ErrorKind = "invalid_date"
+	// KindOutOfRange is a number outside the bounds of a BoundedInt or the range of a Percent.
+	KindOutOfRange ErrorKind = "out_of_range"
+	// KindInvalidValue is JSON input of the wrong shape, such as a string for an Int.
+	KindInvalidValue ErrorKind = "invalid_value"
+	// KindOther is any other problem. Its message is the English Reason.
+	KindOther ErrorKind = "other"
+)
+
+// Messages is the catalog used by the default Translator, keyed by ErrorKind.
+// It holds English and Thai messages, with English as the fallback.
+// Add languages or replace messages with SetString; the arguments of each message are
+// the field (%[1]s), the raw input (%[2]s), the English reason (%[3]s),
+// and for KindOutOfRange the bounds (%[4]v and %[5]v), which are int64 for a BoundedInt
+// and float64 for a Percent.
+var Messages = catalog.NewBuilder(catalog.Fallback(language.English))
+
+func init() {
+	set := func(tag language.Tag, kind ErrorKind, msg string) {
+		if err := Messages.SetString(tag, string(kind), msg); err != nil {
+			panic(err)
+		}
+	}
+	set(language.English, KindInvalidDate, "%[1]s: not a valid date (got %[2]s)")
+	set(language.English, KindOutOfRange, "%[1]s: must be between %[4]v and %[5]v (got %[2]s)")
+	set(language.English, KindInvalidValue, "%[1]s: not a valid value (got %[2]s)")
+	set(language.English, KindOther, "%[1]s: %[3]s (got %[2]s)")
+
+	set(language.Thai, KindInvalidDate, "%[1]s: ไม่ใช่วันที่ที่ถูกต้อง (ได้รับ %[2]s)")
+	set(language.Thai, KindOutOfRange, "%[1]s: ต้องอยู่ระหว่าง %[4]v ถึง %[5]v (ได้รับ %[2]s)")
+	set(language.Thai, KindInvalidValue, "%[1]s: ค่าไม่ถูกต้อง (ได้รับ %[2]s)")
+	set(language.Thai, KindOther, "%[1]s: %[3]s (ได้รับ %[2]s)")
+}
+
+// Translator renders a ValidationError as a message for end users who read the language tag.
+type Translator func(tag language.Tag, e *ValidationError) string
+
+var defaultTranslator = CatalogTranslator(Messages)
+
+// CatalogTranslator returns a Translator that prints the message for each ErrorKind from c,
+// with the arguments described in Messages. Languages missing from c use its first language.
+func CatalogTranslator(c catalog.Catalog) Translator {
+	return func(tag language.Tag, e *ValidationError) string {
+		tag, _, _ = language.NewMatcher(c.Languages()).Match(tag)
+		min, max, _ := rangeBounds(e.Err)
+		kind := e.Kind
+		if kind == "" {
+			kind = KindOther
+		}
+		p := message.NewPrinter(tag, message.Catalog(c))
+		return p.Sprintf(string(kind), e.Field, e.Raw, e.Reason, min, max)
+	}
+}
+
+// Localize returns the message for e in the language tag, using Options.Translator.
+func (e *ValidationError) Localize(tag language.Tag) string {
+	return config().Translator(tag, e)
+}
+
+// Localize returns the message for each invalid field in the language tag, using Options.Translator.
+func (e ValidationErrors) Localize(tag language.Tag) []string {
+	translate := config().Translator
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = translate(tag, err)
+	}
+	return msgs
+}
@@ -0,0 +1,253 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Month is a nullable time.Month. It supports SQL and JSON serialization.
+// It unmarshals numbers (1 is January) as well as full or three-letter English names,
+// and marshals according to FormatMonth.
+// It will marshal to null if null.
+type Month struct {
+	Month time.Month
+	Valid bool
+}
+
+// NewMonth creates a new Month.
+func NewMonth(m time.Month, valid bool) Month {
+	return Month{
+		Month: m,
+		Valid: valid,
+	}
+}
+
+// MonthFrom creates a new Month that will always be valid.
+func MonthFrom(m time.Month) Month {
+	return NewMonth(m, true)
+}
+
+// MonthFromPtr creates a new Month that will be null if m is nil.
+func MonthFromPtr(m *time.Month) Month {
+	if m == nil {
+		return NewMonth(0, false)
+	}
+	return NewMonth(*m, true)
+}
+
+// ParseMonth parses a number (1-12) or an English month name, such as "Jan" or "january".
+// It will be null if s is not a month.
+func ParseMonth(s string) Month {
+	n, ok := parseCalendar(s, monthName, int(time.January), int(time.December))
+	if !ok && s != "" {
+		coerced("Month", "Parse", s, nil)
+	}
+	return NewMonth(time.Month(n), ok)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (m Month) ValueOrZero() time.Month {
+	if !m.Valid {
+		return 0
+	}
+	return m.Month
+}
+
+// Format returns the month in the given style, or a blank string if null.
+func (m Month) Format(style CalendarStyle) string {
+	if !m.Valid {
+		return ""
+	}
+	return formatCalendar(m.Month.String(), int(m.Month), style)
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports integer and text columns holding a number or a month name.
+func (m *Month) Scan(value any) error {
+	var err error
+	switch x := value.(type) {
+	case nil:
+		m.Month, m.Valid = 0, false
+		return nil
+	case int64:
+		err = m.setNumber(x)
+	case string:
+		err = m.setString(x)
+	case []byte:
+		err = m.setString(string(x))
+	default:
+		m.Valid = false
+		return newScanError("null.Month", value, nil)
+	}
+	if err != nil {
+		return newScanError("null.Month", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It always encodes the month as an integer, 1 being January.
+func (m Month) Value() (driver.Value, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+	return int64(m.Month), nil
+}
+
+func (m *Month) setNumber(n int64) error {
+	if n < int64(time.January) || n > int64(time.December) {
+		m.Valid = false
+		return fmt.Errorf("month out of range: %d", n)
+	}
+	m.Month, m.Valid = time.Month(n), true
+	return nil
+}
+
+func (m *Month) setString(s string) error {
+	n, ok := parseCalendar(s, monthName, int(time.January), int(time.December))
+	if !ok {
+		m.Valid = false
+		return fmt.Errorf("invalid month: %q", s)
+	}
+	m.Month, m.Valid = time.Month(n), true
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+func (m *Month) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "Month")
+	data = trimJSON("Month", data)
+	if bytes.Equal(data, nullBytes) {
+		m.Valid = false
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+		}
+		return unmarshalError(ErrInvalidJSON, m.setString(str))
+	}
+
+	var n int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	return unmarshalError(ErrInvalidJSON, m.setNumber(n))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Month if the input is blank or "null".
+func (m *Month) UnmarshalText(text []byte) error {
+	text = prepareText("Month", text)
+	str := string(text)
+	if str == "" || str == "null" {
+		m.Valid = false
+		return nil
+	}
+	return unmarshalError(ErrInvalidText, m.setString(str))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (m *Month) UnmarshalParam(param string) error {
+	return m.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Month is null, otherwise a number or string according to FormatMonth.
+func (m Month) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Month", m.Valid, m.marshalJSON)
+}
+
+func (m Month) marshalJSON() ([]byte, error) {
+	if !m.Valid {
+		return []byte("null"), nil
+	}
+	style := config().MonthStyle
+	if style == StyleNumber {
+		return []byte(strconv.Itoa(int(m.Month))), nil
+	}
+	return marshalJSONString(m.Format(style))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Month is null.
+func (m Month) MarshalText() ([]byte, error) {
+	return []byte(m.Format(config().MonthStyle)), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (m Month) AppendText(buf []byte) ([]byte, error) {
+	return append(buf, m.Format(config().MonthStyle)...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Month to v under key, or nothing if null.
+func (m Month) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, m.Valid, m.MarshalText)
+}
+
+// SetValid changes this Month's value and also sets it to be non-null.
+func (m *Month) SetValid(v time.Month) {
+	m.Month = v
+	m.Valid = true
+}
+
+// Ptr returns a pointer to this Month's value, or a nil pointer if this Month is null.
+func (m Month) Ptr() *time.Month {
+	if !m.Valid {
+		return nil
+	}
+	return &m.Month
+}
+
+// IsZero returns true for null Months, for potential future omitempty support.
+// A non-null January will not be considered zero.
+func (m Month) IsZero() bool {
+	return !m.Valid
+}
+
+// IsNull returns true if this Month is null.
+func (m Month) IsNull() bool {
+	return !m.Valid
+}
+
+// SetNull sets this Month to null and clears its value.
+func (m *Month) SetNull() {
+	m.Month, m.Valid = 0, false
+}
+
+// Reset sets this Month to its zero value, which is null.
+func (m *Month) Reset() {
+	*m = Month{}
+}
+
+// Equal returns true if both months have the same value or are both null.
+func (m Month) Equal(other Month) bool {
+	return m.Valid == other.Valid && (!m.Valid || m.Month == other.Month)
+}
+
+// Merge returns this Month if it is valid, otherwise other.
+func (m Month) Merge(other Month) Month {
+	if m.Valid {
+		return m
+	}
+	return other
+}
+
+// When returns this Month if cond is true, otherwise a null Month.
+func (m Month) When(cond bool) Month {
+	if !cond {
+		m.SetNull()
+	}
+	return m
+}
@@ -0,0 +1,144 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseMonth(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Month
+		ok   bool
+	}{
+		{"January", time.January, true},
+		{"jan", time.January, true},
+		{"DEC", time.December, true},
+		{"12", time.December, true},
+		{"1", time.January, true},
+		{"0", 0, false},
+		{"13", 0, false},
+		{"Ja", 0, false},
+	}
+	for _, tc := range tests {
+		m := ParseMonth(tc.in)
+		if m.Valid != tc.ok || (tc.ok && m.Month != tc.want) {
+			t.Errorf("ParseMonth(%q) = %v, %t; want %v, %t", tc.in, m.Month, m.Valid, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUnmarshalMonth(t *testing.T) {
+	var num Month
+	err := json.Unmarshal([]byte(`2`), &num)
+	maybePanic(err)
+	assertMonth(t, num, time.February, "number json")
+
+	var name Month
+	err = json.Unmarshal([]byte(`"march"`), &name)
+	maybePanic(err)
+	assertMonth(t, name, time.March, "name json")
+
+	var numString Month
+	err = json.Unmarshal([]byte(`"11"`), &numString)
+	maybePanic(err)
+	assertMonth(t, numString, time.November, "number string json")
+
+	var null Month
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullMonth(t, null, "null json")
+
+	var outOfRange Month
+	err = json.Unmarshal([]byte(`0`), &outOfRange)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullMonth(t, outOfRange, "out of range json")
+
+	var text Month
+	err = text.UnmarshalText([]byte("Aug"))
+	maybePanic(err)
+	assertMonth(t, text, time.August, "UnmarshalText()")
+
+	var bad Month
+	err = bad.UnmarshalText([]byte("Smarch"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullMonth(t, bad, "UnmarshalText() bad name")
+}
+
+func TestMarshalMonth(t *testing.T) {
+	defer configured.Store(nil)
+
+	m := MonthFrom(time.September)
+	data, err := json.Marshal(m)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"September"`, "long json marshal")
+
+	Configure(Options{MonthStyle: StyleShort})
+	data, err = json.Marshal(m)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"Sep"`, "short json marshal")
+
+	Configure(Options{MonthStyle: StyleNumber})
+	data, err = json.Marshal(m)
+	maybePanic(err)
+	assertJSONEquals(t, data, `9`, "number json marshal")
+	data, err = m.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "9", "number text marshal")
+
+	null := MonthFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMonthScanValue(t *testing.T) {
+	var num Month
+	err := num.Scan(int64(12))
+	maybePanic(err)
+	assertMonth(t, num, time.December, "scanned int")
+	if v, err := num.Value(); v != int64(12) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var text Month
+	err = text.Scan("October")
+	maybePanic(err)
+	assertMonth(t, text, time.October, "scanned text")
+
+	var null Month
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullMonth(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var outOfRange Month
+	err = outOfRange.Scan(int64(13))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func assertMonth(t *testing.T, m Month, want time.Month, from string) {
+	t.Helper()
+	if m.Month != want {
+		t.Errorf("bad %s month: %v ≠ %v\n", from, m.Month, want)
+	}
+	if !m.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullMonth(t *testing.T, m Month, from string) {
+	t.Helper()
+	if m.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,284 @@
+//go:build nullmsgp
+
+package null
+
+import (
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// The MessagePack methods of the types encode null as nil and other values as their base type,
+// such as a String as a str and a Time as a timestamp extension.
+
+func appendMsg[T any](b []byte, valid bool, v T, appendV func([]byte, T) []byte) []byte {
+	if !valid {
+		return msgp.AppendNil(b)
+	}
+	return appendV(b, v)
+}
+
+func readMsg[T any](b []byte, read func([]byte) (T, []byte, error), set func(v T, valid bool)) ([]byte, error) {
+	if msgp.IsNil(b) {
+		var zero T
+		set(zero, false)
+		return msgp.ReadNilBytes(b)
+	}
+	v, rest, err := read(b)
+	if err != nil {
+		return b, err
+	}
+	set(v, true)
+	return rest, nil
+}
+
+func encodeMsg[T any](w *msgp.Writer, valid bool, v T, write func(T) error) error {
+	if !valid {
+		return w.WriteNil()
+	}
+	return write(v)
+}
+
+func decodeMsg[T any](r *msgp.Reader, read func() (T, error), set func(v T, valid bool)) error {
+	if r.IsNil() {
+		var zero T
+		set(zero, false)
+		return r.ReadNil()
+	}
+	v, err := read()
+	if err != nil {
+		return err
+	}
+	set(v, true)
+	return nil
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (s String) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, s.Valid, s.String, msgp.AppendString), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (s *String) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadStringBytes, s.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (s String) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, s.Valid, s.String, en.WriteString)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (s *String) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadString, s.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (s String) Msgsize() int {
+	if !s.Valid {
+		return msgp.NilSize
+	}
+	return msgp.StringPrefixSize + len(s.String)
+}
+
+func (s *String) setMsg(v string, valid bool) {
+	s.String, s.Valid = v, valid
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (i Int) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, i.Valid, i.Int64, msgp.AppendInt64), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (i *Int) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadInt64Bytes, i.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (i Int) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, i.Valid, i.Int64, en.WriteInt64)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (i *Int) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadInt64, i.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (i Int) Msgsize() int {
+	if !i.Valid {
+		return msgp.NilSize
+	}
+	return msgp.Int64Size
+}
+
+func (i *Int) setMsg(v int64, valid bool) {
+	i.Int64, i.Valid = v, valid
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (f Float) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, f.Valid, f.Float64, msgp.AppendFloat64), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (f *Float) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadFloat64Bytes, f.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (f Float) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, f.Valid, f.Float64, en.WriteFloat64)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (f *Float) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadFloat64, f.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (f Float) Msgsize() int {
+	if !f.Valid {
+		return msgp.NilSize
+	}
+	return msgp.Float64Size
+}
+
+func (f *Float) setMsg(v float64, valid bool) {
+	f.Float64, f.Valid = v, valid
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (b Bool) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, b.Valid, b.Bool, msgp.AppendBool), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (b *Bool) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadBoolBytes, b.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (b Bool) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, b.Valid, b.Bool, en.WriteBool)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (b *Bool) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadBool, b.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (b Bool) Msgsize() int {
+	if !b.Valid {
+		return msgp.NilSize
+	}
+	return msgp.BoolSize
+}
+
+func (b *Bool) setMsg(v bool, valid bool) {
+	b.Bool, b.Valid = v, valid
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (t Time) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, t.Valid, t.Time, msgp.AppendTime), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (t *Time) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadTimeBytes, t.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (t Time) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, t.Valid, t.Time, en.WriteTime)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (t *Time) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadTime, t.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (t Time) Msgsize() int {
+	if !t.Valid {
+		return msgp.NilSize
+	}
+	return msgp.TimeSize
+}
+
+func (t *Time) setMsg(v time.Time, valid bool) {
+	t.Time, t.Valid = v, valid
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (s DateString) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, s.Valid, s.String, msgp.AppendString), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (s *DateString) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadStringBytes, s.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (s DateString) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, s.Valid, s.String, en.WriteString)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (s *DateString) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadString, s.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (s DateString) Msgsize() int {
+	if !s.Valid {
+		return msgp.NilSize
+	}
+	return msgp.StringPrefixSize + len(s.String)
+}
+
+// setMsg sets the decoded value and normalizes it like UnmarshalText.
+func (s *DateString) setMsg(v string, valid bool) {
+	s.String, s.Valid = v, valid
+	if valid {
+		s.Valid = s.normalize("UnmarshalMsg")
+	}
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler. It range checks the value like UnmarshalJSON.
+func (b *BoundedInt[B]) UnmarshalMsg(bts []byte) ([]byte, error) {
+	rest, err := b.Int.UnmarshalMsg(bts)
+	if err != nil {
+		return rest, err
+	}
+	return rest, b.check()
+}
+
+// DecodeMsg implements msgp.Decodable. It range checks the value like UnmarshalJSON.
+func (b *BoundedInt[B]) DecodeMsg(dc *msgp.Reader) error {
+	if err := b.Int.DecodeMsg(dc); err != nil {
+		return err
+	}
+	return b.check()
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler. It range checks the value like UnmarshalJSON.
+func (p *Percent) UnmarshalMsg(bts []byte) ([]byte, error) {
+	rest, err := p.Float.UnmarshalMsg(bts)
+	if err != nil {
+		return rest, err
+	}
+	return rest, p.check("UnmarshalMsg")
+}
+
+// DecodeMsg implements msgp.Decodable. It range checks the value like UnmarshalJSON.
+func (p *Percent) DecodeMsg(dc *msgp.Reader) error {
+	if err := p.Float.DecodeMsg(dc); err != nil {
+		return err
+	}
+	return p.check("DecodeMsg")
+}
@@ -0,0 +1,82 @@
+//go:build nullmsgp
+
+package null
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+type msgpValue interface {
+	msgp.Marshaler
+	msgp.Unmarshaler
+	msgp.Encodable
+	msgp.Decodable
+	msgp.Sizer
+}
+
+func TestMsgpRoundTrip(t *testing.T) {
+	values := []msgpValue{
+		&String{}, ptr(StringFrom("")),
+		&Int{}, ptr(IntFrom(-7)),
+		&Float{}, ptr(FloatFrom(1.5)),
+		&Bool{}, ptr(BoolFrom(false)),
+		&Time{}, ptr(TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC))),
+		&DateString{}, ptr(DateStringFrom("2024-01-02")),
+		&Percent{}, ptr(PercentFrom(12.5)),
+		&BoundedInt[testRating]{}, ptr(BoundedIntFrom[testRating](3)),
+	}
+	for _, v := range values {
+		data, err := v.MarshalMsg(nil)
+		maybePanic(err)
+		if len(data) > v.Msgsize() {
+			t.Errorf("%T: Msgsize %d < encoded size %d", v, v.Msgsize(), len(data))
+		}
+
+		var buf bytes.Buffer
+		w := msgp.NewWriter(&buf)
+		maybePanic(v.EncodeMsg(w))
+		maybePanic(w.Flush())
+		if !bytes.Equal(buf.Bytes(), data) {
+			t.Errorf("%T: EncodeMsg = %x, MarshalMsg = %x", v, buf.Bytes(), data)
+		}
+
+		rest, err := v.UnmarshalMsg(append(data, 0xc0))
+		maybePanic(err)
+		if !bytes.Equal(rest, []byte{0xc0}) {
+			t.Errorf("%T: UnmarshalMsg left %x", v, rest)
+		}
+		maybePanic(v.DecodeMsg(msgp.NewReader(&buf)))
+		again, err := v.MarshalMsg(nil)
+		maybePanic(err)
+		if !bytes.Equal(again, data) {
+			t.Errorf("%T: round trip = %x, want %x", v, again, data)
+		}
+	}
+}
+
+func TestMsgpDecode(t *testing.T) {
+	s := StringFrom("x")
+	if _, err := s.UnmarshalMsg(msgp.AppendNil(nil)); err != nil || s.Valid {
+		t.Errorf("UnmarshalMsg(nil) = %v %+v", err, s)
+	}
+	var d DateString
+	if _, err := d.UnmarshalMsg(msgp.AppendString(nil, "2024-01-02T10:00:00Z")); err != nil || d.String != "2024-01-02" {
+		t.Errorf("DateString.UnmarshalMsg = %v %+v", err, d)
+	}
+	var i Int
+	if _, err := i.UnmarshalMsg(msgp.AppendString(nil, "1")); err == nil {
+		t.Error("expected error for str into Int")
+	}
+	var b BoundedInt[testRating]
+	if _, err := b.UnmarshalMsg(msgp.AppendInt64(nil, 9)); err == nil || b.Valid {
+		t.Errorf("BoundedInt.UnmarshalMsg(9) = %v %+v", err, b)
+	}
+	var p Percent
+	if _, err := p.UnmarshalMsg(msgp.AppendFloat64(nil, 150)); err == nil || p.Valid {
+		t.Errorf("Percent.UnmarshalMsg(150) = %v %+v", err, p)
+	}
+}
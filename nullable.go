@@ -0,0 +1,94 @@
+package null
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Nullable is implemented by the types in this package that hold a single value of type T,
+// such as String (Nullable[string]) and Time (Nullable[time.Time]).
+// It can be used to write generic helpers over any of them.
+//
+// Interval, whose value has several parts, and Regexp, whose value is already a pointer, do not implement it.
+type Nullable[T any] interface {
+	// ValueOrZero returns the value if valid, otherwise the zero value of T.
+	ValueOrZero() T
+	// IsZero returns true if null.
+	IsZero() bool
+	// Ptr returns a pointer to the value, or nil if null.
+	Ptr() *T
+}
+
+var (
+	_ Nullable[string]          = String{}
+	_ Nullable[int64]           = Int{}
+	_ Nullable[float64]         = Float{}
+	_ Nullable[bool]            = Bool{}
+	_ Nullable[time.Time]       = Time{}
+	_ Nullable[time.Time]       = DeletedAt{}
+	_ Nullable[string]          = DateString{}
+	_ Nullable[string]          = LanguageTag{}
+	_ Nullable[string]          = CountryCode{}
+	_ Nullable[string]          = Color{}
+	_ Nullable[rune]            = Char{}
+	_ Nullable[json.Number]     = Number{}
+	_ Nullable[string]          = TimeZone{}
+	_ Nullable[[]string]        = CSVList{}
+	_ Nullable[string]          = Phone{}
+	_ Nullable[string]          = Cron{}
+	_ Nullable[string]          = Enum{}
+	_ Nullable[time.Weekday]    = Weekday{}
+	_ Nullable[time.Month]      = Month{}
+	_ Nullable[int64]           = UnixMilli{}
+	_ Nullable[int64]           = UnixMicro{}
+	_ Nullable[float64]         = Percent{}
+	_ Nullable[uint64]          = Flags{}
+	_ Nullable[struct{}]        = Object[struct{}]{}
+	_ Nullable[json.RawMessage] = JSON{}
+	_ Nullable[[]byte]          = GzipBytes{}
+	_ Nullable[string]          = Encrypted{}
+	_ Nullable[string]          = Secret{}
+)
+
+// Nuller is implemented by pointers to every type in this package,
+// so nullable fields of different types can be handled uniformly.
+type Nuller interface {
+	// IsNull returns true if null.
+	IsNull() bool
+	// SetNull sets the value to null and clears it.
+	SetNull()
+}
+
+var (
+	_ Nuller = (*String)(nil)
+	_ Nuller = (*Int)(nil)
+	_ Nuller = (*Float)(nil)
+	_ Nuller = (*Bool)(nil)
+	_ Nuller = (*Time)(nil)
+	_ Nuller = (*DeletedAt)(nil)
+	_ Nuller = (*DateString)(nil)
+	_ Nuller = (*LanguageTag)(nil)
+	_ Nuller = (*CountryCode)(nil)
+	_ Nuller = (*Phone)(nil)
+	_ Nuller = (*Regexp)(nil)
+	_ Nuller = (*Object[struct{}])(nil)
+	_ Nuller = (*Cron)(nil)
+	_ Nuller = (*Interval)(nil)
+	_ Nuller = (*Enum)(nil)
+	_ Nuller = (*Flags)(nil)
+	_ Nuller = (*Weekday)(nil)
+	_ Nuller = (*Month)(nil)
+	_ Nuller = (*UnixMilli)(nil)
+	_ Nuller = (*UnixMicro)(nil)
+	_ Nuller = (*Percent)(nil)
+	_ Nuller = (*Color)(nil)
+	_ Nuller = (*Char)(nil)
+	_ Nuller = (*Number)(nil)
+	_ Nuller = (*TimeZone)(nil)
+	_ Nuller = (*CSVList)(nil)
+	_ Nuller = (*JSON)(nil)
+	_ Nuller = (*GzipBytes)(nil)
+	_ Nuller = (*Encrypted)(nil)
+	_ Nuller = (*Password)(nil)
+	_ Nuller = (*Secret)(nil)
+)
@@ -0,0 +1,85 @@
+package null
+
+import (
+	"testing"
+)
+
+// orDefault is an example of a generic helper over Nullable.
+func orDefault[T any](v Nullable[T], def T) T {
+	if v.IsZero() {
+		return def
+	}
+	return v.ValueOrZero()
+}
+
+func TestNullable(t *testing.T) {
+	if got := orDefault[string](StringFrom("a"), "b"); got != "a" {
+		t.Errorf("bad valid value: %q", got)
+	}
+	if got := orDefault[int64](Int{}, 5); got != 5 {
+		t.Errorf("bad default: %d", got)
+	}
+
+	var b Nullable[int64] = BoundedIntFrom[testRating](3)
+	if p := b.Ptr(); p == nil || *p != 3 {
+		t.Errorf("bad BoundedInt ptr: %v", p)
+	}
+}
+
+func TestNuller(t *testing.T) {
+	var (
+		s  = StringFrom("a")
+		ds = DateStringFrom("2024-01-01")
+		e  = NewEnum("a", "b").With("a")
+		b  = BoundedIntFrom[testRating](3)
+		iv = IntervalFrom(1, 2, 3)
+	)
+	fields := []Nuller{&s, &ds, &e, &b, &iv}
+	for _, f := range fields {
+		if f.IsNull() {
+			t.Errorf("%T should not be null", f)
+		}
+		f.SetNull()
+		if !f.IsNull() {
+			t.Errorf("%T should be null after SetNull", f)
+		}
+	}
+	if ds.String != "" {
+		t.Errorf("SetNull should clear DateString: %q", ds.String)
+	}
+	if b.Int64 != 0 || iv.Months != 0 {
+		t.Error("SetNull should clear values")
+	}
+	if err := e.Set("b"); err != nil {
+		t.Errorf("SetNull should keep allowed Enum values: %v", err)
+	}
+}
+
+func TestReset(t *testing.T) {
+	ds := DateStringFrom("2024-01-01")
+	ds.Reset()
+	if ds != (DateString{}) {
+		t.Errorf("Reset should zero DateString: %+v", ds)
+	}
+
+	p := PhoneFrom("+66 81 234 5678")
+	p.Reset()
+	if p != (Phone{}) {
+		t.Errorf("Reset should zero Phone: %+v", p)
+	}
+
+	e := NewEnum("a").With("a")
+	e.Reset()
+	if e.Valid || e.Allowed() != nil {
+		t.Errorf("Reset should forget allowed Enum values: %v", e.Allowed())
+	}
+	if err := e.Set("anything"); err != nil {
+		t.Errorf("reset Enum should accept any value: %v", err)
+	}
+
+	u := UnixMilliFrom(5)
+	u.Reset()
+	if u.Valid || u.Int64 != 0 {
+		t.Errorf("Reset should zero UnixMilli: %+v", u)
+	}
+}
@@ -0,0 +1,134 @@
+// Package nullarrow appends nullable values to Apache Arrow array builders and reads them back,
+// for bridging structs of null types with Arrow Flight or ADBC.
+//
+// Null values are Arrow nulls. A DateString is a Date32 and a Time a Timestamp in the unit
+// of its array, read back in the time zone of the array.
+package nullarrow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/attapon-th/null"
+)
+
+// AppendString appends s to b, or a null if s is null.
+func AppendString(b *array.StringBuilder, s null.String) {
+	if !s.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(s.String)
+}
+
+// AppendInt appends i to b, or a null if i is null.
+func AppendInt(b *array.Int64Builder, i null.Int) {
+	if !i.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(i.Int64)
+}
+
+// AppendFloat appends f to b, or a null if f is null.
+func AppendFloat(b *array.Float64Builder, f null.Float) {
+	if !f.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(f.Float64)
+}
+
+// AppendBool appends v to b, or a null if v is null.
+func AppendBool(b *array.BooleanBuilder, v null.Bool) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Bool)
+}
+
+// AppendTime appends t to b in the unit of its type, or a null if t is null.
+func AppendTime(b *array.TimestampBuilder, t null.Time) error {
+	if !t.Valid {
+		b.AppendNull()
+		return nil
+	}
+	ts, err := arrow.TimestampFromTime(t.Time, b.Type().(*arrow.TimestampType).Unit)
+	if err != nil {
+		return fmt.Errorf("nullarrow: %w", err)
+	}
+	b.Append(ts)
+	return nil
+}
+
+// AppendDate appends the date held by d to b, or a null if d is null.
+// It returns an error wrapping null.ErrInvalidDate if the value of d is not a date.
+func AppendDate(b *array.Date32Builder, d null.DateString) error {
+	if !d.Valid {
+		b.AppendNull()
+		return nil
+	}
+	t, err := time.Parse(null.CurrentOptions().DateFormat, d.String)
+	if err != nil {
+		return fmt.Errorf("nullarrow: %w: %w", null.ErrInvalidDate, err)
+	}
+	b.Append(arrow.Date32FromTime(t))
+	return nil
+}
+
+// StringAt returns the value at index i of a, or a null String if it is null.
+func StringAt(a *array.String, i int) null.String {
+	if a.IsNull(i) {
+		return null.String{}
+	}
+	return null.StringFrom(a.Value(i))
+}
+
+// IntAt returns the value at index i of a, or a null Int if it is null.
+func IntAt(a *array.Int64, i int) null.Int {
+	if a.IsNull(i) {
+		return null.Int{}
+	}
+	return null.IntFrom(a.Value(i))
+}
+
+// FloatAt returns the value at index i of a, or a null Float if it is null.
+func FloatAt(a *array.Float64, i int) null.Float {
+	if a.IsNull(i) {
+		return null.Float{}
+	}
+	return null.FloatFrom(a.Value(i))
+}
+
+// BoolAt returns the value at index i of a, or a null Bool if it is null.
+func BoolAt(a *array.Boolean, i int) null.Bool {
+	if a.IsNull(i) {
+		return null.Bool{}
+	}
+	return null.BoolFrom(a.Value(i))
+}
+
+// TimeAt returns the value at index i of a in the time zone of its type, or a null Time if it is null.
+// It returns an error if the time zone of a cannot be loaded.
+func TimeAt(a *array.Timestamp, i int) (null.Time, error) {
+	if a.IsNull(i) {
+		return null.Time{}, nil
+	}
+	toTime, err := a.DataType().(*arrow.TimestampType).GetToTimeFunc()
+	if err != nil {
+		return null.Time{}, fmt.Errorf("nullarrow: %w", err)
+	}
+	return null.TimeFrom(toTime(a.Value(i))), nil
+}
+
+// DateAt returns the date at index i of a in the configured date format,
+// or a null DateString if it is null.
+func DateAt(a *array.Date32, i int) null.DateString {
+	if a.IsNull(i) {
+		return null.NewDateString("", false)
+	}
+	return null.NewDateString(a.Value(i).ToTime().Format(null.CurrentOptions().DateFormat), true)
+}
@@ -0,0 +1,108 @@
+package nullarrow
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/attapon-th/null"
+)
+
+func TestRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "age", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "score", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "active", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+		{Name: "created", Type: &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "Asia/Bangkok"}, Nullable: true},
+		{Name: "birthday", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+	}, nil)
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	created := time.Date(2023, 5, 1, 12, 30, 0, 123456000, time.UTC)
+	AppendString(b.Field(0).(*array.StringBuilder), null.StringFrom("Somchai"))
+	AppendInt(b.Field(1).(*array.Int64Builder), null.IntFrom(0))
+	AppendFloat(b.Field(2).(*array.Float64Builder), null.FloatFrom(1.5))
+	AppendBool(b.Field(3).(*array.BooleanBuilder), null.BoolFrom(false))
+	if err := AppendTime(b.Field(4).(*array.TimestampBuilder), null.TimeFrom(created)); err != nil {
+		t.Fatal(err)
+	}
+	if err := AppendDate(b.Field(5).(*array.Date32Builder), null.DateStringFrom("1969-12-31")); err != nil {
+		t.Fatal(err)
+	}
+
+	AppendString(b.Field(0).(*array.StringBuilder), null.String{})
+	AppendInt(b.Field(1).(*array.Int64Builder), null.Int{})
+	AppendFloat(b.Field(2).(*array.Float64Builder), null.Float{})
+	AppendBool(b.Field(3).(*array.BooleanBuilder), null.Bool{})
+	if err := AppendTime(b.Field(4).(*array.TimestampBuilder), null.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := AppendDate(b.Field(5).(*array.Date32Builder), null.NewDateString("1990-01-01", false)); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	if got := StringAt(rec.Column(0).(*array.String), 0); !got.Equal(null.StringFrom("Somchai")) {
+		t.Errorf("StringAt(0) = %v", got)
+	}
+	if got := IntAt(rec.Column(1).(*array.Int64), 0); !got.Equal(null.IntFrom(0)) {
+		t.Errorf("IntAt(0) = %v", got)
+	}
+	if got := FloatAt(rec.Column(2).(*array.Float64), 0); !got.Equal(null.FloatFrom(1.5)) {
+		t.Errorf("FloatAt(0) = %v", got)
+	}
+	if got := BoolAt(rec.Column(3).(*array.Boolean), 0); !got.Equal(null.BoolFrom(false)) {
+		t.Errorf("BoolAt(0) = %v", got)
+	}
+	got, err := TimeAt(rec.Column(4).(*array.Timestamp), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Time.Equal(created) || got.Time.Location().String() != "Asia/Bangkok" {
+		t.Errorf("TimeAt(0) = %v, want %v in Asia/Bangkok", got, created)
+	}
+	if got := DateAt(rec.Column(5).(*array.Date32), 0); !got.Equal(null.DateStringFrom("1969-12-31")) {
+		t.Errorf("DateAt(0) = %v", got)
+	}
+
+	if got := StringAt(rec.Column(0).(*array.String), 1); got.Valid {
+		t.Errorf("StringAt(1) = %v, want null", got)
+	}
+	if got := IntAt(rec.Column(1).(*array.Int64), 1); got.Valid {
+		t.Errorf("IntAt(1) = %v, want null", got)
+	}
+	if got := FloatAt(rec.Column(2).(*array.Float64), 1); got.Valid {
+		t.Errorf("FloatAt(1) = %v, want null", got)
+	}
+	if got := BoolAt(rec.Column(3).(*array.Boolean), 1); got.Valid {
+		t.Errorf("BoolAt(1) = %v, want null", got)
+	}
+	if got, err := TimeAt(rec.Column(4).(*array.Timestamp), 1); err != nil || got.Valid {
+		t.Errorf("TimeAt(1) = %v, %v, want null", got, err)
+	}
+	if got := DateAt(rec.Column(5).(*array.Date32), 1); got.Valid {
+		t.Errorf("DateAt(1) = %v, want null", got)
+	}
+}
+
+func TestAppendInvalidDate(t *testing.T) {
+	b := array.NewDate32Builder(memory.DefaultAllocator)
+	defer b.Release()
+	err := AppendDate(b, null.NewDateString("not a date", true))
+	if !errors.Is(err, null.ErrInvalidDate) {
+		t.Errorf("AppendDate() error = %v, want ErrInvalidDate", err)
+	}
+	if b.Len() != 0 {
+		t.Errorf("AppendDate() appended %d values, want 0", b.Len())
+	}
+}
@@ -0,0 +1,22 @@
+module github.com/attapon-th/null/nullavro
+
+go 1.24
+
+require (
+	github.com/attapon-th/null v0.0.0-00010101000000-000000000000
+	github.com/hamba/avro/v2 v2.26.0
+)
+
+require (
+	github.com/josharian/intern v1.0.0 // indirect
+	github.com/json-iterator/go v1.1.12 // indirect
+	github.com/mailru/easyjson v0.7.7 // indirect
+	github.com/mitchellh/mapstructure v1.5.0 // indirect
+	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
+	github.com/modern-go/reflect2 v1.0.2 // indirect
+	github.com/philhofer/fwd v1.1.3-0.20240916144458-20a13a1f6b7c // indirect
+	github.com/tinylib/msgp v1.2.5 // indirect
+	golang.org/x/text v0.19.0 // indirect
+)
+
+replace github.com/attapon-th/null => ..
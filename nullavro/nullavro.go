@@ -0,0 +1,221 @@
+// Package nullavro encodes and decodes structs of nullable fields as Avro with github.com/hamba/avro,
+// so the structs used for JSON can also be produced to and consumed from Kafka.
+//
+// Nullable fields are unions of null and their base type, such as StringSchema for a null.String.
+// A DateString is a date and a Time a timestamp with microsecond precision.
+// Fields are named by the avro struct tag, or the field name if there is none,
+// and fields tagged `avro:"-"` are skipped.
+package nullavro
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/hamba/avro/v2"
+)
+
+// Schema fragments for the fields of a record that hold null types.
+const (
+	// StringSchema is the schema of a null.String.
+	StringSchema = `["null","string"]`
+	// IntSchema is the schema of a null.Int.
+	IntSchema = `["null","long"]`
+	// FloatSchema is the schema of a null.Float.
+	FloatSchema = `["null","double"]`
+	// BoolSchema is the schema of a null.Bool.
+	BoolSchema = `["null","boolean"]`
+	// TimeSchema is the schema of a null.Time.
+	TimeSchema = `["null",{"type":"long","logicalType":"timestamp-micros"}]`
+	// DateSchema is the schema of a null.DateString.
+	DateSchema = `["null",{"type":"int","logicalType":"date"}]`
+)
+
+// Marshal returns the Avro encoding of v, a struct or a pointer to one, with schema.
+func Marshal(schema avro.Schema, v any) ([]byte, error) {
+	datum, err := toAvro(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	return avro.Marshal(schema, datum)
+}
+
+// Unmarshal decodes the Avro-encoded data with schema into the struct v points to.
+func Unmarshal(schema avro.Schema, data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("nullavro: Unmarshal needs a non-nil pointer, not %T", v)
+	}
+	var datum any
+	if err := avro.Unmarshal(schema, data, &datum); err != nil {
+		return err
+	}
+	return fromAvro(datum, rv.Elem())
+}
+
+// toAvro converts rv to the generic data that avro.Marshal accepts for unions.
+func toAvro(rv reflect.Value) (any, error) {
+	if !rv.IsValid() {
+		return nil, nil
+	}
+	switch x := rv.Interface().(type) {
+	case null.String:
+		return orNil(x.Valid, x.String), nil
+	case null.Int:
+		return orNil(x.Valid, x.Int64), nil
+	case null.Float:
+		return orNil(x.Valid, x.Float64), nil
+	case null.Bool:
+		return orNil(x.Valid, x.Bool), nil
+	case null.Time:
+		return orNil(x.Valid, x.Time), nil
+	case null.DateString:
+		if !x.Valid {
+			return nil, nil
+		}
+		t, err := time.Parse(null.CurrentOptions().DateFormat, x.String)
+		if err != nil {
+			return nil, fmt.Errorf("nullavro: %w: %w", null.ErrInvalidDate, err)
+		}
+		return t, nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return toAvro(rv.Elem())
+	case reflect.Struct:
+		record := make(map[string]any)
+		err := eachField(rv, func(name string, fv reflect.Value) error {
+			datum, err := toAvro(fv)
+			record[name] = datum
+			return err
+		})
+		return record, err
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Bytes(), nil
+		}
+		items := make([]any, rv.Len())
+		for i := range items {
+			var err error
+			if items[i], err = toAvro(rv.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	}
+	return rv.Interface(), nil
+}
+
+func orNil[T any](valid bool, v T) any {
+	if !valid {
+		return nil
+	}
+	return v
+}
+
+// fromAvro stores the generic data decoded by avro.Unmarshal in rv.
+func fromAvro(datum any, rv reflect.Value) error {
+	switch x := rv.Addr().Interface().(type) {
+	case *null.String:
+		return setNullable(datum, x, x.SetValid)
+	case *null.Int:
+		return setNullable(datum, x, x.SetValid)
+	case *null.Float:
+		return setNullable(datum, x, x.SetValid)
+	case *null.Bool:
+		return setNullable(datum, x, x.SetValid)
+	case *null.Time:
+		return setNullable(datum, x, x.SetValid)
+	case *null.DateString:
+		return setNullable(datum, x, func(t time.Time) {
+			x.SetValid(t.Format(null.CurrentOptions().DateFormat))
+		})
+	}
+
+	if datum == nil {
+		rv.SetZero()
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(rv.Type().Elem())
+		if err := fromAvro(datum, elem.Elem()); err != nil {
+			return err
+		}
+		rv.Set(elem)
+		return nil
+	case reflect.Struct:
+		record, ok := datum.(map[string]any)
+		if !ok {
+			return fmt.Errorf("nullavro: cannot decode %T into %s", datum, rv.Type())
+		}
+		return eachField(rv, func(name string, fv reflect.Value) error {
+			if datum, ok := record[name]; ok {
+				return fromAvro(datum, fv)
+			}
+			return nil
+		})
+	case reflect.Slice:
+		items, ok := datum.([]any)
+		if !ok {
+			break
+		}
+		s := reflect.MakeSlice(rv.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := fromAvro(item, s.Index(i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(s)
+		return nil
+	}
+	dv := reflect.ValueOf(datum)
+	if !dv.Type().ConvertibleTo(rv.Type()) {
+		return fmt.Errorf("nullavro: cannot decode %T into %s", datum, rv.Type())
+	}
+	rv.Set(dv.Convert(rv.Type()))
+	return nil
+}
+
+// setNullable sets n to null if datum is nil, or calls set with datum converted to T.
+func setNullable[T any](datum any, n interface{ SetNull() }, set func(T)) error {
+	if datum == nil {
+		n.SetNull()
+		return nil
+	}
+	var v T
+	dv, vt := reflect.ValueOf(datum), reflect.TypeOf(v)
+	if !dv.Type().ConvertibleTo(vt) {
+		return fmt.Errorf("nullavro: cannot decode %T into %T", datum, n)
+	}
+	set(dv.Convert(vt).Interface().(T))
+	return nil
+}
+
+// eachField calls fn with the Avro name and value of each exported field of the struct rv.
+func eachField(rv reflect.Value, fn func(name string, fv reflect.Value) error) error {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("avro"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if err := fn(name, rv.Field(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package nullavro
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/hamba/avro/v2"
+)
+
+type address struct {
+	City null.String `avro:"city"`
+	Zip  null.String `avro:"zip"`
+}
+
+type user struct {
+	Name     null.String     `avro:"name"`
+	Age      null.Int        `avro:"age"`
+	Score    null.Float      `avro:"score"`
+	Active   null.Bool       `avro:"active"`
+	Created  null.Time       `avro:"created"`
+	Birthday null.DateString `avro:"birthday"`
+	Address  address         `avro:"address"`
+	Tags     []null.String   `avro:"tags"`
+	Count    int32           `avro:"count"`
+	Internal null.String     `avro:"-"`
+}
+
+var userSchema = avro.MustParse(`{
+	"type": "record",
+	"name": "user",
+	"fields": [
+		{"name": "name", "type": ` + StringSchema + `},
+		{"name": "age", "type": ` + IntSchema + `},
+		{"name": "score", "type": ` + FloatSchema + `},
+		{"name": "active", "type": ` + BoolSchema + `},
+		{"name": "created", "type": ` + TimeSchema + `},
+		{"name": "birthday", "type": ` + DateSchema + `},
+		{"name": "address", "type": {
+			"type": "record",
+			"name": "address",
+			"fields": [
+				{"name": "city", "type": ` + StringSchema + `},
+				{"name": "zip", "type": ` + StringSchema + `}
+			]
+		}},
+		{"name": "tags", "type": {"type": "array", "items": ` + StringSchema + `}},
+		{"name": "count", "type": "int"}
+	]
+}`)
+
+func TestRoundTrip(t *testing.T) {
+	created := time.Date(2023, 5, 1, 12, 30, 0, 123456000, time.UTC)
+	tests := []struct {
+		name string
+		in   user
+	}{
+		{"valid", user{
+			Name:     null.StringFrom("Somchai"),
+			Age:      null.IntFrom(0),
+			Score:    null.FloatFrom(1.5),
+			Active:   null.BoolFrom(false),
+			Created:  null.TimeFrom(created),
+			Birthday: null.DateStringFrom("1990-02-03"),
+			Address:  address{City: null.StringFrom("Bangkok")},
+			Tags:     []null.String{null.StringFrom("a"), {}},
+			Count:    3,
+		}},
+		{"null", user{Tags: []null.String{}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Marshal(userSchema, &tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got user
+			if err := Unmarshal(userSchema, data, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Created.Valid {
+				got.Created.Time = got.Created.Time.UTC()
+			}
+			if !reflect.DeepEqual(got, tt.in) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.in)
+			}
+		})
+	}
+}
+
+func TestMarshalNullDate(t *testing.T) {
+	data, err := Marshal(userSchema, user{Birthday: null.NewDateString("1990-02-03", false)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got user
+	if err := Unmarshal(userSchema, data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Birthday.Valid {
+		t.Errorf("Birthday = %+v, want null", got.Birthday)
+	}
+}
+
+func TestMarshalInvalidDate(t *testing.T) {
+	_, err := Marshal(userSchema, user{Birthday: null.NewDateString("not a date", true)})
+	if !errors.Is(err, null.ErrInvalidDate) {
+		t.Errorf("Marshal() error = %v, want ErrInvalidDate", err)
+	}
+}
+
+func TestUnmarshalNotPointer(t *testing.T) {
+	if err := Unmarshal(userSchema, nil, user{}); err == nil {
+		t.Error("Unmarshal(non-pointer) = nil error")
+	}
+}
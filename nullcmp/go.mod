@@ -0,0 +1,18 @@
+module github.com/attapon-th/null/nullcmp
+
+go 1.24
+
+require (
+	github.com/attapon-th/null v0.0.0-00010101000000-000000000000
+	github.com/google/go-cmp v0.6.0
+)
+
+require (
+	github.com/josharian/intern v1.0.0 // indirect
+	github.com/mailru/easyjson v0.7.7 // indirect
+	github.com/philhofer/fwd v1.1.3-0.20240916144458-20a13a1f6b7c // indirect
+	github.com/tinylib/msgp v1.2.5 // indirect
+	golang.org/x/text v0.19.0 // indirect
+)
+
+replace github.com/attapon-th/null => ..
@@ -0,0 +1,134 @@
+// Package nullcmp provides go-cmp options for the types in the null and zero packages.
+// With them, cmp.Diff reports nullable values by their content, such as
+// "2024-01-01" or null, instead of by their struct fields.
+//
+//	if diff := cmp.Diff(want, got, nullcmp.Options()); diff != "" {
+//		t.Errorf("mismatch (-want +got):\n%s", diff)
+//	}
+package nullcmp
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/zero"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Value is the form that the transformers in Options convert nullable values to.
+// It prints as "null" or as the inner value.
+type Value struct {
+	Null  bool
+	Inner any
+}
+
+// Equal reports whether both values are null or have equal inner values according to cmp.Equal,
+// so times are compared with time.Time.Equal.
+func (v Value) Equal(other Value) bool {
+	return v.Null == other.Null && (v.Null || cmp.Equal(v.Inner, other.Inner))
+}
+
+// String returns "null", or the inner value formatted with its String method or %v.
+func (v Value) String() string {
+	if v.Null {
+		return "null"
+	}
+	switch x := v.Inner.(type) {
+	case string:
+		return fmt.Sprintf("%q", x)
+	case time.Time:
+		return x.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(v.Inner)
+}
+
+// redacted is the inner value of a Password or Secret: the SHA-256 digest of its text,
+// printed by its first bytes, so that diffs tell values apart without leaking them into test logs.
+type redacted [sha256.Size]byte
+
+func redact(s string) redacted {
+	return sha256.Sum256([]byte(s))
+}
+
+func (r redacted) String() string {
+	return fmt.Sprintf("[REDACTED %x]", r[:4])
+}
+
+func of[T any](valid bool, inner T) Value {
+	if !valid {
+		return Value{Null: true}
+	}
+	return Value{Inner: inner}
+}
+
+// Options returns transformers for every type in the null and zero packages.
+// Types that embed one of them, such as null.BoundedInt, are compared through the embedded field.
+// Phone is compared by its normalized value and Raw is ignored.
+// Password and Secret are compared by a digest of their text, which diffs print redacted.
+func Options() cmp.Options {
+	return cmp.Options{
+		transformer(func(v null.String) Value { return of(v.Valid, v.String) }),
+		transformer(func(v null.Int) Value { return of(v.Valid, v.Int64) }),
+		transformer(func(v null.Float) Value { return of(v.Valid, v.Float64) }),
+		transformer(func(v null.Bool) Value { return of(v.Valid, v.Bool) }),
+		transformer(func(v null.Time) Value { return of(v.Valid, v.Time) }),
+		transformer(func(v null.DateString) Value { return of(v.Valid, v.String) }),
+		transformer(func(v null.LanguageTag) Value { return of(v.Valid, v.String) }),
+		transformer(func(v null.CountryCode) Value { return of(v.Valid, v.String) }),
+		transformer(func(v null.Phone) Value { return of(v.Valid, v.String) }),
+		transformer(func(v null.Cron) Value { return of(v.Valid, v.String) }),
+		transformer(func(v null.Enum) Value { return of(v.Valid, v.String) }),
+		transformer(func(v null.Flags) Value { return of(v.Valid, v.Flags) }),
+		transformer(func(v null.Regexp) Value { return of(v.Valid, v.Pattern()) }),
+		transformer(func(v null.Interval) Value { return of(v.Valid, v.ISO8601()) }),
+		transformer(func(v null.Weekday) Value { return of(v.Valid, v.Weekday) }),
+		transformer(func(v null.Month) Value { return of(v.Valid, v.Month) }),
+		transformer(func(v null.UnixMilli) Value { return of(v.Valid, v.Int64) }),
+		transformer(func(v null.UnixMicro) Value { return of(v.Valid, v.Int64) }),
+		transformer(func(v null.Percent) Value { return of(v.Valid, v.Float64) }),
+		transformer(func(v null.JSON) Value { return of(v.Valid, string(v.JSON)) }),
+		transformer(func(v null.Color) Value { return of(v.Valid, v.String) }),
+		transformer(func(v null.Char) Value { return of(v.Valid, v.Rune) }),
+		transformer(func(v null.Number) Value { return of(v.Valid, v.Number) }),
+		transformer(func(v null.TimeZone) Value { return of(v.Valid, v.String) }),
+		transformer(func(v null.CSVList) Value { return of(v.Valid, v.Items) }),
+		transformer(func(v null.DeletedAt) Value { return of(v.Valid, v.Time) }),
+		transformer(func(v null.GzipBytes) Value { return of(v.Valid, v.Bytes) }),
+		transformer(func(v null.Encrypted) Value { return of(v.Valid, v.String) }),
+		transformer(func(v null.Secret) Value { return of(v.Valid, redact(v.Text)) }),
+		transformer(func(v null.Password) Value {
+			s, ok := v.Reveal()
+			return of(ok, redact(s))
+		}),
+		objectTransformer(),
+
+		// zero types are null whenever they hold the zero value
+		transformer(func(v zero.String) Value { return of(!v.IsZero(), v.ValueOrZero()) }),
+		transformer(func(v zero.Int) Value { return of(!v.IsZero(), v.ValueOrZero()) }),
+		transformer(func(v zero.Float) Value { return of(!v.IsZero(), v.ValueOrZero()) }),
+		transformer(func(v zero.Bool) Value { return of(!v.IsZero(), v.ValueOrZero()) }),
+		transformer(func(v zero.Time) Value { return of(!v.IsZero(), v.ValueOrZero()) }),
+	}
+}
+
+func transformer[T any](fn func(T) Value) cmp.Option {
+	return cmp.Transformer(fmt.Sprintf("%T", *new(T)), fn)
+}
+
+// objectTransformer transforms null.Object values of any type parameter,
+// which cannot be named by a single transformer function.
+func objectTransformer() cmp.Option {
+	isObject := func(t reflect.Type) bool {
+		return t.PkgPath() == "github.com/attapon-th/null" && strings.HasPrefix(t.Name(), "Object[")
+	}
+	return cmp.FilterPath(func(p cmp.Path) bool {
+		return isObject(p.Last().Type())
+	}, cmp.Transformer("null.Object", func(v any) Value {
+		rv := reflect.ValueOf(v)
+		return of(rv.FieldByName("Valid").Bool(), rv.FieldByName("V").Interface())
+	}))
+}
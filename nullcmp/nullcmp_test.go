@@ -0,0 +1,126 @@
+package nullcmp
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/zero"
+	"github.com/google/go-cmp/cmp"
+)
+
+type record struct {
+	Name    null.String
+	Born    null.DateString
+	Seen    null.Time
+	Role    null.Enum
+	Perms   null.Flags
+	Pattern null.Regexp
+	Rating  null.BoundedInt[rating]
+	Score   zero.Int
+}
+
+type rating struct{}
+
+func (rating) Bounds() (min, max int64) { return 1, 5 }
+
+func TestDiff(t *testing.T) {
+	want := record{
+		Name: null.StringFrom("Attapon"),
+		Born: null.DateStringFrom("2024-01-01"),
+		Role: null.NewEnum("admin", "user").With("admin"),
+	}
+	got := record{
+		Name: null.StringFrom("Attapon"),
+		Role: null.NewEnum("admin", "user").With("user"),
+	}
+	diff := cmp.Diff(want, got, Options())
+	for _, s := range []string{"`\"2024-01-01\"`", `s"null"`, "`\"admin\"`", "`\"user\"`"} {
+		if !strings.Contains(diff, s) {
+			t.Errorf("diff missing %q:\n%s", s, diff)
+		}
+	}
+	if strings.Contains(diff, "NullString") {
+		t.Errorf("diff shows struct internals:\n%s", diff)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	at := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	a := record{
+		Seen:    null.TimeFrom(at),
+		Perms:   null.NewFlags(map[string]uint64{"read": 1}).With(1),
+		Pattern: null.NewRegexp(regexp.MustCompile("a+"), true),
+		Rating:  null.BoundedIntFrom[rating](3),
+		Score:   zero.IntFrom(0),
+	}
+	b := record{
+		Seen:    null.TimeFrom(at.In(time.FixedZone("ICT", 7*60*60))),
+		Perms:   null.FlagsFrom(1),
+		Pattern: null.RegexpFrom("a+"),
+		Rating:  null.BoundedIntFrom[rating](3),
+		Score:   zero.NewInt(0, false),
+	}
+	if diff := cmp.Diff(a, b, Options()); diff != "" {
+		t.Errorf("expected equal records, got diff:\n%s", diff)
+	}
+
+	b.Rating = null.BoundedIntFrom[rating](4)
+	if cmp.Equal(a, b, Options()) {
+		t.Error("expected records with different ratings to differ")
+	}
+}
+
+func TestValueString(t *testing.T) {
+	tests := []struct {
+		v    Value
+		want string
+	}{
+		{Value{Null: true}, "null"},
+		{Value{Inner: "a"}, `"a"`},
+		{Value{Inner: int64(5)}, "5"},
+		{Value{Inner: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}, "2024-01-02T03:04:05Z"},
+		{Value{Inner: time.Monday}, "Monday"},
+	}
+	for _, tc := range tests {
+		if got := tc.v.String(); got != tc.want {
+			t.Errorf("%#v.String() = %q, want %q", tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestSensitive(t *testing.T) {
+	type account struct {
+		Password null.Password
+		Token    null.Secret
+		Key      null.Encrypted
+		Avatar   null.GzipBytes
+		Deleted  null.DeletedAt
+		Settings null.Object[map[string]int]
+	}
+	want := account{
+		Password: null.PasswordFrom("hunter2"),
+		Token:    null.SecretFrom("tok-123"),
+		Key:      null.EncryptedFrom("k"),
+		Avatar:   null.GzipBytesFrom([]byte{1, 2}),
+		Deleted:  null.NewDeletedAt(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), true),
+		Settings: null.ObjectFrom(map[string]int{"a": 1}),
+	}
+	got := want
+	if diff := cmp.Diff(want, got, Options()); diff != "" {
+		t.Errorf("expected equal accounts, got diff:\n%s", diff)
+	}
+
+	got.Password = null.PasswordFrom("hunter3")
+	got.Token = null.Secret{}
+	got.Settings = null.ObjectFrom(map[string]int{"a": 2})
+	diff := cmp.Diff(want, got, Options())
+	if !strings.Contains(diff, "Password") || !strings.Contains(diff, "Token") || !strings.Contains(diff, "Settings") {
+		t.Errorf("diff missing changed fields:\n%s", diff)
+	}
+	if strings.Contains(diff, "hunter") || strings.Contains(diff, "tok-123") {
+		t.Errorf("diff leaks a credential:\n%s", diff)
+	}
+}
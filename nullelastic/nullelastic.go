@@ -0,0 +1,280 @@
+// Package nullelastic builds Elasticsearch mappings for structs of nullable fields and encodes
+// them as documents without their null fields, for search-indexing pipelines.
+//
+// Fields are named like encoding/json names them, so documents and mappings agree.
+// The field type of the mapping can be overridden with an es struct tag, such as `es:"text"`
+// for a String analyzed as full text instead of the default keyword.
+package nullelastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/attapon-th/null"
+)
+
+// Field types of the nullable types whose JSON is not the JSON of their value type.
+var nullTypes = map[reflect.Type]string{
+	reflect.TypeOf(null.Char{}):   "keyword",
+	reflect.TypeOf(null.Number{}): "double",
+	reflect.TypeOf(null.Flags{}):  "keyword",
+	reflect.TypeOf(null.Regexp{}): "keyword",
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	nullerType  = reflect.TypeOf((*interface{ IsNull() bool })(nil)).Elem()
+	weekdayType = reflect.TypeOf(null.Weekday{})
+	monthType   = reflect.TypeOf(null.Month{})
+)
+
+// timeFormat is the Elasticsearch format of times encoded by time.Time.MarshalJSON.
+const timeFormat = "strict_date_optional_time_nanos"
+
+// Mapping returns the Elasticsearch mapping of the struct type of model, which may be a pointer,
+// as the value of the mappings key of a create index request: {"properties": {...}}.
+//
+// String-like types are keyword fields, Int and Float long and double fields, and Bool
+// boolean fields. Time is a date field and DateString a date field in the configured date format.
+// Other nullable types are mapped by the type of their ValueOrZero method and nested structs
+// are object fields. Weekday and Month follow WeekdayStyle and MonthStyle.
+func Mapping(model any) (map[string]any, error) {
+	t := reflect.TypeOf(model)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("nullelastic: cannot map %v, need a struct", t)
+	}
+	return objectMapping(t)
+}
+
+func objectMapping(t reflect.Type) (map[string]any, error) {
+	properties := make(map[string]any)
+	err := eachField(t, func(name string, sf reflect.StructField) error {
+		m, err := fieldMapping(sf.Type)
+		if err != nil {
+			return fmt.Errorf("nullelastic: field %s: %w", sf.Name, err)
+		}
+		if override := sf.Tag.Get("es"); override != "" {
+			m = map[string]any{"type": override}
+		}
+		properties[name] = m
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"properties": properties}, nil
+}
+
+// fieldMapping returns the mapping of a field of type t.
+func fieldMapping(t reflect.Type) (map[string]any, error) {
+	if typ, ok := nullTypes[t]; ok {
+		return map[string]any{"type": typ}, nil
+	}
+	opts := null.CurrentOptions()
+	switch t {
+	case reflect.TypeOf(null.DateString{}):
+		return map[string]any{"type": "date", "format": dateFormat(opts.DateFormat)}, nil
+	case weekdayType, monthType:
+		style := opts.WeekdayStyle
+		if t == monthType {
+			style = opts.MonthStyle
+		}
+		if style == null.StyleNumber {
+			return map[string]any{"type": "integer"}, nil
+		}
+		return map[string]any{"type": "keyword"}, nil
+	case reflect.TypeOf(null.JSON{}):
+		// documents of any shape are kept in the source without being indexed
+		return map[string]any{"type": "object", "enabled": false}, nil
+	case timeType:
+		return map[string]any{"type": "date", "format": timeFormat}, nil
+	}
+	if t.Implements(nullerType) {
+		if m, ok := t.MethodByName("ValueOrZero"); ok && m.Type.NumOut() == 1 {
+			return fieldMapping(m.Type.Out(0))
+		}
+		return map[string]any{"type": "keyword"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "keyword"}, nil
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return map[string]any{"type": "integer"}, nil
+	case reflect.Int, reflect.Int64, reflect.Uint32:
+		return map[string]any{"type": "long"}, nil
+	case reflect.Uint, reflect.Uint64:
+		return map[string]any{"type": "unsigned_long"}, nil
+	case reflect.Float32:
+		return map[string]any{"type": "float"}, nil
+	case reflect.Float64:
+		return map[string]any{"type": "double"}, nil
+	case reflect.Pointer:
+		return fieldMapping(t.Elem())
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "binary"}, nil
+		}
+		// Elasticsearch fields hold arrays of their type
+		return fieldMapping(t.Elem())
+	case reflect.Struct:
+		return objectMapping(t)
+	case reflect.Map, reflect.Interface:
+		return map[string]any{"type": "object"}, nil
+	}
+	return nil, fmt.Errorf("no Elasticsearch field type for %s", t)
+}
+
+// Go layout elements and the Java DateTimeFormatter patterns that Elasticsearch formats use,
+// longer elements first.
+var layoutElements = []struct{ layout, pattern string }{
+	{"January", "MMMM"}, {"Jan", "MMM"}, {"Monday", "EEEE"}, {"Mon", "EEE"},
+	{"2006", "yyyy"}, {"Z07:00", "XXX"}, {"-07:00", "xxx"}, {"-0700", "xx"}, {"MST", "z"},
+	{".000000000", ".SSSSSSSSS"}, {".000000", ".SSSSSS"}, {".000", ".SSS"},
+	{"01", "MM"}, {"02", "dd"}, {"06", "yy"}, {"15", "HH"}, {"03", "hh"}, {"04", "mm"}, {"05", "ss"},
+	{"PM", "a"}, {"1", "M"}, {"2", "d"}, {"3", "h"}, {"4", "m"}, {"5", "s"},
+}
+
+// dateFormat returns the Elasticsearch date format of the Go time layout,
+// quoting letters that are not part of a layout element.
+func dateFormat(layout string) string {
+	if layout == time.DateOnly {
+		return "strict_date"
+	}
+	var b strings.Builder
+	quoted := false
+	for layout != "" {
+		elem := ""
+		for _, e := range layoutElements {
+			if strings.HasPrefix(layout, e.layout) {
+				elem = e.layout
+				if quoted {
+					b.WriteByte('\'')
+					quoted = false
+				}
+				b.WriteString(e.pattern)
+				break
+			}
+		}
+		if elem != "" {
+			layout = layout[len(elem):]
+			continue
+		}
+		c := layout[0]
+		if isLetter := c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '\''; isLetter != quoted {
+			b.WriteByte('\'')
+			quoted = isLetter
+		}
+		if c == '\'' {
+			b.WriteByte('\'')
+		}
+		b.WriteByte(c)
+		layout = layout[1:]
+	}
+	if quoted {
+		b.WriteByte('\'')
+	}
+	return b.String()
+}
+
+// Document returns the JSON encoding of v, a struct or a pointer to one, for indexing.
+// It omits null fields, nil pointers, slices and maps, and fields of nested structs that are null,
+// so that the documents only hold the values that are set. Fields are encoded like json.Marshal.
+func Document(v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("nullelastic: cannot make a document of %T, need a struct", v)
+	}
+	return appendDocument(nil, rv)
+}
+
+func appendDocument(buf []byte, rv reflect.Value) ([]byte, error) {
+	buf = append(buf, '{')
+	first := true
+	err := eachField(rv.Type(), func(name string, sf reflect.StructField) error {
+		fv := rv.FieldByIndex(sf.Index)
+		for fv.Kind() == reflect.Pointer && !fv.IsNil() && !fv.Type().Implements(nullerType) {
+			fv = fv.Elem()
+		}
+		if isNull(fv) {
+			return nil
+		}
+		if !first {
+			buf = append(buf, ',')
+		}
+		first = false
+		key, _ := json.Marshal(name)
+		buf = append(buf, key...)
+		buf = append(buf, ':')
+
+		if fv.Kind() == reflect.Struct && !fv.Type().Implements(nullerType) &&
+			!fv.Type().Implements(reflect.TypeOf((*json.Marshaler)(nil)).Elem()) {
+			var err error
+			buf, err = appendDocument(buf, fv)
+			return err
+		}
+		data, err := json.Marshal(fv.Interface())
+		if err != nil {
+			return fmt.Errorf("nullelastic: field %s: %w", sf.Name, err)
+		}
+		buf = append(buf, data...)
+		return nil
+	})
+	return append(buf, '}'), err
+}
+
+// isNull reports whether fv is nil, which encodes as JSON null, or a null nullable value.
+func isNull(fv reflect.Value) bool {
+	switch fv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		if fv.IsNil() {
+			return true
+		}
+	}
+	n, ok := fv.Interface().(interface{ IsNull() bool })
+	return ok && n.IsNull()
+}
+
+// eachField calls fn with the JSON name of each field of the struct type t that encoding/json
+// would encode, including the fields of embedded structs.
+func eachField(t reflect.Type, fn func(name string, sf reflect.StructField) error) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct && !sf.Type.Implements(nullerType) {
+			err := eachField(sf.Type, func(name string, inner reflect.StructField) error {
+				inner.Index = append([]int{i}, inner.Index...)
+				return fn(name, inner)
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if err := fn(name, sf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package nullelastic
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/zero"
+)
+
+type audit struct {
+	Created null.Time `json:"created"`
+}
+
+type address struct {
+	City null.String `json:"city"`
+}
+
+type product struct {
+	audit
+	Name     null.String     `json:"name" es:"text"`
+	SKU      null.String     `json:"sku"`
+	Stock    null.Int        `json:"stock"`
+	Price    null.Float      `json:"price"`
+	Active   null.Bool       `json:"active"`
+	Released null.DateString `json:"released"`
+	Code     zero.String     `json:"code"`
+	Address  address         `json:"address"`
+	Tags     []string        `json:"tags"`
+	Meta     null.JSON       `json:"meta"`
+	Note     *string         `json:"note"`
+	Internal null.String     `json:"-"`
+}
+
+func TestMapping(t *testing.T) {
+	got, err := Mapping(&product{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{"properties": map[string]any{
+		"created":  map[string]any{"type": "date", "format": "strict_date_optional_time_nanos"},
+		"name":     map[string]any{"type": "text"},
+		"sku":      map[string]any{"type": "keyword"},
+		"stock":    map[string]any{"type": "long"},
+		"price":    map[string]any{"type": "double"},
+		"active":   map[string]any{"type": "boolean"},
+		"released": map[string]any{"type": "date", "format": "strict_date"},
+		"code":     map[string]any{"type": "keyword"},
+		"address": map[string]any{"properties": map[string]any{
+			"city": map[string]any{"type": "keyword"},
+		}},
+		"tags": map[string]any{"type": "keyword"},
+		"meta": map[string]any{"type": "object", "enabled": false},
+		"note": map[string]any{"type": "keyword"},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Mapping() = %v, want %v", got, want)
+	}
+
+	if _, err := Mapping(struct{ C chan int }{}); err == nil {
+		t.Error("Mapping(chan field) = nil error")
+	}
+	if _, err := Mapping(1); err == nil {
+		t.Error("Mapping(int) = nil error")
+	}
+}
+
+func TestDateFormat(t *testing.T) {
+	tests := []struct {
+		layout string
+		want   string
+	}{
+		{time.DateOnly, "strict_date"},
+		{"02/01/2006", "dd/MM/yyyy"},
+		{"2006-01-02T15:04:05Z07:00", "yyyy-MM-dd'T'HH:mm:ssXXX"},
+		{"Jan 2, 2006", "MMM d, yyyy"},
+		{"2006 o'clock", "yyyy 'o''clock'"},
+	}
+	for _, tt := range tests {
+		if got := dateFormat(tt.layout); got != tt.want {
+			t.Errorf("dateFormat(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+	}
+}
+
+func TestDocument(t *testing.T) {
+	note := "x"
+	p := product{
+		audit:    audit{Created: null.TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))},
+		Name:     null.StringFrom("Widget"),
+		Stock:    null.IntFrom(0),
+		Released: null.DateStringFrom("2024-01-02"),
+		Code:     zero.StringFrom(""),
+		Address:  address{},
+		Note:     &note,
+		Internal: null.StringFrom("secret"),
+	}
+	data, err := Document(&p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"created":"2024-01-02T03:04:05Z","name":"Widget","stock":0,"released":"2024-01-02","address":{},"note":"x"}`
+	if string(data) != want {
+		t.Errorf("Document() = %s, want %s", data, want)
+	}
+	if !json.Valid(data) {
+		t.Errorf("Document() = %s, not valid JSON", data)
+	}
+
+	if _, err := Document("x"); err == nil {
+		t.Error("Document(string) = nil error")
+	}
+}
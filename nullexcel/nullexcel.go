@@ -0,0 +1,153 @@
+// Package nullexcel writes nullable values to and reads them from github.com/xuri/excelize cells,
+// for generating spreadsheet reports from structs of null types.
+//
+// Null values are empty cells. A DateString is a date cell shown with the built-in short date
+// number format, and a Time a date and time cell. Excel has no time zones, so a Time is written
+// as its wall clock and read back in UTC, to the second.
+package nullexcel
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/xuri/excelize/v2"
+)
+
+// dateNumFmt is the built-in number format of dates, such as 1/2/2006 in the en-US locale.
+const dateNumFmt = 14
+
+// SetCellValue sets the value of the cell on sheet to value like excelize's File.SetCellValue,
+// leaving it empty if value is a null type that is null.
+// A valid DateString whose value is not a date is an error wrapping null.ErrInvalidDate.
+func SetCellValue(f *excelize.File, sheet, cell string, value any) error {
+	switch v := value.(type) {
+	case null.String:
+		return f.SetCellValue(sheet, cell, orNil(v.Valid, v.String))
+	case null.Int:
+		return f.SetCellValue(sheet, cell, orNil(v.Valid, v.Int64))
+	case null.Float:
+		return f.SetCellValue(sheet, cell, orNil(v.Valid, v.Float64))
+	case null.Bool:
+		return f.SetCellValue(sheet, cell, orNil(v.Valid, v.Bool))
+	case null.Time:
+		return f.SetCellValue(sheet, cell, orNil(v.Valid, v.Time))
+	case null.DateString:
+		if !v.Valid {
+			return f.SetCellValue(sheet, cell, nil)
+		}
+		t, err := time.Parse(null.CurrentOptions().DateFormat, v.String)
+		if err != nil {
+			return fmt.Errorf("nullexcel: %w: %w", null.ErrInvalidDate, err)
+		}
+		if err := f.SetCellValue(sheet, cell, t); err != nil {
+			return err
+		}
+		return setDateStyle(f, sheet, cell)
+	}
+	return f.SetCellValue(sheet, cell, value)
+}
+
+func orNil[T any](valid bool, v T) any {
+	if !valid {
+		return nil
+	}
+	return v
+}
+
+// setDateStyle sets the number format of the cell to dateNumFmt, keeping the rest of its style.
+func setDateStyle(f *excelize.File, sheet, cell string) error {
+	id, err := f.GetCellStyle(sheet, cell)
+	if err != nil {
+		return err
+	}
+	style, err := f.GetStyle(id)
+	if err != nil {
+		return err
+	}
+	style.NumFmt, style.CustomNumFmt = dateNumFmt, nil
+	if id, err = f.NewStyle(style); err != nil {
+		return err
+	}
+	return f.SetCellStyle(sheet, cell, cell, id)
+}
+
+// GetCellValue stores the value of the cell on sheet in dst, a pointer to a String, Int, Float,
+// Bool, Time or DateString, setting it to null if the cell is empty.
+// Numbers are read without their number format, and dates from their serial number.
+func GetCellValue(f *excelize.File, sheet, cell string, dst any) error {
+	raw, err := f.GetCellValue(sheet, cell, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return err
+	}
+	if err := setCell(f, raw, dst); err != nil {
+		return fmt.Errorf("nullexcel: cell %s!%s: %w", sheet, cell, err)
+	}
+	return nil
+}
+
+func setCell(f *excelize.File, raw string, dst any) error {
+	if raw == "" {
+		n, ok := dst.(interface{ SetNull() })
+		if !ok {
+			return fmt.Errorf("cannot read into %T", dst)
+		}
+		n.SetNull()
+		return nil
+	}
+	switch d := dst.(type) {
+	case *null.String:
+		d.SetValid(raw)
+	case *null.Int:
+		i, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		d.SetValid(i)
+	case *null.Float:
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		d.SetValid(v)
+	case *null.Bool:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		d.SetValid(v)
+	case *null.Time:
+		t, err := excelTime(f, raw)
+		if err != nil {
+			return err
+		}
+		d.SetValid(t)
+	case *null.DateString:
+		t, err := excelTime(f, raw)
+		if err != nil {
+			return err
+		}
+		d.SetValid(t.Format(null.CurrentOptions().DateFormat))
+	default:
+		return fmt.Errorf("cannot read into %T", dst)
+	}
+	return nil
+}
+
+// excelTime returns the time of the serial number raw in the date system of f.
+func excelTime(f *excelize.File, raw string) (time.Time, error) {
+	serial, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", null.ErrInvalidDate, err)
+	}
+	props, err := f.GetWorkbookProps()
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := excelize.ExcelDateToTime(serial, props.Date1904 != nil && *props.Date1904)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", null.ErrInvalidDate, err)
+	}
+	return t, nil
+}
@@ -0,0 +1,136 @@
+package nullexcel
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/xuri/excelize/v2"
+)
+
+const sheet = "Sheet1"
+
+// roundTrip sets cell to in and reads it back into a value that starts as start.
+func roundTrip[T any](t *testing.T, f *excelize.File, cell string, in any, start T) T {
+	t.Helper()
+	if err := SetCellValue(f, sheet, cell, in); err != nil {
+		t.Fatalf("SetCellValue(%s) error: %v", cell, err)
+	}
+	got := start
+	if err := GetCellValue(f, sheet, cell, &got); err != nil {
+		t.Fatalf("GetCellValue(%s) error: %v", cell, err)
+	}
+	return got
+}
+
+func TestRoundTrip(t *testing.T) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if got := roundTrip(t, f, "A1", null.StringFrom("Somchai"), null.String{}); got != null.StringFrom("Somchai") {
+		t.Errorf("String = %#v", got)
+	}
+	if got := roundTrip(t, f, "B1", null.IntFrom(0), null.Int{}); got != null.IntFrom(0) {
+		t.Errorf("Int = %#v", got)
+	}
+	if got := roundTrip(t, f, "C1", null.FloatFrom(1.5), null.Float{}); got != null.FloatFrom(1.5) {
+		t.Errorf("Float = %#v", got)
+	}
+	if got := roundTrip(t, f, "D1", null.BoolFrom(false), null.Bool{}); got != null.BoolFrom(false) {
+		t.Errorf("Bool = %#v", got)
+	}
+	created := time.Date(2023, 5, 1, 12, 30, 15, 0, time.FixedZone("ICT", 7*60*60))
+	want := time.Date(2023, 5, 1, 12, 30, 15, 0, time.UTC)
+	if got := roundTrip(t, f, "E1", null.TimeFrom(created), null.Time{}); !got.Valid || !got.Time.Equal(want) {
+		t.Errorf("Time = %v, want %v", got, want)
+	}
+	if got := roundTrip(t, f, "F1", null.DateStringFrom("2024-02-01"), null.DateString{}); got != null.DateStringFrom("2024-02-01") {
+		t.Errorf("DateString = %#v", got)
+	}
+}
+
+func TestRoundTripNull(t *testing.T) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if got := roundTrip(t, f, "A1", null.String{}, null.StringFrom("x")); got.Valid {
+		t.Errorf("String = %#v, want null", got)
+	}
+	if got := roundTrip(t, f, "B1", null.Int{}, null.IntFrom(1)); got.Valid {
+		t.Errorf("Int = %#v, want null", got)
+	}
+	if got := roundTrip(t, f, "C1", null.Float{}, null.FloatFrom(1)); got.Valid {
+		t.Errorf("Float = %#v, want null", got)
+	}
+	if got := roundTrip(t, f, "D1", null.Bool{}, null.BoolFrom(true)); got.Valid {
+		t.Errorf("Bool = %#v, want null", got)
+	}
+	if got := roundTrip(t, f, "E1", null.Time{}, null.TimeFrom(time.Now())); got.Valid {
+		t.Errorf("Time = %#v, want null", got)
+	}
+	if got := roundTrip(t, f, "F1", null.NewDateString("2024-02-01", false), null.DateStringFrom("2024-01-01")); got.Valid {
+		t.Errorf("DateString = %#v, want null", got)
+	}
+	for _, cell := range []string{"A1", "B1", "C1", "D1", "E1", "F1"} {
+		if raw, _ := f.GetCellValue(sheet, cell); raw != "" {
+			t.Errorf("cell %s = %q, want empty", cell, raw)
+		}
+	}
+}
+
+func TestDateStyle(t *testing.T) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	bold, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.SetCellStyle(sheet, "A1", "A1", bold); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetCellValue(f, sheet, "A1", null.DateStringFrom("2024-02-01")); err != nil {
+		t.Fatal(err)
+	}
+	id, _ := f.GetCellStyle(sheet, "A1")
+	style, err := f.GetStyle(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if style.NumFmt != dateNumFmt || style.Font == nil || !style.Font.Bold {
+		t.Errorf("style = %+v, want bold with number format %d", style, dateNumFmt)
+	}
+	if got, _ := f.GetCellValue(sheet, "A1"); got != "02-01-24" {
+		t.Errorf("formatted date = %q, want %q", got, "02-01-24")
+	}
+}
+
+func TestSetInvalidDate(t *testing.T) {
+	f := excelize.NewFile()
+	defer f.Close()
+	err := SetCellValue(f, sheet, "A1", null.NewDateString("not a date", true))
+	if !errors.Is(err, null.ErrInvalidDate) {
+		t.Errorf("SetCellValue() error = %v, want ErrInvalidDate", err)
+	}
+}
+
+func TestGetInvalid(t *testing.T) {
+	f := excelize.NewFile()
+	defer f.Close()
+	if err := f.SetCellValue(sheet, "A1", "abc"); err != nil {
+		t.Fatal(err)
+	}
+	var i null.Int
+	if err := GetCellValue(f, sheet, "A1", &i); err == nil {
+		t.Error("GetCellValue(Int of text) = nil error")
+	}
+	var d null.DateString
+	if err := GetCellValue(f, sheet, "A1", &d); !errors.Is(err, null.ErrInvalidDate) {
+		t.Errorf("GetCellValue(DateString of text) error = %v, want ErrInvalidDate", err)
+	}
+	var s string
+	if err := GetCellValue(f, sheet, "A1", &s); err == nil {
+		t.Error("GetCellValue(*string) = nil error")
+	}
+}
@@ -0,0 +1,44 @@
+// Package nullgorm adapts the types in the null package to gorm.io/gorm.
+package nullgorm
+
+import (
+	"database/sql"
+
+	"github.com/attapon-th/null"
+	"github.com/jinzhu/now"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// DeletedAt is a null.DeletedAt that GORM treats as a soft-delete field, like gorm.DeletedAt:
+// queries skip records where it is set, and Delete sets it instead of removing the row.
+type DeletedAt struct {
+	null.DeletedAt
+}
+
+// QueryClauses implements schema.QueryClausesInterface.
+func (DeletedAt) QueryClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{gorm.SoftDeleteQueryClause{Field: f, ZeroValue: zeroValue(f)}}
+}
+
+// UpdateClauses implements schema.UpdateClausesInterface.
+func (DeletedAt) UpdateClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{gorm.SoftDeleteUpdateClause{Field: f, ZeroValue: zeroValue(f)}}
+}
+
+// DeleteClauses implements schema.DeleteClausesInterface.
+func (DeletedAt) DeleteClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{gorm.SoftDeleteDeleteClause{Field: f, ZeroValue: zeroValue(f)}}
+}
+
+// zeroValue returns the value of the zerovalue tag setting, which some schemas store
+// instead of NULL for records that are not deleted.
+func zeroValue(f *schema.Field) sql.NullString {
+	if v, ok := f.TagSettings["ZEROVALUE"]; ok {
+		if _, err := now.Parse(v); err == nil {
+			return sql.NullString{String: v, Valid: true}
+		}
+	}
+	return sql.NullString{}
+}
@@ -0,0 +1,91 @@
+package nullgorm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/attapon-th/null"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// dryRunDialector builds SQL without a database.
+type dryRunDialector struct{}
+
+func (dryRunDialector) Name() string { return "dryrun" }
+
+func (dryRunDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, &callbacks.Config{})
+	return nil
+}
+
+func (d dryRunDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return migrator.Migrator{Config: migrator.Config{DB: db, Dialector: d}}
+}
+
+func (dryRunDialector) DataTypeOf(*schema.Field) string { return "" }
+
+func (dryRunDialector) DefaultValueOf(*schema.Field) clause.Expression {
+	return clause.Expr{SQL: "DEFAULT"}
+}
+
+func (dryRunDialector) BindVarTo(w clause.Writer, _ *gorm.Statement, _ any) {
+	w.WriteByte('?')
+}
+
+func (dryRunDialector) QuoteTo(w clause.Writer, s string) {
+	w.WriteString(s)
+}
+
+func (dryRunDialector) Explain(sql string, _ ...any) string { return sql }
+
+type user struct {
+	ID        int64
+	Name      null.String
+	DeletedAt DeletedAt
+}
+
+func TestDeletedAt(t *testing.T) {
+	db, err := gorm.Open(dryRunDialector{}, &gorm.Config{DryRun: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var users []user
+	stmt := db.Find(&users).Statement
+	if sql := stmt.SQL.String(); !strings.Contains(sql, "users.deleted_at IS NULL") {
+		t.Errorf("query should skip deleted records: %s", sql)
+	}
+	stmt = db.Unscoped().Find(&users).Statement
+	if sql := stmt.SQL.String(); strings.Contains(sql, "deleted_at") {
+		t.Errorf("unscoped query should include deleted records: %s", sql)
+	}
+
+	stmt = db.Delete(&user{ID: 1}).Statement
+	if sql := stmt.SQL.String(); !strings.HasPrefix(sql, "UPDATE users SET deleted_at=?") {
+		t.Errorf("delete should set deleted_at: %s", sql)
+	}
+}
+
+func TestDeletedAtHelpers(t *testing.T) {
+	var d DeletedAt
+	d.Delete()
+	if !d.IsDeleted() || d.Time.IsZero() {
+		t.Errorf("Delete: %+v", d)
+	}
+	v, err := d.Value()
+	if err != nil || v == nil {
+		t.Errorf("Value = %v, %v", v, err)
+	}
+	d.Restore()
+	if d.IsDeleted() {
+		t.Errorf("Restore: %+v", d)
+	}
+	if v, _ := d.Value(); v != nil {
+		t.Errorf("restored Value = %v, want nil", v)
+	}
+}
@@ -0,0 +1,95 @@
+// Package nulljsoniter registers github.com/json-iterator/go encoders and decoders for the types
+// in the null and zero packages, so they encode and decode as they do with encoding/json:
+//
+//	json := jsoniter.ConfigCompatibleWithStandardLibrary
+//	nulljsoniter.Register(json)
+//
+// The encoders call MarshalJSON and the decoders call UnmarshalJSON directly,
+// without json-iterator inspecting the types for each value.
+package nulljsoniter
+
+import (
+	"encoding/json"
+	"reflect"
+	"unsafe"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/modern-go/reflect2"
+)
+
+// packages are the import paths whose types Extension handles.
+var packages = map[string]bool{
+	"github.com/attapon-th/null":      true,
+	"github.com/attapon-th/null/zero": true,
+}
+
+var (
+	marshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// Register registers Extension with api, such as jsoniter.ConfigCompatibleWithStandardLibrary.
+// It must be called before api encodes or decodes the types, because api caches its encoders.
+func Register(api jsoniter.API) {
+	api.RegisterExtension(&Extension{})
+}
+
+// Extension is a jsoniter.Extension for the types in the null and zero packages.
+// Other types are left to the encoders and decoders of json-iterator.
+type Extension struct {
+	jsoniter.DummyExtension
+}
+
+// CreateEncoder returns an encoder that calls MarshalJSON for the types in the null and zero packages,
+// or nil for other types.
+func (*Extension) CreateEncoder(typ reflect2.Type) jsoniter.ValEncoder {
+	t := typ.Type1()
+	if !packages[t.PkgPath()] || !t.Implements(marshalerType) {
+		return nil
+	}
+	return encoder{typ: typ}
+}
+
+// CreateDecoder returns a decoder that calls UnmarshalJSON for the types in the null and zero packages,
+// or nil for other types.
+func (*Extension) CreateDecoder(typ reflect2.Type) jsoniter.ValDecoder {
+	t := typ.Type1()
+	if !packages[t.PkgPath()] || !reflect.PointerTo(t).Implements(unmarshalerType) {
+		return nil
+	}
+	return decoder{ptrType: reflect2.PtrTo(typ)}
+}
+
+type encoder struct {
+	typ reflect2.Type
+}
+
+// IsEmpty returns false, because like encoding/json, omitempty does not omit structs.
+func (encoder) IsEmpty(ptr unsafe.Pointer) bool {
+	return false
+}
+
+func (e encoder) Encode(ptr unsafe.Pointer, stream *jsoniter.Stream) {
+	data, err := e.typ.UnsafeIndirect(ptr).(json.Marshaler).MarshalJSON()
+	if err != nil {
+		stream.Error = err
+		return
+	}
+	stream.Write(data)
+}
+
+type decoder struct {
+	ptrType reflect2.Type
+}
+
+func (d decoder) Decode(ptr unsafe.Pointer, iter *jsoniter.Iterator) {
+	data := iter.SkipAndReturnBytes()
+	if iter.Error != nil {
+		return
+	}
+	// ptrType.UnsafeIndirect expects a pointer to the *T
+	v := d.ptrType.UnsafeIndirect(unsafe.Pointer(&ptr)).(json.Unmarshaler)
+	if err := v.UnmarshalJSON(data); err != nil {
+		iter.ReportError("nulljsoniter", err.Error())
+	}
+}
@@ -0,0 +1,77 @@
+package nulljsoniter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/zero"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type record struct {
+	Name     null.String     `json:"name"`
+	Age      null.Int        `json:"age,omitempty"`
+	Score    null.Float      `json:"score"`
+	Born     null.DateString `json:"born"`
+	Deleted  null.Time       `json:"deleted"`
+	Nickname zero.String     `json:"nickname"`
+	Tags     null.CSVList    `json:"tags"`
+	Plain    string          `json:"plain"`
+}
+
+func newAPI() jsoniter.API {
+	api := jsoniter.Config{SortMapKeys: true, ValidateJsonRawMessage: true}.Froze()
+	Register(api)
+	return api
+}
+
+func TestEncodeMatchesEncodingJSON(t *testing.T) {
+	api := newAPI()
+	records := []record{
+		{},
+		{
+			Name:     null.StringFrom(""),
+			Age:      null.IntFrom(0),
+			Score:    null.FloatFrom(1.5),
+			Born:     null.DateStringFrom("2000-02-29"),
+			Deleted:  null.TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			Nickname: zero.StringFrom("x"),
+			Tags:     null.CSVListFrom([]string{"a", "b"}),
+			Plain:    "p",
+		},
+	}
+	for _, r := range records {
+		want, err := json.Marshal(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := api.Marshal(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("jsoniter: %s\nencoding/json: %s", got, want)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	api := newAPI()
+	var r record
+	input := `{"name":"a","age":null,"score":"2.5","born":"2024-01-02T10:00:00Z","tags":["x"],"plain":"p"}`
+	if err := api.Unmarshal([]byte(input), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Name != null.StringFrom("a") || r.Age.Valid || r.Score.Float64 != 2.5 || r.Born.String != "2024-01-02" {
+		t.Errorf("Unmarshal = %+v", r)
+	}
+	if len(r.Tags.Items) != 1 || r.Plain != "p" {
+		t.Errorf("Unmarshal = %+v", r)
+	}
+
+	if err := api.Unmarshal([]byte(`{"age":"x"}`), &r); err == nil {
+		t.Error("expected error for invalid Int")
+	}
+}
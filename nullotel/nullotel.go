@@ -0,0 +1,125 @@
+// Package nullotel converts nullable values to OpenTelemetry attributes,
+// for annotating spans and metrics with optional fields.
+//
+// Null values have no attribute. Attr returns the zero attribute.KeyValue for them,
+// which is not valid, and Attrs drops it, so that spans only hold the values that are set.
+package nullotel
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/attapon-th/null"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Attr returns the attribute of v under key, or the zero attribute.KeyValue if v is nil
+// or a null type that is null.
+//
+// String and DateString are string attributes, Int and Float int64 and float64 attributes,
+// and Bool a bool attribute. Time is a string attribute in RFC 3339 format with nanoseconds.
+// Other values with an IsNull method are skipped when null and converted by the value of
+// their ValueOrZero method, if they have one. Go strings, integers, floats and
+// bools and their slices keep their type, and the rest are string attributes formatted
+// with their String method or fmt.Sprint.
+func Attr(key string, v any) attribute.KeyValue {
+	switch v := v.(type) {
+	case nil:
+		return attribute.KeyValue{}
+	case null.String:
+		return orEmpty(v.Valid, attribute.String(key, v.String))
+	case null.Int:
+		return orEmpty(v.Valid, attribute.Int64(key, v.Int64))
+	case null.Float:
+		return orEmpty(v.Valid, attribute.Float64(key, v.Float64))
+	case null.Bool:
+		return orEmpty(v.Valid, attribute.Bool(key, v.Bool))
+	case null.Time:
+		if !v.Valid {
+			return attribute.KeyValue{}
+		}
+		return attribute.String(key, v.Time.Format(time.RFC3339Nano))
+	case null.DateString:
+		return orEmpty(v.Valid, attribute.String(key, v.String))
+	case attribute.Value:
+		return attribute.KeyValue{Key: attribute.Key(key), Value: v}
+	case interface{ IsNull() bool }:
+		if v.IsNull() {
+			return attribute.KeyValue{}
+		}
+		if m := reflect.ValueOf(v).MethodByName("ValueOrZero"); m.IsValid() && m.Type().NumIn() == 0 && m.Type().NumOut() == 1 {
+			return value(key, m.Call(nil)[0].Interface())
+		}
+	}
+	return value(key, v)
+}
+
+func orEmpty(valid bool, kv attribute.KeyValue) attribute.KeyValue {
+	if !valid {
+		return attribute.KeyValue{}
+	}
+	return kv
+}
+
+// value returns the attribute of a value that is not a null type.
+func value(key string, v any) attribute.KeyValue {
+	switch v := v.(type) {
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int8:
+		return attribute.Int64(key, int64(v))
+	case int16:
+		return attribute.Int64(key, int64(v))
+	case int32:
+		return attribute.Int64(key, int64(v))
+	case int64:
+		return attribute.Int64(key, v)
+	case uint8:
+		return attribute.Int64(key, int64(v))
+	case uint16:
+		return attribute.Int64(key, int64(v))
+	case uint32:
+		return attribute.Int64(key, int64(v))
+	case float32:
+		return attribute.Float64(key, float64(v))
+	case float64:
+		return attribute.Float64(key, v)
+	case []string:
+		return attribute.StringSlice(key, v)
+	case []bool:
+		return attribute.BoolSlice(key, v)
+	case []int:
+		return attribute.IntSlice(key, v)
+	case []int64:
+		return attribute.Int64Slice(key, v)
+	case []float64:
+		return attribute.Float64Slice(key, v)
+	case time.Time:
+		return attribute.String(key, v.Format(time.RFC3339Nano))
+	case fmt.Stringer:
+		return attribute.String(key, v.String())
+	}
+	return attribute.String(key, fmt.Sprint(v))
+}
+
+// Attrs returns the valid attributes of kvs, dropping those of null values, for passing to
+// trace.Span.SetAttributes or trace.WithAttributes:
+//
+//	span.SetAttributes(nullotel.Attrs(
+//		nullotel.Attr("user.id", user.ID),
+//		nullotel.Attr("user.email", user.Email),
+//	)...)
+func Attrs(kvs ...attribute.KeyValue) []attribute.KeyValue {
+	out := kvs[:0:0]
+	for _, kv := range kvs {
+		if kv.Valid() {
+			out = append(out, kv)
+		}
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package nullotel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestAttr(t *testing.T) {
+	created := time.Date(2023, 5, 1, 12, 30, 0, 123456000, time.UTC)
+	tests := []struct {
+		name string
+		in   any
+		want attribute.KeyValue
+	}{
+		{"String", null.StringFrom("Somchai"), attribute.String("k", "Somchai")},
+		{"Int", null.IntFrom(0), attribute.Int64("k", 0)},
+		{"Float", null.FloatFrom(1.5), attribute.Float64("k", 1.5)},
+		{"Bool", null.BoolFrom(false), attribute.Bool("k", false)},
+		{"Time", null.TimeFrom(created), attribute.String("k", "2023-05-01T12:30:00.123456Z")},
+		{"DateString", null.DateStringFrom("1990-01-01"), attribute.String("k", "1990-01-01")},
+		{"Weekday", null.WeekdayFrom(time.Monday), attribute.String("k", "Monday")},
+		{"int", 7, attribute.Int("k", 7)},
+		{"strings", []string{"a", "b"}, attribute.StringSlice("k", []string{"a", "b"})},
+		{"Value", attribute.BoolValue(true), attribute.Bool("k", true)},
+	}
+	for _, tt := range tests {
+		got := Attr("k", tt.in)
+		if got.Key != tt.want.Key || got.Value.Type() != tt.want.Value.Type() || got.Value.Emit() != tt.want.Value.Emit() {
+			t.Errorf("Attr(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAttrNull(t *testing.T) {
+	for _, in := range []any{
+		nil,
+		null.String{},
+		null.Int{},
+		null.Float{},
+		null.Bool{},
+		null.Time{},
+		null.NewDateString("1990-01-01", false),
+		null.Weekday{},
+	} {
+		if got := Attr("k", in); got.Valid() {
+			t.Errorf("Attr(%#v) = %v, want invalid", in, got)
+		}
+	}
+}
+
+func TestAttrs(t *testing.T) {
+	got := Attrs(
+		Attr("name", null.StringFrom("Somchai")),
+		Attr("email", null.String{}),
+		Attr("age", null.IntFrom(30)),
+	)
+	want := []attribute.KeyValue{attribute.String("name", "Somchai"), attribute.Int64("age", 30)}
+	if len(got) != len(want) {
+		t.Fatalf("Attrs = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Key != want[i].Key || got[i].Value.Emit() != want[i].Value.Emit() {
+			t.Errorf("Attrs[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if got := Attrs(); len(got) != 0 {
+		t.Errorf("Attrs() = %v", got)
+	}
+}
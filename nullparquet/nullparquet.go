@@ -0,0 +1,368 @@
+// Package nullparquet writes and reads structs of nullable fields as Parquet with
+// github.com/parquet-go/parquet-go, such as for archiving database exports to object storage.
+//
+// Nullable fields are OPTIONAL columns of their base type. A DateString is an int32 column with
+// the DATE logical type and a Time an int64 column with the TIMESTAMP logical type in microseconds.
+// Fields are named by the parquet struct tag, or the field name if there is none,
+// and fields tagged `parquet:"-"` are skipped.
+package nullparquet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/parquet-go/parquet-go"
+)
+
+var (
+	stringType = reflect.TypeOf(null.String{})
+	intType    = reflect.TypeOf(null.Int{})
+	floatType  = reflect.TypeOf(null.Float{})
+	boolType   = reflect.TypeOf(null.Bool{})
+	timeType   = reflect.TypeOf(null.Time{})
+	dateType   = reflect.TypeOf(null.DateString{})
+)
+
+var schemaCache sync.Map // map[reflect.Type]*parquet.Schema
+
+// SchemaOf returns the Parquet schema of the struct type of model, which may be a pointer.
+// It panics if a field has a type that has no Parquet column, like parquet.SchemaOf.
+func SchemaOf(model any) *parquet.Schema {
+	schema, err := cachedSchema(reflect.TypeOf(model))
+	if err != nil {
+		panic(err)
+	}
+	return schema
+}
+
+func cachedSchema(t reflect.Type) (*parquet.Schema, error) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("nullparquet: cannot make a schema of %v, need a struct", t)
+	}
+	if schema, ok := schemaCache.Load(t); ok {
+		return schema.(*parquet.Schema), nil
+	}
+	node, err := nodeOf(t)
+	if err != nil {
+		return nil, err
+	}
+	schema := parquet.NewSchema(t.Name(), node)
+	schemaCache.Store(t, schema)
+	return schema, nil
+}
+
+// nodeOf returns the Parquet node of values of type t.
+func nodeOf(t reflect.Type) (parquet.Node, error) {
+	switch t {
+	case stringType:
+		return parquet.Optional(parquet.String()), nil
+	case intType:
+		return parquet.Optional(parquet.Int(64)), nil
+	case floatType:
+		return parquet.Optional(parquet.Leaf(parquet.DoubleType)), nil
+	case boolType:
+		return parquet.Optional(parquet.Leaf(parquet.BooleanType)), nil
+	case timeType:
+		return parquet.Optional(parquet.Timestamp(parquet.Microsecond)), nil
+	case dateType:
+		return parquet.Optional(parquet.Date()), nil
+	case reflect.TypeOf(time.Time{}):
+		return parquet.Timestamp(parquet.Microsecond), nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return parquet.String(), nil
+	case reflect.Bool:
+		return parquet.Leaf(parquet.BooleanType), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return parquet.Int(32), nil
+	case reflect.Int, reflect.Int64:
+		return parquet.Int(64), nil
+	case reflect.Float32:
+		return parquet.Leaf(parquet.FloatType), nil
+	case reflect.Float64:
+		return parquet.Leaf(parquet.DoubleType), nil
+	case reflect.Pointer:
+		elem, err := nodeOf(t.Elem())
+		if err != nil || elem.Optional() {
+			return elem, err
+		}
+		return parquet.Optional(elem), nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return parquet.Leaf(parquet.ByteArrayType), nil
+		}
+		elem, err := nodeOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return parquet.Repeated(parquet.Required(elem)), nil
+	case reflect.Struct:
+		group := make(parquet.Group)
+		err := eachField(t, func(name string, sf reflect.StructField) error {
+			node, err := nodeOf(sf.Type)
+			if err != nil {
+				return fmt.Errorf("nullparquet: field %s: %w", sf.Name, err)
+			}
+			group[name] = node
+			return nil
+		})
+		return group, err
+	}
+	return nil, fmt.Errorf("nullparquet: no Parquet column for %s", t)
+}
+
+// Write writes rows to w as a Parquet file, like parquet.Write.
+func Write[T any](w io.Writer, rows []T, options ...parquet.WriterOption) error {
+	schema, err := cachedSchema(reflect.TypeOf((*T)(nil)))
+	if err != nil {
+		return err
+	}
+	data := make([]map[string]any, len(rows))
+	for i := range rows {
+		datum, err := toParquet(reflect.ValueOf(&rows[i]).Elem())
+		if err != nil {
+			return err
+		}
+		data[i] = datum.(map[string]any)
+	}
+	pw := parquet.NewGenericWriter[map[string]any](w, append([]parquet.WriterOption{schema}, options...)...)
+	if _, err := pw.Write(data); err != nil {
+		return err
+	}
+	return pw.Close()
+}
+
+// Read reads the rows of the Parquet file in r, which is size bytes long, like parquet.Read.
+func Read[T any](r io.ReaderAt, size int64, options ...parquet.ReaderOption) ([]T, error) {
+	schema, err := cachedSchema(reflect.TypeOf((*T)(nil)))
+	if err != nil {
+		return nil, err
+	}
+	f, err := parquet.OpenFile(r, size)
+	if err != nil {
+		return nil, err
+	}
+	pr := parquet.NewGenericReader[map[string]any](f, append([]parquet.ReaderOption{schema}, options...)...)
+	defer pr.Close()
+
+	data := make([]map[string]any, f.NumRows())
+	for i := range data {
+		data[i] = make(map[string]any)
+	}
+	for n := 0; n < len(data); {
+		m, err := pr.Read(data[n:])
+		n += m
+		if errors.Is(err, io.EOF) {
+			data = data[:n]
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows := make([]T, len(data))
+	for i, datum := range data {
+		if err := fromParquet(datum, reflect.ValueOf(&rows[i]).Elem()); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// toParquet converts rv to the generic data that a parquet.Writer accepts with the schema of its type.
+func toParquet(rv reflect.Value) (any, error) {
+	switch x := rv.Interface().(type) {
+	case null.String:
+		return orNil(x.Valid, x.String), nil
+	case null.Int:
+		return orNil(x.Valid, x.Int64), nil
+	case null.Float:
+		return orNil(x.Valid, x.Float64), nil
+	case null.Bool:
+		return orNil(x.Valid, x.Bool), nil
+	case null.Time:
+		return orNil(x.Valid, x.Time.UnixMicro()), nil
+	case null.DateString:
+		if !x.Valid {
+			return nil, nil
+		}
+		t, err := time.Parse(null.CurrentOptions().DateFormat, x.String)
+		if err != nil {
+			return nil, fmt.Errorf("nullparquet: %w: %w", null.ErrInvalidDate, err)
+		}
+		return epochDays(t), nil
+	case time.Time:
+		return x.UnixMicro(), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return toParquet(rv.Elem())
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return int32(rv.Int()), nil
+	case reflect.Int, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Struct:
+		record := make(map[string]any)
+		err := eachField(rv.Type(), func(name string, sf reflect.StructField) error {
+			datum, err := toParquet(rv.FieldByIndex(sf.Index))
+			record[name] = datum
+			return err
+		})
+		return record, err
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Bytes(), nil
+		}
+		items := make([]any, rv.Len())
+		for i := range items {
+			var err error
+			if items[i], err = toParquet(rv.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	}
+	return rv.Interface(), nil
+}
+
+func orNil[T any](valid bool, v T) any {
+	if !valid {
+		return nil
+	}
+	return v
+}
+
+// fromParquet stores the generic data read by a parquet.Reader in rv.
+func fromParquet(datum any, rv reflect.Value) error {
+	switch x := rv.Addr().Interface().(type) {
+	case *null.String:
+		return setNullable(datum, x, x.SetValid)
+	case *null.Int:
+		return setNullable(datum, x, x.SetValid)
+	case *null.Float:
+		return setNullable(datum, x, x.SetValid)
+	case *null.Bool:
+		return setNullable(datum, x, x.SetValid)
+	case *null.Time:
+		return setNullable(datum, x, func(us int64) {
+			x.SetValid(time.UnixMicro(us).UTC())
+		})
+	case *null.DateString:
+		return setNullable(datum, x, func(days int32) {
+			x.SetValid(fromEpochDays(days).Format(null.CurrentOptions().DateFormat))
+		})
+	case *time.Time:
+		return setNullable(datum, nil, func(us int64) {
+			*x = time.UnixMicro(us).UTC()
+		})
+	}
+
+	if datum == nil {
+		rv.SetZero()
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(rv.Type().Elem())
+		if err := fromParquet(datum, elem.Elem()); err != nil {
+			return err
+		}
+		rv.Set(elem)
+		return nil
+	case reflect.Struct:
+		record, ok := datum.(map[string]any)
+		if !ok {
+			return fmt.Errorf("nullparquet: cannot read %T into %s", datum, rv.Type())
+		}
+		return eachField(rv.Type(), func(name string, sf reflect.StructField) error {
+			if datum, ok := record[name]; ok {
+				return fromParquet(datum, rv.FieldByIndex(sf.Index))
+			}
+			return nil
+		})
+	case reflect.Slice:
+		items, ok := datum.([]any)
+		if !ok {
+			break
+		}
+		s := reflect.MakeSlice(rv.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := fromParquet(item, s.Index(i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(s)
+		return nil
+	}
+	dv := reflect.ValueOf(datum)
+	if !dv.Type().ConvertibleTo(rv.Type()) {
+		return fmt.Errorf("nullparquet: cannot read %T into %s", datum, rv.Type())
+	}
+	rv.Set(dv.Convert(rv.Type()))
+	return nil
+}
+
+// setNullable sets n to null if datum is nil, or calls set with datum converted to T.
+// A nil n means the destination is not nullable and a nil datum leaves it unchanged.
+func setNullable[T any](datum any, n interface{ SetNull() }, set func(T)) error {
+	if datum == nil {
+		if n != nil {
+			n.SetNull()
+		}
+		return nil
+	}
+	var v T
+	dv, vt := reflect.ValueOf(datum), reflect.TypeOf(v)
+	if !dv.Type().ConvertibleTo(vt) {
+		return fmt.Errorf("nullparquet: cannot read %T into %T", datum, n)
+	}
+	set(dv.Convert(vt).Interface().(T))
+	return nil
+}
+
+// epochDays returns the number of days from the Unix epoch to the date of t, as DATE columns hold.
+func epochDays(t time.Time) int32 {
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return int32(d.Unix() / (24 * 60 * 60))
+}
+
+func fromEpochDays(days int32) time.Time {
+	return time.Unix(int64(days)*24*60*60, 0).UTC()
+}
+
+// eachField calls fn with the Parquet name of each exported field of the struct type t.
+func eachField(t reflect.Type, fn func(name string, sf reflect.StructField) error) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("parquet"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if err := fn(name, sf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
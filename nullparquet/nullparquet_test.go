@@ -0,0 +1,95 @@
+package nullparquet
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+)
+
+type address struct {
+	City null.String `parquet:"city"`
+	Zip  null.String `parquet:"zip"`
+}
+
+type export struct {
+	Name     null.String     `parquet:"name"`
+	Age      null.Int        `parquet:"age"`
+	Score    null.Float      `parquet:"score"`
+	Active   null.Bool       `parquet:"active"`
+	Created  null.Time       `parquet:"created"`
+	Birthday null.DateString `parquet:"birthday"`
+	Address  address         `parquet:"address"`
+	Tags     []string        `parquet:"tags"`
+	Count    int32           `parquet:"count"`
+	Internal null.String     `parquet:"-"`
+}
+
+func TestSchemaOf(t *testing.T) {
+	schema := SchemaOf(&export{}).String()
+	for _, want := range []string{
+		"optional binary name (STRING);",
+		"optional int64 age (INT(64,true));",
+		"optional double score;",
+		"optional boolean active;",
+		"optional int64 created (TIMESTAMP(isAdjustedToUTC=true,unit=MICROS));",
+		"optional int32 birthday (DATE);",
+		"required group address {",
+		"repeated binary tags (STRING);",
+		"required int32 count (INT(32,true));",
+	} {
+		if !strings.Contains(schema, want) {
+			t.Errorf("SchemaOf() = %s, want it to contain %q", schema, want)
+		}
+	}
+	if strings.Contains(schema, "Internal") {
+		t.Errorf("SchemaOf() = %s, want no Internal column", schema)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	rows := []export{
+		{
+			Name:     null.StringFrom("Somchai"),
+			Age:      null.IntFrom(0),
+			Score:    null.FloatFrom(1.5),
+			Active:   null.BoolFrom(false),
+			Created:  null.TimeFrom(time.Date(2023, 5, 1, 12, 30, 0, 123456000, time.UTC)),
+			Birthday: null.DateStringFrom("1969-12-31"),
+			Address:  address{City: null.StringFrom("Bangkok")},
+			Tags:     []string{"a", "b"},
+			Count:    3,
+		},
+		{Tags: []string{}},
+	}
+	var buf bytes.Buffer
+	if err := Write(&buf, rows); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Read[export](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, rows) {
+		t.Errorf("round trip = %+v, want %+v", got, rows)
+	}
+}
+
+func TestWriteInvalidDate(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, []export{{Birthday: null.NewDateString("not a date", true)}})
+	if !errors.Is(err, null.ErrInvalidDate) {
+		t.Errorf("Write() error = %v, want ErrInvalidDate", err)
+	}
+}
+
+func TestWriteUnsupported(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, []struct{ C chan int }{{}}); err == nil {
+		t.Error("Write(chan field) = nil error")
+	}
+}
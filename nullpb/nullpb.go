@@ -0,0 +1,73 @@
+// Package nullpb bridges structs of nullable fields to protobuf well-known types.
+package nullpb
+
+import (
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FieldMask returns a field mask listing the paths of the valid fields of the struct patch,
+// or of the struct it points to, such as a decoded HTTP PATCH body, for gRPC update APIs.
+// Fields are nullable if they have an IsNull method, like the types in the null package.
+//
+// Paths are named by the json struct tag, or the field name if there is none, and fields
+// tagged `json:"-"` are skipped. Nested structs that are not nullable contribute their own
+// valid fields as dotted paths, such as "address.city". Fields that are neither are ignored.
+func FieldMask(patch any) *fieldmaskpb.FieldMask {
+	mask := &fieldmaskpb.FieldMask{}
+	rv := reflect.ValueOf(patch)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return mask
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.Struct {
+		mask.Paths = appendPaths(mask.Paths, rv, "")
+	}
+	return mask
+}
+
+func appendPaths(paths []string, rv reflect.Value, prefix string) []string {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		fv := rv.Field(i)
+
+		if !sf.IsExported() {
+			// fields of an unexported embedded struct are still promoted
+			if sf.Anonymous && fv.Kind() == reflect.Struct {
+				paths = appendPaths(paths, fv, prefix)
+			}
+			continue
+		}
+		if n, ok := fv.Interface().(interface{ IsNull() bool }); ok {
+			if !n.IsNull() {
+				paths = append(paths, prefix+fieldName(sf, name))
+			}
+			continue
+		}
+		if fv.Kind() == reflect.Struct {
+			if sf.Anonymous && name == "" {
+				paths = appendPaths(paths, fv, prefix)
+			} else {
+				paths = appendPaths(paths, fv, prefix+fieldName(sf, name)+".")
+			}
+		}
+	}
+	return paths
+}
+
+func fieldName(sf reflect.StructField, name string) string {
+	if name == "" {
+		return sf.Name
+	}
+	return name
+}
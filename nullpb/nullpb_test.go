@@ -0,0 +1,46 @@
+package nullpb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/attapon-th/null"
+)
+
+type address struct {
+	City null.String `json:"city"`
+	Zip  null.String `json:"zip"`
+}
+
+type meta struct {
+	Source null.String `json:"source"`
+}
+
+type updateUserRequest struct {
+	meta
+	Name     null.String `json:"name"`
+	Email    null.String `json:"email,omitempty"`
+	Age      null.Int
+	Address  address     `json:"address"`
+	Internal null.String `json:"-"`
+	Count    int         `json:"count"`
+}
+
+func TestFieldMask(t *testing.T) {
+	req := &updateUserRequest{
+		meta:     meta{Source: null.StringFrom("web")},
+		Email:    null.StringFrom("a@example.com"),
+		Age:      null.IntFrom(0),
+		Address:  address{City: null.StringFrom("Bangkok")},
+		Internal: null.StringFrom("x"),
+		Count:    1,
+	}
+	want := []string{"source", "email", "Age", "address.city"}
+	if got := FieldMask(req).GetPaths(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FieldMask() = %v, want %v", got, want)
+	}
+
+	if got := FieldMask((*updateUserRequest)(nil)).GetPaths(); len(got) != 0 {
+		t.Errorf("FieldMask(nil) = %v, want no paths", got)
+	}
+}
@@ -0,0 +1,73 @@
+// Package nullschema decodes HTML form values into the types in the null and zero packages
+// with github.com/gorilla/schema.
+//
+// The types implement encoding.TextUnmarshaler, which schema uses on its own. RegisterConverters
+// makes the decoding explicit and takes precedence over other converters registered for them.
+// Either way, an empty form value unmarshals to null.
+package nullschema
+
+import (
+	"encoding"
+	"reflect"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/zero"
+	"github.com/gorilla/schema"
+)
+
+// RegisterConverters registers converters on decoder for every type in the null and zero packages,
+// except Enum and Flags, which need the allowed values of the field they decode into.
+// Input that cannot be parsed is reported by decoder as a schema.ConversionError.
+func RegisterConverters(decoder *schema.Decoder) {
+	register[null.String](decoder)
+	register[null.Int](decoder)
+	register[null.Float](decoder)
+	register[null.Bool](decoder)
+	register[null.Time](decoder)
+	register[null.DateString](decoder)
+	register[null.LanguageTag](decoder)
+	register[null.CountryCode](decoder)
+	register[null.Phone](decoder)
+	register[null.Regexp](decoder)
+	register[null.Cron](decoder)
+	register[null.Interval](decoder)
+	register[null.Weekday](decoder)
+	register[null.Month](decoder)
+	register[null.UnixMilli](decoder)
+	register[null.UnixMicro](decoder)
+	register[null.Percent](decoder)
+	register[null.Color](decoder)
+	register[null.Char](decoder)
+	register[null.Number](decoder)
+	register[null.TimeZone](decoder)
+	register[null.CSVList](decoder)
+
+	register[zero.String](decoder)
+	register[zero.Int](decoder)
+	register[zero.Float](decoder)
+	register[zero.Bool](decoder)
+	register[zero.Time](decoder)
+}
+
+func register[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](decoder *schema.Decoder) {
+	var zero T
+	decoder.RegisterConverter(zero, Converter[T, PT]())
+}
+
+// Converter returns a schema.Converter that decodes form values into T with its UnmarshalText
+// method, for registering types such as null.BoundedInt instances or those generated by nullgen.
+func Converter[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}]() schema.Converter {
+	return func(value string) reflect.Value {
+		var v T
+		if err := PT(&v).UnmarshalText([]byte(value)); err != nil {
+			return reflect.Value{}
+		}
+		return reflect.ValueOf(v)
+	}
+}
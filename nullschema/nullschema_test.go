@@ -0,0 +1,72 @@
+package nullschema
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/zero"
+	"github.com/gorilla/schema"
+)
+
+type rating struct{}
+
+func (rating) Bounds() (min, max int64) { return 1, 5 }
+
+type signupForm struct {
+	Name     null.String             `schema:"name"`
+	Age      null.Int                `schema:"age"`
+	Born     null.DateString         `schema:"born"`
+	Agree    null.Bool               `schema:"agree"`
+	Day      null.Weekday            `schema:"day"`
+	Nickname null.String             `schema:"nickname"`
+	Rating   null.BoundedInt[rating] `schema:"rating"`
+	Score    zero.Int                `schema:"score"`
+}
+
+func newDecoder() *schema.Decoder {
+	d := schema.NewDecoder()
+	RegisterConverters(d)
+	d.RegisterConverter(null.BoundedInt[rating]{}, Converter[null.BoundedInt[rating]]())
+	return d
+}
+
+func TestDecode(t *testing.T) {
+	form := url.Values{
+		"name":     {"Attapon"},
+		"age":      {"30"},
+		"born":     {"2000-01-02"},
+		"agree":    {"true"},
+		"day":      {"Monday"},
+		"nickname": {""},
+		"rating":   {"4"},
+		"score":    {"0"},
+	}
+	var f signupForm
+	if err := newDecoder().Decode(&f, form); err != nil {
+		t.Fatal(err)
+	}
+	if f.Name != null.StringFrom("Attapon") || f.Age != null.IntFrom(30) || f.Agree != null.BoolFrom(true) ||
+		f.Born.String != "2000-01-02" || f.Day != null.WeekdayFrom(time.Monday) || f.Rating.Int64 != 4 {
+		t.Errorf("Decode() = %+v", f)
+	}
+	if f.Nickname.Valid {
+		t.Error("empty nickname should be null")
+	}
+	if f.Score.Valid {
+		t.Error("zero score should be null in the zero package")
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	for key, value := range map[string]string{"age": "old", "agree": "maybe", "rating": "9"} {
+		var f signupForm
+		err := newDecoder().Decode(&f, url.Values{key: {value}})
+		var multi schema.MultiError
+		if !errors.As(err, &multi) {
+			t.Errorf("%s=%s: got %v, want a conversion error", key, value, err)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Package nullsonic checks that github.com/bytedance/sonic encodes and decodes the types
+// in the null and zero packages as encoding/json does.
+//
+// sonic has no API for registering encoders. Instead it calls the MarshalJSON and UnmarshalJSON
+// methods that every type in these packages implements with a value receiver, so fields,
+// pointers, slices, maps and interface values holding them are all encoded with their null semantics,
+// never as the fields of their embedded sql.Null structs. Check verifies this for a sonic.API,
+// such as in a test of the application that pins its sonic version and configuration:
+//
+//	func TestSonic(t *testing.T) {
+//		if err := nullsonic.Check(sonic.ConfigFastest); err != nil {
+//			t.Fatal(err)
+//		}
+//	}
+package nullsonic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/zero"
+	"github.com/bytedance/sonic"
+)
+
+// samples returns valid and null values of the types that Check covers.
+func samples() []any {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []any{
+		null.StringFrom(""), null.String{},
+		null.IntFrom(0), null.Int{},
+		null.FloatFrom(1.5), null.Float{},
+		null.BoolFrom(false), null.Bool{},
+		null.TimeFrom(at), null.Time{},
+		null.DateStringFrom("2024-01-02"), null.DateString{},
+		null.LanguageTagFrom("th"), null.CountryCodeFrom("TH"),
+		null.ColorFrom("#abc"), null.CharFrom('y'),
+		null.NumberFrom("12345678901234567890.10"), null.Number{},
+		null.TimeZoneFrom("UTC"), null.CSVListFrom([]string{"a", "b"}), null.CSVList{},
+		null.WeekdayFrom(time.Monday), null.MonthFrom(time.March),
+		null.UnixMilliFromTime(at), null.PercentFrom(50),
+		null.IntervalFrom(1, 2, time.Hour), null.Interval{},
+		null.JSONFrom([]byte(`{"a":[1,2]}`)), null.JSON{},
+		null.ObjectFrom(struct{ A int }{1}), null.Object[struct{ A int }]{},
+		zero.StringFrom(""), zero.IntFrom(3), zero.TimeFrom(at),
+		struct {
+			S null.String           `json:"s"`
+			P *null.Int             `json:"p"`
+			L []null.DateString     `json:"l"`
+			M map[string]null.Float `json:"m"`
+			I any                   `json:"i"`
+		}{S: null.StringFrom("x"), L: []null.DateString{{}}, M: map[string]null.Float{"f": {}}, I: null.Bool{}},
+	}
+}
+
+// Check encodes and decodes a valid and a null value of the types in the null and zero packages,
+// alone and inside a struct, with api and with encoding/json,
+// and returns an error describing the first difference.
+func Check(api sonic.API) error {
+	for _, v := range samples() {
+		want, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("nullsonic: encoding/json cannot encode %T: %w", v, err)
+		}
+		got, err := api.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("nullsonic: sonic cannot encode %T: %w", v, err)
+		}
+		if !bytes.Equal(got, want) {
+			return fmt.Errorf("nullsonic: sonic encodes %T as %s, want %s", v, got, want)
+		}
+
+		ptr := reflect.New(reflect.TypeOf(v))
+		if err := api.Unmarshal(want, ptr.Interface()); err != nil {
+			return fmt.Errorf("nullsonic: sonic cannot decode %s into %T: %w", want, v, err)
+		}
+		again, err := json.Marshal(ptr.Elem().Interface())
+		if err != nil {
+			return fmt.Errorf("nullsonic: encoding/json cannot encode %T: %w", v, err)
+		}
+		if !bytes.Equal(again, want) {
+			return fmt.Errorf("nullsonic: sonic decodes %s into %T as %s", want, v, again)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package nullsonic
+
+import (
+	"testing"
+
+	"github.com/bytedance/sonic"
+)
+
+func TestCheck(t *testing.T) {
+	configs := map[string]sonic.API{
+		"default": sonic.ConfigDefault,
+		"std":     sonic.ConfigStd,
+		"fastest": sonic.ConfigFastest,
+	}
+	for name, api := range configs {
+		if err := Check(api); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Package nulltest provides test assertions for the types in the null and zero packages.
+// Failure messages show values in their JSON form, such as "2024-01-01" or null.
+package nulltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/attapon-th/null"
+)
+
+// AssertEqual reports an error if got is not equal to want according to T's Equal method.
+func AssertEqual[T interface{ Equal(T) bool }](t testing.TB, want, got T) {
+	t.Helper()
+	if !got.Equal(want) {
+		t.Errorf("%T: want %s, got %s", got, format(want), format(got))
+	}
+}
+
+// AssertNull reports an error if v is not null.
+// Pass a pointer to the value, such as &user.Email.
+func AssertNull(t testing.TB, v null.Nuller) {
+	t.Helper()
+	if !v.IsNull() {
+		t.Errorf("%T: want null, got %s", v, format(v))
+	}
+}
+
+// RequireValid stops the test if v is null.
+// Pass a pointer to the value, such as &user.Email.
+func RequireValid(t testing.TB, v null.Nuller) {
+	t.Helper()
+	if v.IsNull() {
+		t.Fatalf("%T: want a valid value, got null", v)
+	}
+}
+
+// format returns v as JSON, falling back to %v if it cannot be marshaled.
+func format(v any) string {
+	if n, ok := v.(interface{ IsNull() bool }); ok && n.IsNull() {
+		return "null"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
@@ -0,0 +1,78 @@
+package nulltest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/attapon-th/null"
+	"github.com/attapon-th/null/zero"
+)
+
+// recorder captures failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	r.fatal = true
+}
+
+func TestAssertEqual(t *testing.T) {
+	r := &recorder{TB: t}
+	AssertEqual(r, null.StringFrom("a"), null.StringFrom("a"))
+	AssertEqual(r, null.TimeFrom(time.Unix(0, 0).UTC()), null.TimeFrom(time.Unix(0, 0)))
+	AssertEqual(r, zero.IntFrom(0), zero.NewInt(0, false))
+	if len(r.errors) != 0 {
+		t.Fatalf("unexpected failures: %v", r.errors)
+	}
+
+	AssertEqual(r, null.DateStringFrom("2024-01-01"), null.NewDateString("", false))
+	want := `null.DateString: want "2024-01-01", got null`
+	if len(r.errors) != 1 || r.errors[0] != want {
+		t.Errorf("failures = %q, want %q", r.errors, want)
+	}
+}
+
+func TestAssertNull(t *testing.T) {
+	r := &recorder{TB: t}
+	nullInt, blank := null.NewInt(0, false), zero.StringFrom("")
+	AssertNull(r, &nullInt)
+	AssertNull(r, &blank)
+	if len(r.errors) != 0 {
+		t.Fatalf("unexpected failures: %v", r.errors)
+	}
+
+	five := null.IntFrom(5)
+	AssertNull(r, &five)
+	want := "*null.Int: want null, got 5"
+	if len(r.errors) != 1 || r.errors[0] != want {
+		t.Errorf("failures = %q, want %q", r.errors, want)
+	}
+	if r.fatal {
+		t.Error("AssertNull should not stop the test")
+	}
+}
+
+func TestRequireValid(t *testing.T) {
+	r := &recorder{TB: t}
+	valid, invalid := null.BoolFrom(false), null.NewBool(false, false)
+	RequireValid(r, &valid)
+	if r.fatal {
+		t.Fatalf("unexpected failures: %v", r.errors)
+	}
+
+	RequireValid(r, &invalid)
+	if !r.fatal {
+		t.Error("RequireValid should stop the test for null")
+	}
+}
@@ -0,0 +1,22 @@
+package null
+
+var (
+	// NullTokens Set additional text tokens that UnmarshalText treats as null,
+	// such as "NULL", "N/A", "-" or `\N`. Tokens are matched exactly, defaults to none.
+	// Blank input and "null" are always treated as null.
+	//
+	// Deprecated: Use Configure with Options.NullTokens. It is ignored once Configure has been called.
+	NullTokens = []string{}
+)
+
+// prepareText applies whitespace trimming and null token matching to text input for the named type.
+// Input matching a null token is returned as blank.
+func prepareText(name string, text []byte) []byte {
+	text = trimText(name, text)
+	for _, token := range config().NullTokens {
+		if string(text) == token {
+			return text[:0]
+		}
+	}
+	return text
+}
@@ -0,0 +1,58 @@
+package null
+
+import (
+	"testing"
+)
+
+func TestNullTokens(t *testing.T) {
+	var i Int
+	if err := i.UnmarshalText([]byte("N/A")); err == nil {
+		t.Error("expected error for unregistered token")
+	}
+
+	defer configured.Store(nil)
+	tokens := []string{"NULL", "N/A", "-", `\N`}
+	Configure(Options{NullTokens: tokens})
+
+	for _, token := range tokens {
+		i = IntFrom(1)
+		err := i.UnmarshalText([]byte(token))
+		maybePanic(err)
+		assertNullInt(t, i, "null token "+token)
+
+		s := StringFrom("test")
+		err = s.UnmarshalText([]byte(token))
+		maybePanic(err)
+		assertNullStr(t, s, "null token "+token)
+
+		var ti Time
+		err = ti.UnmarshalText([]byte(token))
+		maybePanic(err)
+		assertNullTime(t, ti, "null token "+token)
+	}
+
+	var b Bool
+	err := b.UnmarshalText([]byte("-"))
+	maybePanic(err)
+	assertNullBool(t, b, "null token bool")
+
+	var p Percent
+	err = p.UnmarshalText([]byte(`\N`))
+	maybePanic(err)
+	if p.Valid {
+		t.Error("null token percent should be null")
+	}
+
+	// tokens are matched exactly
+	s := StringFrom("test")
+	err = s.UnmarshalText([]byte("null-ish"))
+	maybePanic(err)
+	if !s.Valid || s.String != "null-ish" {
+		t.Errorf("partial token match should not be null: %+v", s)
+	}
+
+	Configure(Options{NullTokens: tokens, TrimSpace: true})
+	err = s.UnmarshalText([]byte(" N/A "))
+	maybePanic(err)
+	assertNullStr(t, s, "trimmed null token")
+}
@@ -0,0 +1,238 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Number is a nullable json.Number. It keeps the exact text of numeric input,
+// such as "0.10" or "12345678901234567890.01", rather than rounding it to a float64,
+// so it suits amounts of unknown precision. It supports SQL and JSON serialization.
+// It will marshal to null if null.
+type Number struct {
+	Number json.Number
+	Valid  bool
+}
+
+// NewNumber creates a new Number.
+// The value is stored as-is and is not validated.
+func NewNumber(n json.Number, valid bool) Number {
+	return Number{
+		Number: n,
+		Valid:  valid,
+	}
+}
+
+// NumberFrom creates a new Number that will be null if n is not a valid JSON number.
+func NumberFrom(n json.Number) Number {
+	if !validNumber(string(n)) {
+		coerced("Number", "From", string(n), errInvalidNumber(string(n)))
+		return NewNumber("", false)
+	}
+	return NewNumber(n, true)
+}
+
+// NumberFromPtr creates a new Number that will be null if n is nil or not a valid JSON number.
+func NumberFromPtr(n *json.Number) Number {
+	if n == nil {
+		return NewNumber("", false)
+	}
+	return NumberFrom(*n)
+}
+
+// validNumber reports whether s is a JSON number literal, such as "-1.5e3".
+func validNumber(s string) bool {
+	if s == "" || s[0] == '"' || strings.TrimSpace(s) != s {
+		return false
+	}
+	var n json.Number
+	return json.Unmarshal([]byte(s), &n) == nil
+}
+
+func errInvalidNumber(s string) error {
+	return fmt.Errorf("null: invalid number %q", s)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n Number) ValueOrZero() json.Number {
+	if !n.Valid {
+		return ""
+	}
+	return n.Number
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports numeric, integer, float and text columns, keeping the text of numeric and text values.
+// It returns an error if a text value is not a number.
+func (n *Number) Scan(value any) error {
+	var str string
+	switch x := value.(type) {
+	case nil:
+		n.Number, n.Valid = "", false
+		return nil
+	case string:
+		str = x
+	case []byte:
+		str = string(x)
+	case int64:
+		str = strconv.FormatInt(x, 10)
+	case float64:
+		str = strconv.FormatFloat(x, 'g', -1, 64)
+	default:
+		n.Number, n.Valid = "", false
+		return newScanError("null.Number", value, fmt.Errorf("unsupported type %T", value))
+	}
+	if !validNumber(str) {
+		n.Number, n.Valid = "", false
+		return newScanError("null.Number", value, errInvalidNumber(str))
+	}
+	n.Number, n.Valid = json.Number(str), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the text of the number, which databases convert to the type of the column.
+func (n Number) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return string(n.Number), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, numeric string and null input.
+func (n *Number) UnmarshalJSON(data []byte) error {
+	data = trimJSON("Number", data)
+	if bytes.Equal(data, nullBytes) {
+		n.Number, n.Valid = "", false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Number); err != nil {
+		n.Number, n.Valid = "", false
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Number if the input is blank or "null".
+// It returns an error if the input is not a number.
+func (n *Number) UnmarshalText(text []byte) error {
+	text = prepareText("Number", text)
+	str := string(text)
+	if str == "" || str == "null" {
+		n.Number, n.Valid = "", false
+		return nil
+	}
+	if !validNumber(str) {
+		n.Number, n.Valid = "", false
+		return errInvalidNumber(str)
+	}
+	n.Number, n.Valid = json.Number(str), true
+	return nil
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (n *Number) UnmarshalParam(param string) error {
+	return n.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It encodes the number as it was received, or null if this Number is null.
+func (n Number) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Number", n.Valid, n.marshalJSON)
+}
+
+func (n Number) marshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	if !validNumber(string(n.Number)) {
+		return nil, errInvalidNumber(string(n.Number))
+	}
+	return []byte(n.Number), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Number is null.
+func (n Number) MarshalText() ([]byte, error) {
+	return n.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (n Number) AppendText(buf []byte) ([]byte, error) {
+	if !n.Valid {
+		return buf, nil
+	}
+	return append(buf, n.Number...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Number to v under key, or nothing if null.
+func (n Number) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, n.Valid, n.MarshalText)
+}
+
+// SetValid changes this Number's value and also sets it to be non-null.
+func (n *Number) SetValid(v json.Number) {
+	n.Number = v
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this Number's value, or a nil pointer if this Number is null.
+func (n Number) Ptr() *json.Number {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Number
+}
+
+// IsZero returns true for null Numbers, for potential future omitempty support.
+func (n Number) IsZero() bool {
+	return !n.Valid
+}
+
+// IsNull returns true if this Number is null.
+func (n Number) IsNull() bool {
+	return !n.Valid
+}
+
+// SetNull sets this Number to null and clears its value.
+func (n *Number) SetNull() {
+	n.Number, n.Valid = "", false
+}
+
+// Reset sets this Number to its zero value, which is null.
+func (n *Number) Reset() {
+	*n = Number{}
+}
+
+// Equal returns true if both Numbers have the same text or are both null.
+// Numbers with the same value but different text, such as "1.0" and "1", are not equal.
+func (n Number) Equal(other Number) bool {
+	return n.Valid == other.Valid && (!n.Valid || n.Number == other.Number)
+}
+
+// Merge returns this Number if it is valid, otherwise other.
+func (n Number) Merge(other Number) Number {
+	if n.Valid {
+		return n
+	}
+	return other
+}
+
+// When returns this Number if cond is true, otherwise a null Number.
+func (n Number) When(cond bool) Number {
+	if !cond {
+		n.SetNull()
+	}
+	return n
+}
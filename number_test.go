@@ -0,0 +1,102 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNumberUnmarshal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want json.Number
+	}{
+		{`0.10`, "0.10"},
+		{`12345678901234567890.000000000001`, "12345678901234567890.000000000001"},
+		{`-1.5e3`, "-1.5e3"},
+		{`"42.00"`, "42.00"},
+	}
+	for _, tc := range tests {
+		var n Number
+		err := json.Unmarshal([]byte(tc.in), &n)
+		maybePanic(err)
+		if n.Number != tc.want || !n.Valid {
+			t.Errorf("UnmarshalJSON(%s) = %+v, want %s", tc.in, n, tc.want)
+		}
+		data, err := json.Marshal(n)
+		maybePanic(err)
+		assertJSONEquals(t, data, string(tc.want), "Number round trip")
+	}
+
+	var n Number
+	err := json.Unmarshal(nullJSON, &n)
+	maybePanic(err)
+	if n.Valid {
+		t.Errorf("UnmarshalJSON(null) = %+v", n)
+	}
+	for _, in := range []string{`"abc"`, `""`, `true`, `{}`} {
+		if err := json.Unmarshal([]byte(in), &n); err == nil || n.Valid {
+			t.Errorf("UnmarshalJSON(%s): expected error, got %v %+v", in, err, n)
+		}
+	}
+}
+
+func TestNumberText(t *testing.T) {
+	var n Number
+	if err := n.UnmarshalText([]byte("3.140")); err != nil || n.Number != "3.140" || !n.Valid {
+		t.Errorf("UnmarshalText = %v %+v", err, n)
+	}
+	text, err := n.MarshalText()
+	maybePanic(err)
+	if string(text) != "3.140" {
+		t.Errorf("MarshalText = %q", text)
+	}
+	if err := n.UnmarshalText([]byte("")); err != nil || n.Valid {
+		t.Errorf("UnmarshalText(blank) = %v %+v", err, n)
+	}
+	if err := n.UnmarshalText([]byte("1,000")); err == nil || n.Valid {
+		t.Errorf("UnmarshalText(1,000): expected error, got %+v", n)
+	}
+}
+
+func TestNumberScanValue(t *testing.T) {
+	var n Number
+	err := n.Scan([]byte("99999999999999999999.99"))
+	maybePanic(err)
+	if n.Number != "99999999999999999999.99" || !n.Valid {
+		t.Errorf("Scan = %+v", n)
+	}
+	v, err := n.Value()
+	maybePanic(err)
+	if v != "99999999999999999999.99" {
+		t.Errorf("Value = %v", v)
+	}
+
+	err = n.Scan(int64(7))
+	maybePanic(err)
+	if n.Number != "7" {
+		t.Errorf("Scan(int64) = %+v", n)
+	}
+	err = n.Scan(nil)
+	maybePanic(err)
+	if n.Valid {
+		t.Errorf("Scan(nil) = %+v", n)
+	}
+	if err := n.Scan("twelve"); err == nil || n.Valid {
+		t.Errorf("Scan(twelve): expected error, got %+v", n)
+	}
+}
+
+func TestNumberFrom(t *testing.T) {
+	if n := NumberFrom("1.50"); !n.Valid || n.Number != "1.50" {
+		t.Errorf("NumberFrom = %+v", n)
+	}
+	if n := NumberFrom("1.5.0"); n.Valid {
+		t.Errorf("NumberFrom(1.5.0) = %+v, want null", n)
+	}
+	if NumberFrom("1.0").Equal(NumberFrom("1")) {
+		t.Error("Numbers with different text should not be equal")
+	}
+	data, err := json.Marshal(NewNumber("", false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null Number")
+}
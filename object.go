@@ -0,0 +1,169 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Object is a nullable embedded document, such as an optional address.
+// It marshals V as JSON, and is stored in SQL as JSON, such as a Postgres jsonb column.
+// It will marshal to null if null.
+type Object[T any] struct {
+	V     T
+	Valid bool
+}
+
+// NewObject creates a new Object.
+func NewObject[T any](v T, valid bool) Object[T] {
+	return Object[T]{
+		V:     v,
+		Valid: valid,
+	}
+}
+
+// ObjectFrom creates a new Object that will always be valid.
+func ObjectFrom[T any](v T) Object[T] {
+	return NewObject(v, true)
+}
+
+// ObjectFromPtr creates a new Object that will be null if v is nil.
+func ObjectFromPtr[T any](v *T) Object[T] {
+	if v == nil {
+		var zero T
+		return NewObject(zero, false)
+	}
+	return NewObject(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (o Object[T]) ValueOrZero() T {
+	if !o.Valid {
+		var zero T
+		return zero
+	}
+	return o.V
+}
+
+// Scan implements the sql.Scanner interface.
+// It decodes JSON string and []byte input with ScanJSON, and SQL NULL or JSON null produce a null Object.
+func (o *Object[T]) Scan(value any) error {
+	o.Valid = false
+	switch x := value.(type) {
+	case []byte:
+		if bytes.Equal(bytes.TrimSpace(x), nullBytes) {
+			value = nil
+		}
+	case string:
+		if strings.TrimSpace(x) == "null" {
+			value = nil
+		}
+	}
+	if err := ScanJSON(value, &o.V); err != nil {
+		return err
+	}
+	o.Valid = value != nil
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the JSON encoding of V as []byte, or nil if null.
+func (o Object[T]) Value() (driver.Value, error) {
+	if !o.Valid {
+		return nil, nil
+	}
+	return json.Marshal(o.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It decodes null to a null Object and any other input into V.
+func (o *Object[T]) UnmarshalJSON(data []byte) error {
+	data = trimJSON("Object", data)
+	var zero T
+	o.V, o.Valid = zero, false
+	if bytes.Equal(data, nullBytes) {
+		return nil
+	}
+	if err := json.Unmarshal(data, &o.V); err != nil {
+		o.V = zero
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	o.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Object is null.
+func (o Object[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Object", o.Valid, o.marshalJSON)
+}
+
+func (o Object[T]) marshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.V)
+}
+
+// SetValid changes this Object's value and also sets it to be non-null.
+func (o *Object[T]) SetValid(v T) {
+	o.V = v
+	o.Valid = true
+}
+
+// Ptr returns a pointer to this Object's value, or a nil pointer if this Object is null.
+func (o Object[T]) Ptr() *T {
+	if !o.Valid {
+		return nil
+	}
+	return &o.V
+}
+
+// IsZero returns true for null Objects, for potential future omitempty support.
+func (o Object[T]) IsZero() bool {
+	return !o.Valid
+}
+
+// IsNull returns true if this Object is null.
+func (o Object[T]) IsNull() bool {
+	return !o.Valid
+}
+
+// SetNull sets this Object to null and clears its value.
+func (o *Object[T]) SetNull() {
+	var zero T
+	o.V, o.Valid = zero, false
+}
+
+// Reset sets this Object to its zero value, which is null.
+func (o *Object[T]) Reset() {
+	*o = Object[T]{}
+}
+
+// patchTarget makes this Object valid and returns a pointer to its value,
+// so ApplyMergePatch merges into it.
+func (o *Object[T]) patchTarget() any {
+	if !o.Valid {
+		var zero T
+		o.V, o.Valid = zero, true
+	}
+	return &o.V
+}
+
+// Merge returns this Object if it is valid, otherwise other.
+func (o Object[T]) Merge(other Object[T]) Object[T] {
+	if o.Valid {
+		return o
+	}
+	return other
+}
+
+// When returns this Object if cond is true, otherwise a null Object.
+func (o Object[T]) When(cond bool) Object[T] {
+	if !cond {
+		o.SetNull()
+	}
+	return o
+}
@@ -0,0 +1,89 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type testAddress struct {
+	Street String `json:"street"`
+	City   String `json:"city"`
+}
+
+type testCustomer struct {
+	Name    String              `json:"name"`
+	Address Object[testAddress] `json:"address"`
+}
+
+func TestObjectJSON(t *testing.T) {
+	var c testCustomer
+	err := json.Unmarshal([]byte(`{"name": "A", "address": {"street": "Sukhumvit"}}`), &c)
+	maybePanic(err)
+	if !c.Address.Valid || c.Address.V.Street != StringFrom("Sukhumvit") || c.Address.V.City.Valid {
+		t.Errorf("unmarshal address = %+v", c.Address)
+	}
+	data, err := json.Marshal(c)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"name":"A","address":{"street":"Sukhumvit","city":null}}`, "customer with address")
+
+	err = json.Unmarshal([]byte(`{"address": null}`), &c)
+	maybePanic(err)
+	if c.Address.Valid || c.Address.V.Street.Valid {
+		t.Errorf("null address = %+v", c.Address)
+	}
+	data, err = json.Marshal(c.Address)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null address")
+
+	if err := json.Unmarshal([]byte(`{"address": 5}`), &c); !errors.Is(err, ErrInvalidJSON) {
+		t.Errorf("bad address: got %v, want ErrInvalidJSON", err)
+	}
+}
+
+func TestObjectSQL(t *testing.T) {
+	o := ObjectFrom(testAddress{City: StringFrom("Bangkok")})
+	v, err := o.Value()
+	maybePanic(err)
+	if string(v.([]byte)) != `{"street":null,"city":"Bangkok"}` {
+		t.Errorf("Value() = %s", v)
+	}
+
+	var scanned Object[testAddress]
+	maybePanic(scanned.Scan(v))
+	if !scanned.Valid || scanned.V != o.V {
+		t.Errorf("Scan() = %+v, want %+v", scanned, o)
+	}
+	for _, src := range []any{nil, []byte("null"), " null "} {
+		maybePanic(scanned.Scan(src))
+		if scanned.Valid || scanned.V.City.Valid {
+			t.Errorf("Scan(%v) = %+v, want null", src, scanned)
+		}
+	}
+
+	v, err = NewObject(testAddress{}, false).Value()
+	if v != nil || err != nil {
+		t.Errorf("null Value() = %v, %v", v, err)
+	}
+}
+
+func TestObjectPtr(t *testing.T) {
+	assertNullObject(t, ObjectFromPtr[testAddress](nil), "ObjectFromPtr(nil)")
+	a := testAddress{City: StringFrom("Bangkok")}
+	o := ObjectFromPtr(&a)
+	if p := o.Ptr(); p == nil || *p != a {
+		t.Errorf("Ptr() = %v, want %v", p, a)
+	}
+	o.SetNull()
+	assertNullObject(t, o, "SetNull")
+	if o.Ptr() != nil || o.ValueOrZero() != (testAddress{}) {
+		t.Error("null Object should have no value")
+	}
+}
+
+func assertNullObject(t *testing.T, o Object[testAddress], from string) {
+	t.Helper()
+	if o.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,228 @@
+package null
+
+import (
+	"log"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+// Options holds package-wide behavior. Set it with Configure, which is safe to call
+// while other goroutines are marshaling and unmarshaling.
+//
+// Until Configure is first called, the deprecated package-level variables such as FormatDate
+// are used instead. After that they are ignored, and Configure logs any of them that were changed.
+type Options struct {
+	// DateFormat is the layout of DateString, defaults to "2006-01-02".
+	DateFormat string
+	// WeekdayStyle is the marshal style of Weekday, defaults to StyleLong.
+	WeekdayStyle CalendarStyle
+	// MonthStyle is the marshal style of Month, defaults to StyleLong.
+	MonthStyle CalendarStyle
+
+	// NullTokens are additional text tokens that UnmarshalText treats as null, such as "NULL" or `\N`.
+	NullTokens []string
+	// TrimSpace trims surrounding whitespace from text and JSON string input before parsing.
+	TrimSpace bool
+	// TrimSpaceOf sets TrimSpace for individual types, keyed by type name (e.g. "DateString").
+	TrimSpaceOf map[string]bool
+	// EmptyStringAsNull unmarshals a blank JSON string ("") to null for
+	// String, DateString, Enum, Cron and Regexp.
+	EmptyStringAsNull bool
+
+	// AcceptLegacyJSON also accepts the object shape produced by marshaling a sql.NullXXX directly,
+	// or by the JSONObject mode, in UnmarshalJSON.
+	AcceptLegacyJSON bool
+	// JSONMarshalMode is the JSON shape produced by MarshalJSON, defaults to JSONPlain.
+	JSONMarshalMode JSONMode
+	// JSONMarshalModeOf sets JSONMarshalMode for individual types, keyed by type name.
+	JSONMarshalModeOf map[string]JSONMode
+
+	// StrictDates rejects parsed dates and times that break DateRule, such as a year of 0202,
+	// as if they were malformed: DateString input becomes null and Time input returns an error
+	// wrapping ErrInvalidDate. Scanned Time columns are not checked.
+	StrictDates bool
+	// DateRule is the rule applied by StrictDates, defaults to YearRange(1900, 2200).
+	DateRule DateRule
+
+	// EnumNullUnknown produces null for unknown Enum input instead of an error.
+	EnumNullUnknown bool
+	// PercentMin is the lowest accepted value of Percent, defaults to 0.
+	PercentMin float64
+	// PercentMax is the highest accepted value of Percent, which also represents 100%, defaults to 100.
+	PercentMax float64
+	// PercentNullOutOfRange produces null for out-of-range Percent input instead of an error.
+	PercentNullOutOfRange bool
+
+	// NormalizePhone is the normalizer used by Phone, defaults to NormalizePhoneE164.
+	NormalizePhone PhoneNormalizer
+	// ParseCron is the parser used by Cron, defaults to ParseCronSpec.
+	ParseCron CronParser
+	// LocalizeDate renders DateString.Humanize, defaults to LocalizeDateEnglish.
+	LocalizeDate DateLocalizer
+	// Translator renders ValidationError.Localize, defaults to CatalogTranslator(Messages).
+	Translator Translator
+
+	// Mask hides Secret values in String, GoString and LogValue, defaults to MaskKeepLast(4).
+	Mask Masker
+	// Cipher encrypts and decrypts Encrypted values in SQL. Encrypted cannot be stored or scanned without one.
+	Cipher Cipher
+	// RedactEncrypted marshals valid Encrypted values to JSON as "[REDACTED]" instead of the plaintext.
+	RedactEncrypted bool
+	// GzipThreshold is the length above which GzipBytes values are compressed, defaults to 1024.
+	// Set it negative to compress every value.
+	GzipThreshold int
+	// GzipMaxSize is the largest size GzipBytes.Scan decompresses values to, defaults to 64 MiB,
+	// so that a small compressed value cannot expand without bound. Set it negative for no limit.
+	GzipMaxSize int64
+	// CSVSeparator separates the items of a CSVList in SQL and text, defaults to ','.
+	CSVSeparator rune
+	// CSVEscape escapes separators and itself within CSVList items, defaults to '\\'.
+	CSVEscape rune
+
+	// Intern shares the storage of equal strings scanned into String and DateString,
+	// such as with NewInterner. Scanned strings are not interned if it is nil.
+	Intern Interner
+
+	// DisableBufferPool stops MarshalJSON and MarshalText from encoding into pooled buffers,
+	// so each call allocates its own scratch space.
+	DisableBufferPool bool
+}
+
+var configured atomic.Pointer[Options]
+
+// Configure atomically replaces the package-wide options.
+// Blank DateFormat, zero PercentMax, GzipThreshold, GzipMaxSize, CSVSeparator and CSVEscape,
+// and nil functions are set to their defaults.
+// To change a single option, modify the result of CurrentOptions and pass it back in.
+func Configure(opts Options) {
+	if opts.DateFormat == "" {
+		opts.DateFormat = "2006-01-02"
+	}
+	if opts.DateRule == nil {
+		opts.DateRule = defaultDateRule
+	}
+	if opts.PercentMax == 0 {
+		opts.PercentMax = 100
+	}
+	if opts.GzipThreshold == 0 {
+		opts.GzipThreshold = defaultGzipThreshold
+	}
+	if opts.GzipMaxSize == 0 {
+		opts.GzipMaxSize = defaultGzipMaxSize
+	}
+	if opts.CSVSeparator == 0 {
+		opts.CSVSeparator = defaultCSVSeparator
+	}
+	if opts.CSVEscape == 0 {
+		opts.CSVEscape = defaultCSVEscape
+	}
+	if opts.NormalizePhone == nil {
+		opts.NormalizePhone = NormalizePhoneE164
+	}
+	if opts.ParseCron == nil {
+		opts.ParseCron = ParseCronSpec
+	}
+	if opts.LocalizeDate == nil {
+		opts.LocalizeDate = LocalizeDateEnglish
+	}
+	if opts.Mask == nil {
+		opts.Mask = defaultMask
+	}
+	if opts.Translator == nil {
+		opts.Translator = defaultTranslator
+	}
+	opts = opts.clone()
+	if changed := changedGlobals(); len(changed) > 0 {
+		log.Printf("null: Configure ignores the deprecated variables %s; set them in Options instead", strings.Join(changed, ", "))
+	}
+	configured.Store(&opts)
+}
+
+// CurrentOptions returns a copy of the options in effect.
+func CurrentOptions() Options {
+	return config().clone()
+}
+
+// config returns the options in effect without copying its maps and slices, which must not be modified.
+func config() Options {
+	if opts := configured.Load(); opts != nil {
+		return *opts
+	}
+	return globalOptions()
+}
+
+// globalOptions returns the options set by the deprecated package-level variables.
+func globalOptions() Options {
+	return Options{
+		DateFormat:            FormatDate,
+		WeekdayStyle:          FormatWeekday,
+		MonthStyle:            FormatMonth,
+		NullTokens:            NullTokens,
+		TrimSpace:             TrimSpace,
+		TrimSpaceOf:           TrimSpaceOf,
+		EmptyStringAsNull:     EmptyStringAsNull,
+		AcceptLegacyJSON:      AcceptLegacyJSON,
+		JSONMarshalMode:       JSONMarshalMode,
+		JSONMarshalModeOf:     JSONMarshalModeOf,
+		DateRule:              defaultDateRule,
+		EnumNullUnknown:       EnumNullUnknown,
+		PercentMin:            PercentMin,
+		PercentMax:            PercentMax,
+		PercentNullOutOfRange: PercentNullOutOfRange,
+		NormalizePhone:        NormalizePhone,
+		ParseCron:             ParseCron,
+		LocalizeDate:          LocalizeDateEnglish,
+		Mask:                  defaultMask,
+		GzipThreshold:         defaultGzipThreshold,
+		GzipMaxSize:           defaultGzipMaxSize,
+		CSVSeparator:          defaultCSVSeparator,
+		CSVEscape:             defaultCSVEscape,
+		Translator:            defaultTranslator,
+	}
+}
+
+// initialGlobals holds the deprecated package-level variables as they were declared.
+var initialGlobals = globalOptions()
+
+// changedGlobals returns the names of the deprecated package-level variables
+// that differ from their declared values.
+func changedGlobals() []string {
+	now, was := globalOptions(), initialGlobals
+	var changed []string
+	for _, g := range []struct {
+		name string
+		same bool
+	}{
+		{"FormatDate", now.DateFormat == was.DateFormat},
+		{"FormatWeekday", now.WeekdayStyle == was.WeekdayStyle},
+		{"FormatMonth", now.MonthStyle == was.MonthStyle},
+		{"NullTokens", slices.Equal(now.NullTokens, was.NullTokens)},
+		{"TrimSpace", now.TrimSpace == was.TrimSpace},
+		{"TrimSpaceOf", len(now.TrimSpaceOf) == 0},
+		{"EmptyStringAsNull", now.EmptyStringAsNull == was.EmptyStringAsNull},
+		{"AcceptLegacyJSON", now.AcceptLegacyJSON == was.AcceptLegacyJSON},
+		{"JSONMarshalMode", now.JSONMarshalMode == was.JSONMarshalMode},
+		{"JSONMarshalModeOf", len(now.JSONMarshalModeOf) == 0},
+		{"EnumNullUnknown", now.EnumNullUnknown == was.EnumNullUnknown},
+		{"PercentMin", now.PercentMin == was.PercentMin},
+		{"PercentMax", now.PercentMax == was.PercentMax},
+		{"PercentNullOutOfRange", now.PercentNullOutOfRange == was.PercentNullOutOfRange},
+		{"NormalizePhone", reflect.ValueOf(now.NormalizePhone).Pointer() == reflect.ValueOf(was.NormalizePhone).Pointer()},
+		{"ParseCron", reflect.ValueOf(now.ParseCron).Pointer() == reflect.ValueOf(was.ParseCron).Pointer()},
+	} {
+		if !g.same {
+			changed = append(changed, g.name)
+		}
+	}
+	return changed
+}
+
+func (opts Options) clone() Options {
+	opts.NullTokens = slices.Clone(opts.NullTokens)
+	opts.TrimSpaceOf = maps.Clone(opts.TrimSpaceOf)
+	opts.JSONMarshalModeOf = maps.Clone(opts.JSONMarshalModeOf)
+	return opts
+}
@@ -0,0 +1,132 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestConfigure(t *testing.T) {
+	defer configured.Store(nil)
+
+	Configure(Options{
+		DateFormat:        "02/01/2006",
+		MonthStyle:        StyleNumber,
+		NullTokens:        []string{"N/A"},
+		EmptyStringAsNull: true,
+	})
+
+	ds := DateStringFrom("31/12/2024")
+	if !ds.Valid {
+		t.Error("DateString should use configured DateFormat")
+	}
+	if DateStringFrom("2024-12-31").Valid {
+		t.Error("DateString should ignore FormatDate after Configure")
+	}
+
+	data, err := json.Marshal(MonthFrom(3))
+	maybePanic(err)
+	assertJSONEquals(t, data, "3", "configured month style")
+
+	var i Int
+	err = i.UnmarshalText([]byte("N/A"))
+	maybePanic(err)
+	assertNullInt(t, i, "configured null token")
+
+	var s String
+	err = json.Unmarshal([]byte(`""`), &s)
+	maybePanic(err)
+	assertNullStr(t, s, "configured EmptyStringAsNull")
+
+	// defaults fill in zero values
+	opts := CurrentOptions()
+	if opts.PercentMax != 100 || opts.NormalizePhone == nil || opts.ParseCron == nil {
+		t.Errorf("defaults not applied: %+v", opts)
+	}
+	if !PercentFrom(100).Valid || PercentFrom(101).Valid {
+		t.Error("Percent should use default range")
+	}
+
+	// CurrentOptions returns a copy
+	opts.NullTokens[0] = "-"
+	if CurrentOptions().NullTokens[0] != "N/A" {
+		t.Error("modifying CurrentOptions should not change the configuration")
+	}
+
+	Configure(Options{})
+	if CurrentOptions().DateFormat != "2006-01-02" {
+		t.Errorf("bad default DateFormat: %q", CurrentOptions().DateFormat)
+	}
+}
+
+func TestConfigureIgnoresGlobals(t *testing.T) {
+	defer configured.Store(nil)
+	defer func(prev bool) { EmptyStringAsNull = prev }(EmptyStringAsNull)
+	EmptyStringAsNull = true
+
+	var s String
+	err := json.Unmarshal([]byte(`""`), &s)
+	maybePanic(err)
+	assertNullStr(t, s, "EmptyStringAsNull before Configure")
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+	Configure(Options{})
+	if !strings.Contains(buf.String(), "EmptyStringAsNull") {
+		t.Errorf("Configure should log the ignored variable, got %q", buf.String())
+	}
+
+	err = json.Unmarshal([]byte(`""`), &s)
+	maybePanic(err)
+	if !s.Valid {
+		t.Error("EmptyStringAsNull should be ignored after Configure")
+	}
+
+	buf.Reset()
+	EmptyStringAsNull = false
+	Configure(Options{})
+	if buf.Len() != 0 {
+		t.Errorf("unchanged variables should not be logged, got %q", buf.String())
+	}
+}
+
+func TestConfigureCopiesMaps(t *testing.T) {
+	defer configured.Store(nil)
+
+	trimOf := map[string]bool{"Int": true}
+	Configure(Options{TrimSpaceOf: trimOf})
+	trimOf["Int"] = false
+
+	var i Int
+	err := i.UnmarshalText([]byte(" 12345 "))
+	maybePanic(err)
+	assertInt(t, i, "configured TrimSpaceOf")
+}
+
+func TestConfigureConcurrent(t *testing.T) {
+	defer configured.Store(nil)
+
+	var wg sync.WaitGroup
+	for n := 0; n < 4; n++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				Configure(Options{TrimSpace: j%2 == 0})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				var ds DateString
+				_ = ds.UnmarshalText([]byte(" 2024-01-01 "))
+			}
+		}()
+	}
+	wg.Wait()
+}
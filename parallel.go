@@ -0,0 +1,67 @@
+package null
+
+import (
+	"context"
+	"encoding"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelChunk is the number of inputs a worker of ParseDatesParallel and ParseAllParallel
+// parses between checks of its context.
+const parallelChunk = 1024
+
+// ParseDatesParallel is like ParseDates, but shards the inputs across workers goroutines,
+// or GOMAXPROCS goroutines if workers is zero or less. dates and errs are in the order of ss.
+// If ctx is canceled, the workers stop and err is ctx.Err(); the inputs that were not reached
+// are left null without errors.
+func ParseDatesParallel(ctx context.Context, ss []string, workers int) (dates []DateString, errs []error, err error) {
+	return parseParallel(ctx, ss, workers, parseDateString)
+}
+
+// ParseAllParallel is like ParseAll, but shards the inputs across goroutines like ParseDatesParallel.
+func ParseAllParallel[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](ctx context.Context, ss []string, workers int) (values []T, errs []error, err error) {
+	return parseParallel(ctx, ss, workers, parseText[T, PT])
+}
+
+// parseParallel parses ss with parse on workers goroutines, which take chunks of ss in turn.
+func parseParallel[T any](ctx context.Context, ss []string, workers int, parse func(string) (T, error)) ([]T, []error, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	values := make([]T, len(ss))
+	var (
+		errs []error
+		mu   sync.Mutex
+		next atomic.Int64
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				lo := int(next.Add(parallelChunk)) - parallelChunk
+				if lo >= len(ss) {
+					return
+				}
+				hi := min(lo+parallelChunk, len(ss))
+				for i := lo; i < hi; i++ {
+					v, err := parse(ss[i])
+					values[i] = v
+					if err != nil {
+						mu.Lock()
+						errs = setIndexedError(errs, len(ss), i, err)
+						mu.Unlock()
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return values, errs, ctx.Err()
+}
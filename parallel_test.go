@@ -0,0 +1,51 @@
+package null
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestParseDatesParallel(t *testing.T) {
+	ss := make([]string, 5000)
+	for i := range ss {
+		ss[i] = fmt.Sprintf("2024-01-%02d", i%31+1)
+	}
+	ss[17] = "not a date"
+	ss[4321] = ""
+
+	want, wantErrs := ParseDates(ss)
+	for _, workers := range []int{0, 1, 3} {
+		dates, errs, err := ParseDatesParallel(context.Background(), ss, workers)
+		maybePanic(err)
+		if !slices.Equal(dates, want) {
+			t.Errorf("workers=%d: dates differ from ParseDates", workers)
+		}
+		if len(errs) != len(wantErrs) || !errors.Is(errs[17], ErrInvalidDate) || errs[4321] != nil || errs[0] != nil {
+			t.Errorf("workers=%d: errs = %v", workers, errs[17])
+		}
+	}
+
+	if dates, errs, err := ParseDatesParallel(context.Background(), nil, 4); len(dates) != 0 || errs != nil || err != nil {
+		t.Errorf("no inputs: %v %v %v", dates, errs, err)
+	}
+}
+
+func TestParseAllParallel(t *testing.T) {
+	ints, errs, err := ParseAllParallel[Int](context.Background(), []string{"1", "x", "3"}, 2)
+	maybePanic(err)
+	if want := []Int{IntFrom(1), {}, IntFrom(3)}; !slices.Equal(ints, want) {
+		t.Errorf("ParseAllParallel = %v, want %v", ints, want)
+	}
+	if errs == nil || errs[1] == nil {
+		t.Errorf("errs = %v", errs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := ParseAllParallel[Int](ctx, []string{"1"}, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled: err = %v", err)
+	}
+}
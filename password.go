@@ -0,0 +1,134 @@
+package null
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+// Password is a nullable write-only credential. It accepts input from JSON, text and SQL,
+// but always marshals to JSON null and is never revealed by String, GoString or LogValue.
+// Use Reveal to read it, such as to hash it. Its value is unexported so reflection-based
+// encoders cannot reach it either.
+type Password struct {
+	value string
+	valid bool
+}
+
+// PasswordFrom creates a new Password that will always be valid.
+func PasswordFrom(s string) Password {
+	return Password{value: s, valid: true}
+}
+
+// Reveal returns the password, and false if it is null.
+func (p Password) Reveal() (password string, ok bool) {
+	return p.value, p.valid
+}
+
+// String implements fmt.Stringer. It returns "[REDACTED]", or a blank string if null.
+func (p Password) String() string {
+	if !p.valid {
+		return ""
+	}
+	return redacted
+}
+
+// GoString implements fmt.GoStringer, so that %#v is redacted too.
+func (p Password) GoString() string {
+	return fmt.Sprintf("null.Password(%q)", p.String())
+}
+
+// LogValue implements slog.LogValuer. It logs the same text as String.
+func (p Password) LogValue() slog.Value {
+	return slog.StringValue(p.String())
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns a *ScanError if value cannot be converted.
+func (p *Password) Scan(value any) error {
+	var str sql.NullString
+	if err := str.Scan(value); err != nil {
+		*p = Password{}
+		return newScanError("null.Password", value, err)
+	}
+	p.value, p.valid = str.String, str.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Unlike MarshalJSON, it returns the password, so that it can be stored, usually after hashing.
+func (p Password) Value() (driver.Value, error) {
+	if !p.valid {
+		return nil, nil
+	}
+	return p.value, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (p *Password) UnmarshalJSON(data []byte) error {
+	data = trimJSON("Password", data)
+	if bytes.Equal(data, nullBytes) {
+		*p = Password{}
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	p.value, p.valid = str, true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Password if the input is blank.
+func (p *Password) UnmarshalText(text []byte) error {
+	p.value, p.valid = string(text), len(text) > 0
+	return nil
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (p *Password) UnmarshalParam(param string) error {
+	return p.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler. It always encodes null.
+func (p Password) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It always encodes a blank string.
+func (p Password) MarshalText() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// IsZero returns true for null Passwords, for potential future omitempty support.
+func (p Password) IsZero() bool {
+	return !p.valid
+}
+
+// IsNull returns true if this Password is null.
+func (p Password) IsNull() bool {
+	return !p.valid
+}
+
+// SetNull sets this Password to null and clears its value.
+func (p *Password) SetNull() {
+	*p = Password{}
+}
+
+// Reset sets this Password to its zero value, which is null.
+func (p *Password) Reset() {
+	*p = Password{}
+}
+
+// Equal returns true if both Passwords are the same or are both null.
+// The comparison takes constant time for passwords of the same length.
+func (p Password) Equal(other Password) bool {
+	return p.valid == other.valid && subtle.ConstantTimeCompare([]byte(p.value), []byte(other.value)) == 1
+}
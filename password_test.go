@@ -0,0 +1,58 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestPassword(t *testing.T) {
+	var req struct {
+		User     string   `json:"user"`
+		Password Password `json:"password"`
+	}
+	err := json.Unmarshal([]byte(`{"user":"somchai","password":"hunter2"}`), &req)
+	maybePanic(err)
+	if pw, ok := req.Password.Reveal(); !ok || pw != "hunter2" {
+		t.Errorf("Reveal = %q, %v", pw, ok)
+	}
+
+	data, err := json.Marshal(req)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"user":"somchai","password":null}`, "Password")
+	data, err = Marshal(req)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"user":"somchai","password":null}`, "Marshal")
+
+	for _, format := range []string{"%v", "%s", "%+v", "%#v"} {
+		if out := fmt.Sprintf(format, req); strings.Contains(out, "hunter2") {
+			t.Errorf("%s reveals the password: %s", format, out)
+		}
+	}
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("login", "password", req.Password)
+	if strings.Contains(buf.String(), "hunter2") || !strings.Contains(buf.String(), `"password":"[REDACTED]"`) {
+		t.Errorf("LogValue: %s", buf.String())
+	}
+
+	var p Password
+	err = p.Scan([]byte("$2a$10$hash"))
+	maybePanic(err)
+	if v, _ := p.Value(); v != "$2a$10$hash" {
+		t.Errorf("Value = %v", v)
+	}
+	if !p.Equal(PasswordFrom("$2a$10$hash")) || p.Equal(PasswordFrom("other")) {
+		t.Error("Equal")
+	}
+	err = p.UnmarshalText(nil)
+	maybePanic(err)
+	if !p.IsNull() || p.String() != "" {
+		t.Errorf("blank text should be null: %#v", p)
+	}
+	if !(Password{}).Equal(Password{}) {
+		t.Error("null Passwords should be equal")
+	}
+}
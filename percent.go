@@ -0,0 +1,173 @@
+package null
+
+import (
+	"fmt"
+)
+
+var (
+	// PercentMin Set the lowest accepted value of Percent, defaults to 0
+	//
+	// Deprecated: Use Configure with Options.PercentMin. It is ignored once Configure has been called.
+	PercentMin = 0.0
+	// PercentMax Set the highest accepted value of Percent, which also represents 100%, defaults to 100.
+	// Use 1 for fractional percentages.
+	//
+	// Deprecated: Use Configure with Options.PercentMax. It is ignored once Configure has been called.
+	PercentMax = 100.0
+	// PercentNullOutOfRange Set whether out-of-range Percent input produces null instead of an error
+	//
+	// Deprecated: Use Configure with Options.PercentNullOutOfRange. It is ignored once Configure has been called.
+	PercentNullOutOfRange = false
+)
+
+// FloatRangeError is returned when a Percent receives a value outside of its range.
+type FloatRangeError struct {
+	Value    float64
+	Min, Max float64
+}
+
+// Error implements the error interface.
+func (e *FloatRangeError) Error() string {
+	return fmt.Sprintf("null: value %v out of range [%v, %v]", e.Value, e.Min, e.Max)
+}
+
+// Percent is a nullable percentage constrained to [PercentMin, PercentMax].
+// It supports SQL and JSON serialization like Float.
+// Out-of-range input returns a *FloatRangeError, or produces null if PercentNullOutOfRange is set.
+// It will marshal to null if null.
+type Percent struct {
+	Float
+}
+
+// NewPercent creates a new Percent. The value is not range checked.
+func NewPercent(f float64, valid bool) Percent {
+	return Percent{Float: NewFloat(f, valid)}
+}
+
+// PercentFrom creates a new Percent that will be null if f is out of range.
+func PercentFrom(f float64) Percent {
+	if !percentInRange(f) {
+		coerced("Percent", "From", fmt.Sprint(f), percentRangeError(f))
+		return NewPercent(f, false)
+	}
+	return NewPercent(f, true)
+}
+
+// PercentFromPtr creates a new Percent that will be null if f is nil or out of range.
+func PercentFromPtr(f *float64) Percent {
+	if f == nil {
+		return NewPercent(0, false)
+	}
+	return PercentFrom(*f)
+}
+
+// PercentFromFraction creates a new Percent from a fraction, where 1 is 100%.
+// It will be null if the result is out of range.
+func PercentFromFraction(f float64) Percent {
+	return PercentFrom(f * config().PercentMax)
+}
+
+func percentInRange(f float64) bool {
+	opts := config()
+	return f >= opts.PercentMin && f <= opts.PercentMax
+}
+
+func percentRangeError(f float64) error {
+	opts := config()
+	return &FloatRangeError{Value: f, Min: opts.PercentMin, Max: opts.PercentMax}
+}
+
+// check applies the range rule after the embedded Float has been decoded by op.
+func (p *Percent) check(op string) error {
+	if !p.Valid || percentInRange(p.Float64) {
+		return nil
+	}
+	p.Valid = false
+	err := percentRangeError(p.Float64)
+	if config().PercentNullOutOfRange {
+		coerced("Percent", op, fmt.Sprint(p.Float64), err)
+		return nil
+	}
+	return err
+}
+
+// Fraction returns the value as a fraction of PercentMax, so 50 is 0.5 with the default range.
+// ok is false if this Percent is null.
+func (p Percent) Fraction() (f float64, ok bool) {
+	if !p.Valid {
+		return 0, false
+	}
+	return p.Float64 / config().PercentMax, true
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *Percent) Scan(value any) error {
+	if err := p.Float.Scan(value); err != nil {
+		return err
+	}
+	if err := p.check("Scan"); err != nil {
+		return newScanError("null.Percent", value, err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+func (p *Percent) UnmarshalJSON(data []byte) error {
+	data = trimJSON("Percent", data)
+	if err := p.Float.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	return p.check("UnmarshalJSON")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Percent if the input is blank or "null".
+func (p *Percent) UnmarshalText(text []byte) error {
+	text = prepareText("Percent", text)
+	if err := p.Float.UnmarshalText(text); err != nil {
+		return err
+	}
+	return p.check("UnmarshalText")
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (p *Percent) UnmarshalParam(param string) error {
+	return p.UnmarshalText([]byte(param))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (p *Percent) UnmarshalBinary(data []byte) error {
+	if err := p.Float.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	return p.check("UnmarshalBinary")
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Percent is null.
+func (p Percent) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Percent", p.Valid, p.Float.marshalJSON)
+}
+
+// Equal returns true if both percentages have the same value or are both null.
+func (p Percent) Equal(other Percent) bool {
+	return p.Float.Equal(other.Float)
+}
+
+// Merge returns this Percent if it is valid, otherwise other.
+func (p Percent) Merge(other Percent) Percent {
+	if p.Valid {
+		return p
+	}
+	return other
+}
+
+// When returns this Percent if cond is true, otherwise a null Percent.
+func (p Percent) When(cond bool) Percent {
+	if !cond {
+		p.SetNull()
+	}
+	return p
+}
@@ -0,0 +1,126 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestPercentFrom(t *testing.T) {
+	p := PercentFrom(42.5)
+	if !p.Valid || p.Float64 != 42.5 {
+		t.Errorf("bad PercentFrom(): %v %t", p.Float64, p.Valid)
+	}
+	if f, ok := p.Fraction(); !ok || f != 0.425 {
+		t.Errorf("bad Fraction(): %v %t", f, ok)
+	}
+
+	if PercentFrom(100.1).Valid || PercentFrom(-1).Valid {
+		t.Error("out of range PercentFrom() should be null")
+	}
+	if !PercentFromFraction(1).Equal(PercentFrom(100)) {
+		t.Error("PercentFromFraction(1) should be 100%")
+	}
+	if PercentFromPtr(nil).Valid {
+		t.Error("PercentFromPtr(nil) should be null")
+	}
+	if _, ok := PercentFromPtr(nil).Fraction(); ok {
+		t.Error("null Fraction() should not be ok")
+	}
+}
+
+func TestPercentRange(t *testing.T) {
+	defer configured.Store(nil)
+	Configure(Options{PercentMin: 0, PercentMax: 1})
+
+	var p Percent
+	err := json.Unmarshal([]byte(`0.25`), &p)
+	maybePanic(err)
+	if f, ok := p.Fraction(); !ok || f != 0.25 {
+		t.Errorf("bad Fraction(): %v %t", f, ok)
+	}
+
+	var over Percent
+	err = json.Unmarshal([]byte(`25`), &over)
+	var rangeErr *FloatRangeError
+	if !errors.As(err, &rangeErr) {
+		t.Fatalf("expected *FloatRangeError, got %T: %v", err, err)
+	}
+	if rangeErr.Value != 25 || rangeErr.Min != 0 || rangeErr.Max != 1 {
+		t.Errorf("bad FloatRangeError: %+v", rangeErr)
+	}
+	if over.Valid {
+		t.Error("out of range input should be null")
+	}
+}
+
+func TestUnmarshalPercent(t *testing.T) {
+	var p Percent
+	err := json.Unmarshal([]byte(`"75"`), &p)
+	maybePanic(err)
+	if !p.Equal(PercentFrom(75)) {
+		t.Errorf("bad unmarshaled percent: %v", p)
+	}
+
+	var null Percent
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should produce null percent")
+	}
+
+	var over Percent
+	err = over.UnmarshalText([]byte("150"))
+	if err == nil || over.Valid {
+		t.Error("expected error and null percent")
+	}
+
+	var badType Percent
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestPercentNullOutOfRange(t *testing.T) {
+	defer configured.Store(nil)
+	Configure(Options{PercentNullOutOfRange: true})
+
+	var p Percent
+	err := json.Unmarshal([]byte(`101`), &p)
+	maybePanic(err)
+	if p.Valid {
+		t.Error("out of range input should be null")
+	}
+
+	err = p.Scan(-5.0)
+	maybePanic(err)
+	if p.Valid {
+		t.Error("out of range scan should be null")
+	}
+}
+
+func TestMarshalPercent(t *testing.T) {
+	data, err := json.Marshal(PercentFrom(12.5))
+	maybePanic(err)
+	assertJSONEquals(t, data, "12.5", "percent json marshal")
+
+	data, err = json.Marshal(PercentFromPtr(nil))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null percent json marshal")
+}
+
+func TestPercentScanValue(t *testing.T) {
+	var p Percent
+	err := p.Scan(99.0)
+	maybePanic(err)
+	if v, err := p.Value(); v != 99.0 || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var over Percent
+	err = over.Scan("200")
+	if err == nil || over.Valid {
+		t.Error("expected error and null percent")
+	}
+}
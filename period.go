@@ -0,0 +1,167 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ValidityPeriod is the half-open range of dates [From, To) during which an
+// effective-dated row applies. A null From is unbounded in the past and a null To
+// is unbounded in the future, so the zero ValidityPeriod is always active.
+// A period whose To is not after its From is empty and never active.
+// It marshals to JSON as {"from": ..., "to": ...}, and to SQL as a Postgres daterange
+// such as "[2024-01-01,)".
+type ValidityPeriod struct {
+	From DateString `json:"from"`
+	To   DateString `json:"to"`
+}
+
+// NewValidityPeriod creates a new ValidityPeriod.
+func NewValidityPeriod(from, to DateString) ValidityPeriod {
+	return ValidityPeriod{From: from, To: to}
+}
+
+// ActiveAt returns true if date is on or after From and before To.
+// It returns false if date is null or not a date.
+func (p ValidityPeriod) ActiveAt(date DateString) bool {
+	t, ok := date.date()
+	if !ok {
+		return false
+	}
+	if from, ok := p.From.date(); ok && t.Before(from) {
+		return false
+	}
+	if to, ok := p.To.date(); ok && !t.Before(to) {
+		return false
+	}
+	return true
+}
+
+// IsEmpty returns true if From and To are both dates and To is not after From,
+// so that p is not active on any date.
+func (p ValidityPeriod) IsEmpty() bool {
+	from, ok := p.From.date()
+	if !ok {
+		return false
+	}
+	to, ok := p.To.date()
+	return ok && !from.Before(to)
+}
+
+// Overlaps returns true if p and other are both active on at least one date.
+func (p ValidityPeriod) Overlaps(other ValidityPeriod) bool {
+	if p.IsEmpty() || other.IsEmpty() {
+		return false
+	}
+	// each period must start before the other ends
+	return startsBefore(p.From, other.To) && startsBefore(other.From, p.To)
+}
+
+// startsBefore reports whether a period starting at from has a date before end.
+func startsBefore(from, end DateString) bool {
+	f, ok := from.date()
+	if !ok {
+		return true
+	}
+	e, ok := end.date()
+	if !ok {
+		return true
+	}
+	return f.Before(e)
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports Postgres daterange text, such as "[2024-01-01,2024-02-01)" or "[2024-01-01,)",
+// and nil, which produces the zero ValidityPeriod. The Postgres "empty" range produces an empty
+// period from and to 1970-01-01.
+func (p *ValidityPeriod) Scan(value any) error {
+	var s string
+	switch x := value.(type) {
+	case nil:
+		*p = ValidityPeriod{}
+		return nil
+	case string:
+		s = x
+	case []byte:
+		s = string(x)
+	default:
+		return newScanError("null.ValidityPeriod", value, nil)
+	}
+	if strings.EqualFold(strings.TrimSpace(s), "empty") {
+		epoch := NewDateString(time.Unix(0, 0).UTC().Format(config().DateFormat), true)
+		p.From, p.To = epoch, epoch
+		return nil
+	}
+	from, to, err := parseDateRange(s)
+	if err != nil {
+		return newScanError("null.ValidityPeriod", value, err)
+	}
+	p.From, p.To = from, to
+	return nil
+}
+
+// parseDateRange parses a Postgres daterange into half-open bounds.
+func parseDateRange(s string) (from, to DateString, err error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 3 || !strings.ContainsRune("[(", rune(s[0])) || !strings.ContainsRune("])", rune(s[len(s)-1])) {
+		return from, to, fmt.Errorf("null: invalid date range %q", s)
+	}
+	lower, upper, ok := strings.Cut(s[1:len(s)-1], ",")
+	if !ok {
+		return from, to, fmt.Errorf("null: invalid date range %q", s)
+	}
+	// exclusive lower and inclusive upper bounds move forward a day
+	if from, err = rangeBound(lower, s[0] == '('); err != nil {
+		return from, to, err
+	}
+	if to, err = rangeBound(upper, s[len(s)-1] == ']'); err != nil {
+		return from, to, err
+	}
+	return from, to, nil
+}
+
+func rangeBound(s string, nextDay bool) (DateString, error) {
+	s = strings.Trim(strings.TrimSpace(s), `"`)
+	if s == "" || s == "infinity" || s == "-infinity" {
+		return NewDateString("", false), nil
+	}
+	t, err := parseDate(s)
+	if err != nil {
+		return DateString{}, fmt.Errorf("%w: %w", ErrInvalidDate, err)
+	}
+	if nextDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return NewDateString(t.Format(config().DateFormat), true), nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns a Postgres daterange literal, with a blank bound for a null From or To,
+// or "empty" if p is empty.
+func (p ValidityPeriod) Value() (driver.Value, error) {
+	if p.IsEmpty() {
+		return "empty", nil
+	}
+	lower, err := rangeLiteral(p.From)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := rangeLiteral(p.To)
+	if err != nil {
+		return nil, err
+	}
+	return "[" + lower + "," + upper + ")", nil
+}
+
+func rangeLiteral(s DateString) (string, error) {
+	if !s.Valid {
+		return "", nil
+	}
+	t, err := parseDate(s.String)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDate, err)
+	}
+	return t.Format(time.DateOnly), nil
+}
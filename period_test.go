@@ -0,0 +1,127 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestValidityPeriodActiveAt(t *testing.T) {
+	p := NewValidityPeriod(DateStringFrom("2024-01-01"), DateStringFrom("2024-02-01"))
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2023-12-31", false},
+		{"2024-01-01", true},
+		{"2024-01-31", true},
+		{"2024-02-01", false},
+	}
+	for _, tc := range tests {
+		if got := p.ActiveAt(DateStringFrom(tc.date)); got != tc.want {
+			t.Errorf("ActiveAt(%s) = %v, want %v", tc.date, got, tc.want)
+		}
+	}
+	if p.ActiveAt(NewDateString("", false)) {
+		t.Error("ActiveAt(null) should be false")
+	}
+
+	open := NewValidityPeriod(DateStringFrom("2024-01-01"), NewDateString("", false))
+	if !open.ActiveAt(DateStringFrom("2999-01-01")) {
+		t.Error("open-ended period should be active in the future")
+	}
+	if !(ValidityPeriod{}).ActiveAt(DateStringFrom("1900-01-01")) {
+		t.Error("zero period should always be active")
+	}
+}
+
+func TestValidityPeriodOverlaps(t *testing.T) {
+	period := func(from, to string) ValidityPeriod {
+		return NewValidityPeriod(DateStringFrom(from), DateStringFrom(to))
+	}
+	jan := period("2024-01-01", "2024-02-01")
+	tests := []struct {
+		name  string
+		other ValidityPeriod
+		want  bool
+	}{
+		{"adjacent", period("2024-02-01", "2024-03-01"), false},
+		{"before", period("2023-01-01", "2024-01-01"), false},
+		{"inside", period("2024-01-10", "2024-01-11"), true},
+		{"straddling", period("2023-12-15", "2024-01-02"), true},
+		{"open-ended", NewValidityPeriod(DateStringFrom("2024-01-31"), NewDateString("", false)), true},
+		{"unbounded", ValidityPeriod{}, true},
+		{"empty", period("2024-01-15", "2024-01-15"), false},
+	}
+	for _, tc := range tests {
+		if got := jan.Overlaps(tc.other); got != tc.want {
+			t.Errorf("%s: Overlaps = %v, want %v", tc.name, got, tc.want)
+		}
+		if got := tc.other.Overlaps(jan); got != tc.want {
+			t.Errorf("%s: reversed Overlaps = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestValidityPeriodJSON(t *testing.T) {
+	p := NewValidityPeriod(DateStringFrom("2024-01-01"), NewDateString("", false))
+	data, err := json.Marshal(p)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"from":"2024-01-01","to":null}`, "ValidityPeriod")
+
+	var got ValidityPeriod
+	err = json.Unmarshal(data, &got)
+	maybePanic(err)
+	if got != p {
+		t.Errorf("round trip: got %+v, want %+v", got, p)
+	}
+}
+
+func TestValidityPeriodSQL(t *testing.T) {
+	tests := []struct {
+		in       string
+		from, to string
+		value    string
+	}{
+		{"[2024-01-01,2024-02-01)", "2024-01-01", "2024-02-01", "[2024-01-01,2024-02-01)"},
+		{"[2024-01-01,)", "2024-01-01", "", "[2024-01-01,)"},
+		{"(,2024-02-01)", "", "2024-02-01", "[,2024-02-01)"},
+		{"(2023-12-31,2024-01-31]", "2024-01-01", "2024-02-01", "[2024-01-01,2024-02-01)"},
+		{"empty", "1970-01-01", "1970-01-01", "empty"},
+		{"[2024-01-01,2024-01-01)", "2024-01-01", "2024-01-01", "empty"},
+	}
+	for _, tc := range tests {
+		var p ValidityPeriod
+		err := p.Scan(tc.in)
+		maybePanic(err)
+		if p.From.ValueOrZero() != tc.from || p.To.ValueOrZero() != tc.to {
+			t.Errorf("Scan(%q) = %+v", tc.in, p)
+		}
+		v, err := p.Value()
+		maybePanic(err)
+		if v != tc.value {
+			t.Errorf("Value(%q) = %v, want %s", tc.in, v, tc.value)
+		}
+	}
+
+	var empty ValidityPeriod
+	err := empty.Scan([]byte("empty"))
+	maybePanic(err)
+	if !empty.IsEmpty() || empty.ActiveAt(DateStringFrom("1970-01-01")) {
+		t.Errorf("Scan(empty) = %+v, want an empty period", empty)
+	}
+
+	var p ValidityPeriod
+	err = p.Scan([]byte("[2024-01-01,2024-02-01)"))
+	maybePanic(err)
+	err = p.Scan(nil)
+	maybePanic(err)
+	if p != (ValidityPeriod{}) {
+		t.Errorf("Scan(nil) = %+v", p)
+	}
+	for _, bad := range []any{"2024-01-01", "[soon,)", 42} {
+		if err := p.Scan(bad); !errors.Is(err, ErrScanType) {
+			t.Errorf("Scan(%v): expected ErrScanType, got %v", bad, err)
+		}
+	}
+}
@@ -0,0 +1,271 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// PhoneNormalizer converts raw phone number input to E.164 form (e.g. "+66812345678").
+// It returns false if the input cannot be normalized.
+type PhoneNormalizer func(raw string) (string, bool)
+
+var (
+	// NormalizePhone Set the normalizer used by Phone, defaults to NormalizePhoneE164
+	//
+	// Deprecated: Use Configure with Options.NormalizePhone. It is ignored once Configure has been called.
+	NormalizePhone PhoneNormalizer = NormalizePhoneE164
+)
+
+// NormalizePhoneE164 is the default PhoneNormalizer.
+// It strips common separators (spaces, dashes, dots and parentheses) and accepts
+// numbers written with a leading "+" or "00" international prefix.
+// National numbers without a country code cannot be normalized.
+func NormalizePhoneE164(raw string) (string, bool) {
+	var b strings.Builder
+	b.Grow(len(raw))
+	for i, r := range strings.TrimSpace(raw) {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return "", false
+		}
+	}
+	s := b.String()
+	if strings.HasPrefix(s, "00") {
+		s = "+" + s[2:]
+	}
+	if !isE164(s) {
+		return "", false
+	}
+	return s, true
+}
+
+func isE164(s string) bool {
+	// + followed by 8 to 15 digits, no leading zero
+	if len(s) < 9 || len(s) > 16 || s[0] != '+' || s[1] == '0' {
+		return false
+	}
+	for _, r := range s[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Phone is a nullable phone number. It supports SQL and JSON serialization.
+// String holds the E.164 form when the input could be normalized, otherwise the raw input.
+// Raw holds the input as it was received.
+// It will marshal to null if null. Blank input produces a null Phone.
+type Phone struct {
+	sql.NullString
+	Raw string
+}
+
+// NewPhone creates a new Phone. The value is stored as-is and is not normalized.
+func NewPhone(s string, valid bool) Phone {
+	return Phone{
+		NullString: sql.NullString{
+			String: s,
+			Valid:  valid,
+		},
+		Raw: s,
+	}
+}
+
+// PhoneFrom creates a new Phone from raw input, normalized with NormalizePhone.
+// It will be null if s is blank.
+func PhoneFrom(s string) Phone {
+	var p Phone
+	p.set(s)
+	return p
+}
+
+// PhoneFromPtr creates a new Phone that will be null if s is nil or blank.
+func PhoneFromPtr(s *string) Phone {
+	if s == nil {
+		return NewPhone("", false)
+	}
+	return PhoneFrom(*s)
+}
+
+func (p *Phone) set(raw string) {
+	p.Raw = raw
+	if strings.TrimSpace(raw) == "" {
+		p.String = ""
+		p.Valid = false
+		return
+	}
+	p.Valid = true
+	if n, ok := config().NormalizePhone(raw); ok {
+		p.String = n
+		return
+	}
+	p.String = strings.TrimSpace(raw)
+}
+
+// E164 returns the number in E.164 form, and false if it is null or could not be normalized.
+func (p Phone) E164() (string, bool) {
+	if !p.Valid || !isE164(p.String) {
+		return "", false
+	}
+	return p.String, true
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (p Phone) ValueOrZero() string {
+	if !p.Valid {
+		return ""
+	}
+	return p.String
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *Phone) Scan(value any) error {
+	if err := p.NullString.Scan(value); err != nil {
+		p.Valid = false
+		return newScanError("null.Phone", value, err)
+	}
+	if !p.Valid {
+		p.Raw = ""
+		return nil
+	}
+	p.set(p.String)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input. Blank string input produces a null Phone.
+func (p *Phone) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "String")
+	data = trimJSON("Phone", data)
+	if bytes.Equal(data, nullBytes) {
+		p.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	p.set(str)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Phone if the input is blank or "null".
+func (p *Phone) UnmarshalText(text []byte) error {
+	text = prepareText("Phone", text)
+	str := string(text)
+	if str == "null" {
+		str = ""
+	}
+	p.set(str)
+	return nil
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (p *Phone) UnmarshalParam(param string) error {
+	return p.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Phone is null.
+func (p Phone) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Phone", p.Valid, p.marshalJSON)
+}
+
+func (p Phone) marshalJSON() ([]byte, error) {
+	if !p.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(p.String)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Phone is null.
+func (p Phone) MarshalText() ([]byte, error) {
+	if !p.Valid {
+		return []byte{}, nil
+	}
+	return []byte(p.String), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (p Phone) AppendText(buf []byte) ([]byte, error) {
+	if !p.Valid {
+		return buf, nil
+	}
+	return append(buf, p.String...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Phone to v under key, or nothing if null.
+func (p Phone) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, p.Valid, p.MarshalText)
+}
+
+// SetValid changes this Phone's value, normalized with NormalizePhone, and also sets it to be non-null.
+func (p *Phone) SetValid(v string) {
+	p.set(v)
+	p.Valid = true
+}
+
+// Ptr returns a pointer to this Phone's value, or a nil pointer if this Phone is null.
+func (p Phone) Ptr() *string {
+	if !p.Valid {
+		return nil
+	}
+	return &p.String
+}
+
+// IsZero returns true for null phone numbers, for potential future omitempty support.
+func (p Phone) IsZero() bool {
+	return !p.Valid
+}
+
+// IsNull returns true if this Phone is null.
+func (p Phone) IsNull() bool {
+	return !p.Valid
+}
+
+// SetNull sets this Phone to null and clears its value.
+func (p *Phone) SetNull() {
+	p.String, p.Raw, p.Valid = "", "", false
+}
+
+// Reset sets this Phone to its zero value, which is null.
+func (p *Phone) Reset() {
+	*p = Phone{}
+}
+
+// Equal returns true if both phone numbers have the same normalized value or are both null.
+func (p Phone) Equal(other Phone) bool {
+	return p.Valid == other.Valid && (!p.Valid || p.String == other.String)
+}
+
+// Merge returns this Phone if it is valid, otherwise other.
+func (p Phone) Merge(other Phone) Phone {
+	if p.Valid {
+		return p
+	}
+	return other
+}
+
+// When returns this Phone if cond is true, otherwise a null Phone.
+func (p Phone) When(cond bool) Phone {
+	if !cond {
+		p.SetNull()
+	}
+	return p
+}
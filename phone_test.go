@@ -0,0 +1,144 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNormalizePhoneE164(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"+66 81 234 5678", "+66812345678", true},
+		{"0066-81-234-5678", "+66812345678", true},
+		{"+1 (415) 555.2671", "+14155552671", true},
+		{"081 234 5678", "", false},
+		{"+0123456789", "", false},
+		{"+1 415 ext 1", "", false},
+		{"12+34", "", false},
+	}
+	for _, tc := range tests {
+		got, ok := NormalizePhoneE164(tc.in)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("NormalizePhoneE164(%q) = %q, %t; want %q, %t", tc.in, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestPhoneFrom(t *testing.T) {
+	p := PhoneFrom("+66 81 234 5678")
+	assertPhone(t, p, "+66812345678", "PhoneFrom()")
+	if p.Raw != "+66 81 234 5678" {
+		t.Errorf("raw input not kept: %q", p.Raw)
+	}
+	if e, ok := p.E164(); !ok || e != "+66812345678" {
+		t.Errorf("unexpected E164(): %q %t", e, ok)
+	}
+
+	national := PhoneFrom(" 081 234 5678 ")
+	assertPhone(t, national, "081 234 5678", "PhoneFrom() national")
+	if _, ok := national.E164(); ok {
+		t.Error("national number should not be E.164")
+	}
+
+	blank := PhoneFrom("")
+	assertNullPhone(t, blank, "PhoneFrom() blank")
+
+	null := PhoneFromPtr(nil)
+	assertNullPhone(t, null, "PhoneFromPtr(nil)")
+}
+
+func TestPhoneCustomNormalizer(t *testing.T) {
+	defer configured.Store(nil)
+	Configure(Options{NormalizePhone: func(raw string) (string, bool) {
+		if strings.HasPrefix(raw, "0") {
+			return NormalizePhoneE164("+66" + raw[1:])
+		}
+		return NormalizePhoneE164(raw)
+	}})
+
+	p := PhoneFrom("081-234-5678")
+	assertPhone(t, p, "+66812345678", "PhoneFrom() custom normalizer")
+}
+
+func TestUnmarshalPhone(t *testing.T) {
+	var p Phone
+	err := json.Unmarshal([]byte(`"+1 415 555 2671"`), &p)
+	maybePanic(err)
+	assertPhone(t, p, "+14155552671", "phone json")
+
+	var blank Phone
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullPhone(t, blank, "blank json")
+
+	var null Phone
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullPhone(t, null, "null json")
+
+	var badType Phone
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullPhone(t, badType, "wrong type json")
+
+	var text Phone
+	err = text.UnmarshalText([]byte("00441632960961"))
+	maybePanic(err)
+	assertPhone(t, text, "+441632960961", "UnmarshalText()")
+}
+
+func TestMarshalPhone(t *testing.T) {
+	p := PhoneFrom("+66 81 234 5678")
+	data, err := json.Marshal(p)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"+66812345678"`, "non-empty json marshal")
+	data, err = p.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "+66812345678", "non-empty text marshal")
+
+	null := PhoneFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestPhoneScanValue(t *testing.T) {
+	var p Phone
+	err := p.Scan("+66-81-234-5678")
+	maybePanic(err)
+	assertPhone(t, p, "+66812345678", "scanned phone")
+	if v, err := p.Value(); v != "+66812345678" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null Phone
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullPhone(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+}
+
+func assertPhone(t *testing.T, p Phone, want string, from string) {
+	t.Helper()
+	if p.String != want {
+		t.Errorf("bad %s phone: %s ≠ %s\n", from, p.String, want)
+	}
+	if !p.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullPhone(t *testing.T, p Phone, from string) {
+	t.Helper()
+	if p.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
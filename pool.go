@@ -0,0 +1,98 @@
+package null
+
+import (
+	"encoding/json"
+	"sync"
+	"unicode/utf8"
+)
+
+// maxPooledBuffer is the largest buffer capacity returned to bufferPool,
+// so an occasional huge value doesn't pin its memory.
+const maxPooledBuffer = 64 << 10
+
+var bufferPool = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, 64)
+		return &buf
+	},
+}
+
+// marshalPooled returns the bytes appended by fn to an empty buffer.
+// The scratch buffer comes from bufferPool and its contents are copied out,
+// so a call costs a single allocation and the caller owns the result.
+func marshalPooled(fn func(buf []byte) ([]byte, error)) ([]byte, error) {
+	if config().DisableBufferPool {
+		return fn(make([]byte, 0, 32))
+	}
+	bp := bufferPool.Get().(*[]byte)
+	buf, err := fn((*bp)[:0])
+	var out []byte
+	if err == nil {
+		out = make([]byte, len(buf))
+		copy(out, buf)
+	}
+	if cap(buf) <= maxPooledBuffer {
+		*bp = buf[:0]
+		bufferPool.Put(bp)
+	}
+	return out, err
+}
+
+// marshalJSONString returns s as a JSON string, using a pooled buffer.
+func marshalJSONString(s string) ([]byte, error) {
+	return marshalPooled(func(buf []byte) ([]byte, error) {
+		return appendJSONString(buf, s), nil
+	})
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString appends s to buf as a JSON string, escaped exactly like encoding/json:
+// HTML characters, U+2028 and U+2029 are escaped, and invalid UTF-8 is left to encoding/json.
+func appendJSONString(buf []byte, s string) []byte {
+	if !utf8.ValidString(s) {
+		data, _ := json.Marshal(s)
+		return append(buf, data...)
+	}
+	buf = append(buf, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+			buf = append(buf, s[start:i]...)
+			switch b {
+			case '\\', '"':
+				buf = append(buf, '\\', b)
+			case '\b':
+				buf = append(buf, '\\', 'b')
+			case '\f':
+				buf = append(buf, '\\', 'f')
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			default:
+				buf = append(buf, '\\', 'u', '0', '0', hexDigits[b>>4], hexDigits[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == '\u2028' || r == '\u2029' {
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	buf = append(buf, s[start:]...)
+	return append(buf, '"')
+}
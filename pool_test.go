@@ -0,0 +1,62 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAppendJSONString(t *testing.T) {
+	for _, s := range []string{
+		"", "hello", `quote " and \ backslash`, "tab\tnewline\nreturn\r",
+		"\b\f\x00\x1f\x7f", "<script>&amp;</script>", "line para ",
+		"ภาษาไทย", "bad \xff utf-8 \xe0\x80", "emoji 😀",
+	} {
+		want, _ := json.Marshal(s)
+		if got := appendJSONString(nil, s); string(got) != string(want) {
+			t.Errorf("appendJSONString(%q) = %s, want %s", s, got, want)
+		}
+	}
+}
+
+func TestMarshalPooledAllocs(t *testing.T) {
+	s := StringFrom("hello <world>")
+	i := IntFrom(1234567)
+	f := FloatFrom(1.2345)
+	tm := TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 6, time.FixedZone("", 7*60*60)))
+	for name, fn := range map[string]func(){
+		"String.MarshalJSON": func() { s.MarshalJSON() },
+		"Int.MarshalJSON":    func() { i.MarshalJSON() },
+		"Int.MarshalText":    func() { i.MarshalText() },
+		"Float.MarshalJSON":  func() { f.MarshalJSON() },
+		"Time.MarshalJSON":   func() { tm.MarshalJSON() },
+		"Time.MarshalText":   func() { tm.MarshalText() },
+	} {
+		if allocs := testing.AllocsPerRun(100, fn); allocs > 1 {
+			t.Errorf("%s allocated %v times, want 1", name, allocs)
+		}
+	}
+}
+
+func TestDisableBufferPool(t *testing.T) {
+	defer configured.Store(nil)
+	Configure(Options{DisableBufferPool: true})
+
+	data, err := StringFrom("test").MarshalJSON()
+	maybePanic(err)
+	assertJSONEquals(t, data, `"test"`, "String with DisableBufferPool")
+	data, err = IntFrom(12345).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "Int text with DisableBufferPool")
+	data, err = TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)).MarshalJSON()
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2024-01-02T03:04:05Z"`, "Time with DisableBufferPool")
+}
+
+func TestMarshalPooledOwnership(t *testing.T) {
+	a, _ := StringFrom("first").MarshalJSON()
+	b, _ := StringFrom("second").MarshalJSON()
+	if string(a) != `"first"` || string(b) != `"second"` {
+		t.Errorf("pooled results share memory: %s %s", a, b)
+	}
+}
@@ -0,0 +1,129 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+)
+
+// Predicate is a SQL condition with ? placeholders, such as "col = ?".
+// It implements the Sqlizer interface of query builders such as squirrel,
+// so it can be passed to their Where methods.
+type Predicate struct {
+	SQL  string
+	Args []any
+	err  error
+}
+
+// ToSql returns the condition and its arguments.
+func (p Predicate) ToSql() (string, []any, error) {
+	return p.SQL, p.Args, p.err
+}
+
+// Eq returns "col = ?" comparing col to v's value, or "col IS NULL" if v is null.
+// Use it instead of "col = ?", which never matches a null value.
+func Eq(col string, v driver.Valuer) Predicate {
+	return compare(col, v, "=", "IS NULL")
+}
+
+// NotEq returns "col <> ?" comparing col to v's value, or "col IS NOT NULL" if v is null.
+func NotEq(col string, v driver.Valuer) Predicate {
+	return compare(col, v, "<>", "IS NOT NULL")
+}
+
+func compare(col string, v driver.Valuer, op, nullOp string) Predicate {
+	val, err := v.Value()
+	if err != nil {
+		return Predicate{err: fmt.Errorf("null: value of %s: %w", col, err)}
+	}
+	if val == nil {
+		return Predicate{SQL: col + " " + nullOp}
+	}
+	return Predicate{SQL: col + " " + op + " ?", Args: []any{val}}
+}
+
+// SetMap returns the fields of the struct v, or of the struct v points to, as a map from
+// column name to value, skipping fields that are null or nil pointers to nullable types. The map can be passed to
+// the SetMap method of update builders such as squirrel's, so null fields are left unchanged.
+//
+// Column names are taken from the db struct tag, or the field name if there is none, and
+// fields tagged `db:"-"` are skipped. Fields implementing driver.Valuer are stored as their value.
+func SetMap(v any) (map[string]any, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("null: SetMap of nil %T", v)
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: SetMap of non-struct %T", v)
+	}
+	m := make(map[string]any)
+	if err := setMapFields(m, rv); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func setMapFields(m map[string]any, rv reflect.Value) error {
+	for _, f := range dbFields(rv.Type()) {
+		fv := rv.FieldByIndex(f.index)
+		if n, ok := fv.Interface().(interface{ IsNull() bool }); ok && (isNilPointer(fv) || n.IsNull()) {
+			continue
+		}
+		if valuer, ok := fv.Interface().(driver.Valuer); ok {
+			val, err := valuer.Value()
+			if err != nil {
+				return fmt.Errorf("null: value of %s: %w", f.name, err)
+			}
+			m[f.name] = val
+			continue
+		}
+		m[f.name] = fv.Interface()
+	}
+	return nil
+}
+
+// isNilPointer reports whether fv is a nil pointer, such as a nil *String field,
+// whose value methods cannot be called.
+func isNilPointer(fv reflect.Value) bool {
+	return fv.Kind() == reflect.Pointer && fv.IsNil()
+}
+
+var valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+
+// Args returns the fields of the struct v, or of the struct v points to, as query arguments
+// in declared order, for use with INSERT statements and COPY. Fields are included or skipped
+// like the columns of SetMap, but null fields are kept so every row has the same shape;
+// package types pass their own Value methods to the driver.
+// It panics if v is not a struct or a pointer to one.
+func Args(v any) []any {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("null: Args of non-struct %T", v))
+	}
+	fields := dbFields(rv.Type())
+	args := make([]any, len(fields))
+	for i, f := range fields {
+		args[i] = rv.FieldByIndex(f.index).Interface()
+	}
+	return args
+}
+
+// BulkArgs returns the Args of each element of slice, which holds structs or pointers to structs.
+// It panics if slice is not a slice of them.
+func BulkArgs(slice any) [][]any {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		panic(fmt.Sprintf("null: BulkArgs of non-slice %T", slice))
+	}
+	rows := make([][]any, rv.Len())
+	for i := range rows {
+		rows[i] = Args(rv.Index(i).Interface())
+	}
+	return rows
+}
@@ -0,0 +1,121 @@
+package null
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEq(t *testing.T) {
+	tests := []struct {
+		p    Predicate
+		sql  string
+		args []any
+	}{
+		{Eq("name", StringFrom("a")), "name = ?", []any{"a"}},
+		{Eq("name", NewString("", false)), "name IS NULL", nil},
+		{NotEq("age", IntFrom(5)), "age <> ?", []any{int64(5)}},
+		{NotEq("age", NewInt(0, false)), "age IS NOT NULL", nil},
+	}
+	for _, tc := range tests {
+		sql, args, err := tc.p.ToSql()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sql != tc.sql || !reflect.DeepEqual(args, tc.args) {
+			t.Errorf("ToSql() = %q, %v; want %q, %v", sql, args, tc.sql, tc.args)
+		}
+	}
+
+	if _, _, err := Eq("x", failingValuer{}).ToSql(); err == nil {
+		t.Error("Eq should report the Value error")
+	}
+}
+
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) { return nil, errors.New("boom") }
+
+func TestSetMap(t *testing.T) {
+	type audit struct {
+		UpdatedAt Time `db:"updated_at"`
+	}
+	type user struct {
+		audit
+		ID       int64  `db:"id"`
+		Name     String `db:"name"`
+		Nickname String `db:"nickname"`
+		Age      Int
+		Secret   string `db:"-"`
+		internal string
+	}
+	at := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	m, err := SetMap(&user{
+		audit:    audit{UpdatedAt: TimeFrom(at)},
+		ID:       1,
+		Name:     StringFrom("Attapon"),
+		Nickname: NewString("", false),
+		Age:      IntFrom(30),
+		Secret:   "x",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{"updated_at": at, "id": int64(1), "name": "Attapon", "Age": int64(30)}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("SetMap() = %v, want %v", m, want)
+	}
+
+	if _, err := SetMap(42); err == nil {
+		t.Error("SetMap of a non-struct should fail")
+	}
+}
+
+func TestSetMapPointer(t *testing.T) {
+	type user struct {
+		Name     *String `db:"name"`
+		Nickname *String `db:"nickname"`
+		Email    *String `db:"email"`
+	}
+	name, email := StringFrom("Attapon"), NewString("", false)
+	m, err := SetMap(user{Name: &name, Email: &email})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{"name": "Attapon"}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("SetMap() = %v, want %v", m, want)
+	}
+}
+
+func TestArgs(t *testing.T) {
+	type item struct {
+		ID    int64  `db:"id"`
+		Name  String `db:"name"`
+		Price Float
+		Note  string `db:"-"`
+	}
+	items := []item{
+		{ID: 1, Name: StringFrom("a"), Price: FloatFrom(1.5)},
+		{ID: 2, Note: "skipped"},
+	}
+	want := [][]any{
+		{int64(1), StringFrom("a"), FloatFrom(1.5)},
+		{int64(2), String{}, Float{}},
+	}
+	if got := BulkArgs(items); !reflect.DeepEqual(got, want) {
+		t.Errorf("BulkArgs() = %v, want %v", got, want)
+	}
+	if got := Args(&items[0]); !reflect.DeepEqual(got, want[0]) {
+		t.Errorf("Args() = %v, want %v", got, want[0])
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Args of a non-struct should panic")
+		}
+	}()
+	Args(5)
+}
@@ -0,0 +1,104 @@
+package null
+
+import "time"
+
+// Unit is the calendar unit of a Recurrence.
+type Unit int
+
+// Units of a Recurrence.
+const (
+	UnitDay Unit = iota
+	UnitWeek
+	UnitMonth
+	UnitYear
+)
+
+// Recurrence is a schedule of dates starting at Start and repeating every Every Units,
+// such as a monthly subscription renewal.
+// Monthly and yearly dates that do not exist in a shorter month are moved back to its last day,
+// so a recurrence starting on January 31 falls on February 29 or 28, then March 31.
+type Recurrence struct {
+	Start DateString
+	Every int
+	Unit  Unit
+}
+
+// start returns the start date, and false if Start is null or not a date or Every is not positive.
+func (r Recurrence) start() (time.Time, bool) {
+	if r.Every <= 0 {
+		return time.Time{}, false
+	}
+	return r.Start.date()
+}
+
+// occurrence returns the kth date of the schedule, where the 0th is start.
+func (r Recurrence) occurrence(start time.Time, k int) time.Time {
+	n := k * r.Every
+	switch r.Unit {
+	case UnitWeek:
+		return start.AddDate(0, 0, 7*n)
+	case UnitMonth:
+		return addMonthsClamped(start, n)
+	case UnitYear:
+		return addMonthsClamped(start, 12*n)
+	default:
+		return start.AddDate(0, 0, n)
+	}
+}
+
+// addMonthsClamped adds n months to t, moving the day back to the end of the month if it overflows.
+func addMonthsClamped(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), last)-1)
+}
+
+// Next returns the first date of the schedule after the date of after,
+// or Start if after is null or before Start.
+// It returns a null DateString if Start is null or not a date, or Every is not positive.
+func (r Recurrence) Next(after DateString) DateString {
+	start, ok := r.start()
+	if !ok {
+		return NewDateString("", false)
+	}
+	k := 0
+	if a, ok := after.date(); ok && !a.Before(start) {
+		// jump close to after, then step forward
+		k = max(0, r.periodsBefore(start, a)-1)
+		for !r.occurrence(start, k).After(a) {
+			k++
+		}
+	}
+	return NewDateString(r.occurrence(start, k).Format(config().DateFormat), true)
+}
+
+// periodsBefore returns about how many steps of the schedule fit from start to t, at most one too many.
+func (r Recurrence) periodsBefore(start, t time.Time) int {
+	switch r.Unit {
+	case UnitWeek:
+		return daysBetween(start, t) / (7 * r.Every)
+	case UnitMonth, UnitYear:
+		months := (t.Year()-start.Year())*12 + int(t.Month()-start.Month())
+		if r.Unit == UnitYear {
+			return months / (12 * r.Every)
+		}
+		return months / r.Every
+	default:
+		return daysBetween(start, t) / r.Every
+	}
+}
+
+// Occurrences returns the first n dates of the schedule, starting with Start.
+// It returns nil if Start is null or not a date, or Every is not positive.
+func (r Recurrence) Occurrences(n int) []DateString {
+	start, ok := r.start()
+	if !ok || n <= 0 {
+		return nil
+	}
+	layout := config().DateFormat
+	dates := make([]DateString, n)
+	for k := range dates {
+		dates[k] = NewDateString(r.occurrence(start, k).Format(layout), true)
+	}
+	return dates
+}
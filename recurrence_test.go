@@ -0,0 +1,81 @@
+package null
+
+import (
+	"slices"
+	"testing"
+)
+
+func dateStrings(dates []DateString) []string {
+	strs := make([]string, len(dates))
+	for i, d := range dates {
+		strs[i] = d.String
+	}
+	return strs
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	tests := []struct {
+		r    Recurrence
+		want []string
+	}{
+		{Recurrence{DateStringFrom("2024-01-30"), 2, UnitDay}, []string{"2024-01-30", "2024-02-01", "2024-02-03"}},
+		{Recurrence{DateStringFrom("2024-02-26"), 1, UnitWeek}, []string{"2024-02-26", "2024-03-04", "2024-03-11"}},
+		{Recurrence{DateStringFrom("2024-01-31"), 1, UnitMonth}, []string{"2024-01-31", "2024-02-29", "2024-03-31"}},
+		{Recurrence{DateStringFrom("2023-11-30"), 3, UnitMonth}, []string{"2023-11-30", "2024-02-29", "2024-05-30"}},
+		{Recurrence{DateStringFrom("2024-02-29"), 1, UnitYear}, []string{"2024-02-29", "2025-02-28", "2026-02-28"}},
+	}
+	for _, tc := range tests {
+		if got := dateStrings(tc.r.Occurrences(3)); !slices.Equal(got, tc.want) {
+			t.Errorf("%+v: got %v, want %v", tc.r, got, tc.want)
+		}
+	}
+
+	for _, r := range []Recurrence{
+		{NewDateString("", false), 1, UnitDay},
+		{DateStringFrom("2024-01-01"), 0, UnitDay},
+	} {
+		if got := r.Occurrences(3); got != nil {
+			t.Errorf("%+v: expected nil, got %v", r, got)
+		}
+		if got := r.Next(DateStringFrom("2024-01-01")); got.Valid {
+			t.Errorf("%+v: expected null, got %+v", r, got)
+		}
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	monthly := Recurrence{DateStringFrom("2024-01-31"), 1, UnitMonth}
+	tests := []struct {
+		r     Recurrence
+		after DateString
+		want  string
+	}{
+		{monthly, NewDateString("", false), "2024-01-31"},
+		{monthly, DateStringFrom("2023-06-01"), "2024-01-31"},
+		{monthly, DateStringFrom("2024-01-31"), "2024-02-29"},
+		{monthly, DateStringFrom("2024-03-15"), "2024-03-31"},
+		{monthly, DateStringFrom("2024-03-31"), "2024-04-30"},
+		{monthly, DateStringFrom("2030-12-31"), "2031-01-31"},
+		{Recurrence{DateStringFrom("2024-01-01"), 10, UnitDay}, DateStringFrom("2024-01-21"), "2024-01-31"},
+		{Recurrence{DateStringFrom("2024-01-01"), 2, UnitWeek}, DateStringFrom("2024-01-14"), "2024-01-15"},
+		{Recurrence{DateStringFrom("2020-02-29"), 1, UnitYear}, DateStringFrom("2023-03-01"), "2024-02-29"},
+		// more than the 292 years a time.Duration can hold
+		{Recurrence{DateStringFrom("1700-01-01"), 3, UnitDay}, DateStringFrom("2100-01-01"), "2100-01-04"},
+		{Recurrence{DateStringFrom("1700-01-01"), 2, UnitWeek}, DateStringFrom("2100-01-01"), "2100-01-08"},
+	}
+	for _, tc := range tests {
+		got := tc.r.Next(tc.after)
+		if !got.Valid || got.String != tc.want {
+			t.Errorf("%+v.Next(%s) = %+v, want %s", tc.r, tc.after.String, got, tc.want)
+		}
+	}
+}
+
+func TestRecurrencePeriodsBefore(t *testing.T) {
+	r := Recurrence{DateStringFrom("1700-01-01"), 1, UnitDay}
+	start, _ := r.start()
+	end, _ := DateStringFrom("2100-01-01").date()
+	if got, want := r.periodsBefore(start, end), 146097; got != want {
+		t.Errorf("periodsBefore over 400 years = %d, want %d", got, want)
+	}
+}
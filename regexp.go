@@ -0,0 +1,235 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// Regexp is a nullable compiled regular expression. It supports SQL and JSON serialization
+// using the pattern string.
+// It will marshal to null if null.
+type Regexp struct {
+	Regexp *regexp.Regexp
+	Valid  bool
+}
+
+// NewRegexp creates a new Regexp.
+func NewRegexp(re *regexp.Regexp, valid bool) Regexp {
+	return Regexp{
+		Regexp: re,
+		Valid:  valid && re != nil,
+	}
+}
+
+// RegexpFrom compiles pattern into a new Regexp.
+// It will be null if pattern does not compile.
+func RegexpFrom(pattern string) Regexp {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		coerced("Regexp", "From", pattern, err)
+		return NewRegexp(nil, false)
+	}
+	return NewRegexp(re, true)
+}
+
+// RegexpFromPtr creates a new Regexp that will be null if pattern is nil or does not compile.
+func RegexpFromPtr(pattern *string) Regexp {
+	if pattern == nil {
+		return NewRegexp(nil, false)
+	}
+	return RegexpFrom(*pattern)
+}
+
+// Pattern returns the source text of the regular expression, or a blank string if null.
+func (r Regexp) Pattern() string {
+	if !r.Valid {
+		return ""
+	}
+	return r.Regexp.String()
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (r Regexp) ValueOrZero() *regexp.Regexp {
+	if !r.Valid {
+		return nil
+	}
+	return r.Regexp
+}
+
+// MatchString reports whether s contains any match of the regular expression.
+// ok is false if this Regexp is null.
+func (r Regexp) MatchString(s string) (matched bool, ok bool) {
+	if !r.Valid {
+		return false, false
+	}
+	return r.Regexp.MatchString(s), true
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil input, and returns an error if the pattern does not compile.
+func (r *Regexp) Scan(value any) error {
+	var pattern string
+	switch x := value.(type) {
+	case nil:
+		r.Regexp, r.Valid = nil, false
+		return nil
+	case string:
+		pattern = x
+	case []byte:
+		pattern = string(x)
+	default:
+		r.Valid = false
+		return newScanError("null.Regexp", value, nil)
+	}
+	return r.compile(pattern)
+}
+
+// Value implements the driver Valuer interface.
+func (r Regexp) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return r.Regexp.String(), nil
+}
+
+func (r *Regexp) compile(pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		r.Regexp, r.Valid = nil, false
+		return fmt.Errorf("null: couldn't compile regexp: %w", err)
+	}
+	r.Regexp, r.Valid = re, true
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input, and returns an error if the pattern does not compile.
+func (r *Regexp) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "Regexp")
+	data = trimJSON("Regexp", data)
+	if bytes.Equal(data, nullBytes) {
+		r.Regexp, r.Valid = nil, false
+		return nil
+	}
+
+	var pattern string
+	if err := json.Unmarshal(data, &pattern); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	if pattern == "" && config().EmptyStringAsNull {
+		r.Regexp, r.Valid = nil, false
+		return nil
+	}
+	return unmarshalError(ErrInvalidJSON, r.compile(pattern))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Regexp if the input is blank or "null".
+func (r *Regexp) UnmarshalText(text []byte) error {
+	text = prepareText("Regexp", text)
+	str := string(text)
+	if str == "" || str == "null" {
+		r.Regexp, r.Valid = nil, false
+		return nil
+	}
+	return unmarshalError(ErrInvalidText, r.compile(str))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (r *Regexp) UnmarshalParam(param string) error {
+	return r.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Regexp is null.
+func (r Regexp) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Regexp", r.Valid, r.marshalJSON)
+}
+
+func (r Regexp) marshalJSON() ([]byte, error) {
+	if !r.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(r.Regexp.String())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Regexp is null.
+func (r Regexp) MarshalText() ([]byte, error) {
+	if !r.Valid {
+		return []byte{}, nil
+	}
+	return []byte(r.Regexp.String()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (r Regexp) AppendText(buf []byte) ([]byte, error) {
+	if !r.Valid {
+		return buf, nil
+	}
+	return append(buf, r.Regexp.String()...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Regexp to v under key, or nothing if null.
+func (r Regexp) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, r.Valid, r.MarshalText)
+}
+
+// SetValid changes this Regexp's value and also sets it to be non-null.
+func (r *Regexp) SetValid(v *regexp.Regexp) {
+	r.Regexp = v
+	r.Valid = v != nil
+}
+
+// Ptr returns this Regexp's value, or a nil pointer if this Regexp is null.
+func (r Regexp) Ptr() *regexp.Regexp {
+	return r.ValueOrZero()
+}
+
+// IsZero returns true for null Regexps, for potential future omitempty support.
+func (r Regexp) IsZero() bool {
+	return !r.Valid
+}
+
+// IsNull returns true if this Regexp is null.
+func (r Regexp) IsNull() bool {
+	return !r.Valid
+}
+
+// SetNull sets this Regexp to null and clears its value.
+func (r *Regexp) SetNull() {
+	r.Regexp, r.Valid = nil, false
+}
+
+// Reset sets this Regexp to its zero value, which is null.
+func (r *Regexp) Reset() {
+	*r = Regexp{}
+}
+
+// Equal returns true if both Regexps have the same pattern or are both null.
+func (r Regexp) Equal(other Regexp) bool {
+	return r.Valid == other.Valid && (!r.Valid || r.Regexp.String() == other.Regexp.String())
+}
+
+// Merge returns this Regexp if it is valid, otherwise other.
+func (r Regexp) Merge(other Regexp) Regexp {
+	if r.Valid {
+		return r
+	}
+	return other
+}
+
+// When returns this Regexp if cond is true, otherwise a null Regexp.
+func (r Regexp) When(cond bool) Regexp {
+	if !cond {
+		r.SetNull()
+	}
+	return r
+}
@@ -0,0 +1,154 @@
+package null
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+)
+
+var (
+	regexpPattern = `^[a-z]+\d*$`
+	regexpJSON    = []byte(`"^[a-z]+\\d*$"`)
+)
+
+func TestRegexpFrom(t *testing.T) {
+	re := RegexpFrom(regexpPattern)
+	assertRegexp(t, re, "RegexpFrom()")
+
+	bad := RegexpFrom(`(unclosed`)
+	assertNullRegexp(t, bad, "RegexpFrom() bad pattern")
+
+	ptr := RegexpFromPtr(&regexpPattern)
+	assertRegexp(t, ptr, "RegexpFromPtr()")
+
+	null := RegexpFromPtr(nil)
+	assertNullRegexp(t, null, "RegexpFromPtr(nil)")
+
+	nilRe := NewRegexp(nil, true)
+	assertNullRegexp(t, nilRe, "NewRegexp(nil, true)")
+}
+
+func TestRegexpMatchString(t *testing.T) {
+	re := RegexpFrom(regexpPattern)
+	if matched, ok := re.MatchString("abc123"); !matched || !ok {
+		t.Errorf("MatchString() = %t, %t; want true, true", matched, ok)
+	}
+	if matched, ok := re.MatchString("ABC"); matched || !ok {
+		t.Errorf("MatchString() = %t, %t; want false, true", matched, ok)
+	}
+
+	var null Regexp
+	if matched, ok := null.MatchString("abc"); matched || ok {
+		t.Errorf("null MatchString() = %t, %t; want false, false", matched, ok)
+	}
+}
+
+func TestUnmarshalRegexp(t *testing.T) {
+	var re Regexp
+	err := json.Unmarshal(regexpJSON, &re)
+	maybePanic(err)
+	assertRegexp(t, re, "regexp json")
+
+	var null Regexp
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullRegexp(t, null, "null json")
+
+	var bad Regexp
+	err = json.Unmarshal([]byte(`"a(b"`), &bad)
+	if err == nil {
+		t.Error("expected error for bad pattern")
+	}
+	assertNullRegexp(t, bad, "bad pattern json")
+
+	var badType Regexp
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error for wrong type")
+	}
+	assertNullRegexp(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalRegexp(t *testing.T) {
+	var re Regexp
+	err := re.UnmarshalText([]byte(regexpPattern))
+	maybePanic(err)
+	assertRegexp(t, re, "UnmarshalText()")
+
+	var blank Regexp
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullRegexp(t, blank, "UnmarshalText() blank")
+}
+
+func TestMarshalRegexp(t *testing.T) {
+	re := RegexpFrom(regexpPattern)
+	data, err := json.Marshal(re)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(regexpJSON), "non-empty json marshal")
+	data, err = re.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, regexpPattern, "non-empty text marshal")
+
+	var null Regexp
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestRegexpScanValue(t *testing.T) {
+	var re Regexp
+	err := re.Scan([]byte(regexpPattern))
+	maybePanic(err)
+	assertRegexp(t, re, "scanned regexp")
+	if v, err := re.Value(); v != regexpPattern || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null Regexp
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullRegexp(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong Regexp
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestRegexpEqual(t *testing.T) {
+	if !RegexpFrom("a+").Equal(NewRegexp(regexp.MustCompile("a+"), true)) {
+		t.Error("same pattern should be equal")
+	}
+	if RegexpFrom("a+").Equal(RegexpFrom("a*")) {
+		t.Error("different patterns should not be equal")
+	}
+	if !RegexpFromPtr(nil).Equal(Regexp{}) {
+		t.Error("nulls should be equal")
+	}
+}
+
+func assertRegexp(t *testing.T, re Regexp, from string) {
+	t.Helper()
+	if !re.Valid {
+		t.Error(from, "is invalid, but should be valid")
+		return
+	}
+	if re.Pattern() != regexpPattern {
+		t.Errorf("bad %s regexp: %s ≠ %s\n", from, re.Pattern(), regexpPattern)
+	}
+}
+
+func assertNullRegexp(t *testing.T, re Regexp, from string) {
+	t.Helper()
+	if re.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
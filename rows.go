@@ -0,0 +1,146 @@
+package null
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// dbField is a struct field and its column name from the db struct tag.
+type dbField struct {
+	index []int
+	name  string
+}
+
+var dbFieldCache sync.Map // map[reflect.Type][]dbField
+
+// dbFields returns the columns of struct type t in declared order, including the fields of
+// embedded structs. Names are taken from the db tag or the field name, and `db:"-"` is skipped.
+func dbFields(t reflect.Type) []dbField {
+	if fields, ok := dbFieldCache.Load(t); ok {
+		return fields.([]dbField)
+	}
+	fields := appendDBFields(nil, t, nil)
+	dbFieldCache.Store(t, fields)
+	return fields
+}
+
+func appendDBFields(fields []dbField, t reflect.Type, index []int) []dbField {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("db")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		idx := append(append([]int(nil), index...), i)
+
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct &&
+			!sf.Type.Implements(valuerType) && !reflect.PointerTo(sf.Type).Implements(scannerType) {
+			fields = appendDBFields(fields, sf.Type, idx)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, dbField{index: idx, name: name})
+	}
+	return fields
+}
+
+var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+// ScanRow scans the current row of rows into the struct dst points to, matching columns to
+// fields by the db struct tag like SetMap. Column names are matched case-insensitively if
+// there is no exact match, and a column without a matching field is an error.
+// Fields of package types become null for NULL columns.
+//
+// Call it after rows.Next, as with rows.Scan.
+func ScanRow(rows *sql.Rows, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("null: ScanRow destination must be a pointer to a struct, not %T", dst)
+	}
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	targets, err := scanTargets(rv.Elem(), cols)
+	if err != nil {
+		return err
+	}
+	return rows.Scan(targets...)
+}
+
+// ScanAll scans every remaining row of rows into the slice dst points to, which holds structs
+// or pointers to structs, as ScanRow does. It closes rows.
+func ScanAll(rows *sql.Rows, dst any) error {
+	defer rows.Close()
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("null: ScanAll destination must be a pointer to a slice, not %T", dst)
+	}
+	slice := rv.Elem()
+	elemType := slice.Type().Elem()
+	isPtr := elemType.Kind() == reflect.Pointer
+	if isPtr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return fmt.Errorf("null: ScanAll destination must hold structs, not %s", slice.Type().Elem())
+	}
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		elem := reflect.New(elemType)
+		targets, err := scanTargets(elem.Elem(), cols)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(targets...); err != nil {
+			return err
+		}
+		if isPtr {
+			slice.Set(reflect.Append(slice, elem))
+		} else {
+			slice.Set(reflect.Append(slice, elem.Elem()))
+		}
+	}
+	return rows.Err()
+}
+
+// scanTargets returns pointers to the fields of the struct rv matching cols, in order.
+func scanTargets(rv reflect.Value, cols []string) ([]any, error) {
+	fields := dbFields(rv.Type())
+	targets := make([]any, len(cols))
+	for i, col := range cols {
+		f, ok := findDBField(fields, col)
+		if !ok {
+			return nil, fmt.Errorf("null: no field for column %q in %s", col, rv.Type())
+		}
+		targets[i] = rv.FieldByIndex(f.index).Addr().Interface()
+	}
+	return targets, nil
+}
+
+func findDBField(fields []dbField, col string) (dbField, bool) {
+	for _, f := range fields {
+		if f.name == col {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, col) {
+			return f, true
+		}
+	}
+	return dbField{}, false
+}
@@ -0,0 +1,132 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+	"time"
+)
+
+// fakeDriver returns the rows in fakeResults for a query, keyed by the query text.
+type fakeDriver struct{}
+
+type fakeResult struct {
+	cols []string
+	rows [][]driver.Value
+}
+
+var fakeResults = map[string]fakeResult{
+	"users": {
+		cols: []string{"id", "name", "NICKNAME", "born", "updated_at"},
+		rows: [][]driver.Value{
+			{int64(1), "Attapon", nil, []byte("2000-01-02"), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+			{int64(2), nil, []byte("bee"), nil, nil},
+		},
+	},
+	"unknown": {cols: []string{"id", "extra"}, rows: [][]driver.Value{{int64(1), "x"}}},
+}
+
+func init() {
+	sql.Register("nullfake", fakeDriver{})
+}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt(query), nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+type fakeStmt string
+
+func (fakeStmt) Close() error                               { return nil }
+func (fakeStmt) NumInput() int                              { return 0 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) { return nil, driver.ErrSkip }
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	r := fakeResults[string(s)]
+	return &fakeRows{result: r}, nil
+}
+
+type fakeRows struct {
+	result fakeResult
+	next   int
+}
+
+func (r *fakeRows) Columns() []string { return r.result.cols }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.result.rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.next])
+	r.next++
+	return nil
+}
+
+type rowAudit struct {
+	UpdatedAt Time `db:"updated_at"`
+}
+
+type rowUser struct {
+	rowAudit
+	ID       int64      `db:"id"`
+	Name     String     `db:"name"`
+	Nickname String     `db:"nickname"`
+	Born     DateString `db:"born"`
+	Ignored  string     `db:"-"`
+}
+
+func openFake(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("nullfake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestScanRow(t *testing.T) {
+	db := openFake(t)
+	rows, err := db.Query("users")
+	maybePanic(err)
+	defer rows.Close()
+
+	if !rows.Next() {
+		t.Fatal("no rows")
+	}
+	var u rowUser
+	maybePanic(ScanRow(rows, &u))
+	if u.ID != 1 || u.Name != StringFrom("Attapon") || u.Nickname.Valid ||
+		u.Born.String != "2000-01-02" || !u.UpdatedAt.Valid {
+		t.Errorf("ScanRow = %+v", u)
+	}
+
+	if err := ScanRow(rows, u); err == nil {
+		t.Error("ScanRow into a non-pointer should fail")
+	}
+}
+
+func TestScanAll(t *testing.T) {
+	db := openFake(t)
+	rows, err := db.Query("users")
+	maybePanic(err)
+	var users []*rowUser
+	maybePanic(ScanAll(rows, &users))
+	if len(users) != 2 {
+		t.Fatalf("ScanAll got %d users, want 2", len(users))
+	}
+	u := users[1]
+	if u.ID != 2 || u.Name.Valid || u.Nickname != StringFrom("bee") || u.Born.Valid || u.UpdatedAt.Valid {
+		t.Errorf("ScanAll second user = %+v", u)
+	}
+
+	rows, err = db.Query("unknown")
+	maybePanic(err)
+	var values []rowUser
+	if err := ScanAll(rows, &values); err == nil {
+		t.Error("ScanAll with an unknown column should fail")
+	}
+}
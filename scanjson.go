@@ -0,0 +1,45 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// ScanJSON decodes a JSON database value, such as a Postgres json or jsonb column, into dst,
+// which must be a non-nil pointer. It is meant to be called from custom Scan methods:
+//
+//	func (a *Address) Scan(src any) error {
+//		return null.ScanJSON(src, a)
+//	}
+//
+// dst is reset to its zero value first, so fields of package types whose keys are missing
+// or JSON null end up null rather than keeping earlier values. A SQL NULL source only resets dst.
+// It supports string, []byte and nil input.
+func ScanJSON(src any, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("null: ScanJSON destination must be a non-nil pointer, not %T", dst)
+	}
+	var data []byte
+	switch x := src.(type) {
+	case nil:
+	case []byte:
+		data = x
+	case string:
+		data = []byte(x)
+	default:
+		return newScanError(fmt.Sprintf("%T", dst), src, nil)
+	}
+
+	elem := rv.Elem()
+	elem.SetZero()
+	if data == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		elem.SetZero()
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	return nil
+}
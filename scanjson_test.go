@@ -0,0 +1,49 @@
+package null
+
+import (
+	"errors"
+	"testing"
+)
+
+type scanAddress struct {
+	Street String `json:"street"`
+	Zip    Int    `json:"zip"`
+	Since  DateString
+}
+
+func (a *scanAddress) Scan(src any) error {
+	return ScanJSON(src, a)
+}
+
+func TestScanJSON(t *testing.T) {
+	var a scanAddress
+	err := a.Scan([]byte(`{"street": "Sukhumvit", "zip": 10110, "Since": "2024-01-02"}`))
+	maybePanic(err)
+	if a.Street != StringFrom("Sukhumvit") || a.Zip != IntFrom(10110) || a.Since.String != "2024-01-02" {
+		t.Errorf("ScanJSON = %+v", a)
+	}
+
+	// missing and null keys reset earlier values
+	err = a.Scan(`{"street": null}`)
+	maybePanic(err)
+	assertNullStr(t, a.Street, "ScanJSON null street")
+	assertNullInt(t, a.Zip, "ScanJSON missing zip")
+
+	a.Street = StringFrom("x")
+	err = a.Scan(nil)
+	maybePanic(err)
+	assertNullStr(t, a.Street, "ScanJSON SQL NULL")
+}
+
+func TestScanJSONErrors(t *testing.T) {
+	var a scanAddress
+	if err := a.Scan([]byte(`{"zip": "abc"}`)); !errors.Is(err, ErrInvalidJSON) {
+		t.Errorf("bad JSON: got %v, want ErrInvalidJSON", err)
+	}
+	if err := a.Scan(42); !errors.Is(err, ErrScanType) {
+		t.Errorf("int source: got %v, want ErrScanType", err)
+	}
+	if err := ScanJSON([]byte(`{}`), a); err == nil {
+		t.Error("non-pointer destination should fail")
+	}
+}
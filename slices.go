@@ -0,0 +1,116 @@
+package null
+
+import (
+	"encoding"
+	"fmt"
+)
+
+// ValidValues returns the values of the valid elements of xs, in order, skipping nulls.
+// It returns a nil slice if there are no valid elements.
+func ValidValues[T any, N Nullable[T]](xs []N) []T {
+	values, _ := Partition[T](xs)
+	return values
+}
+
+// Partition splits xs into the values of its valid elements, in order, and the number of nulls.
+func Partition[T any, N Nullable[T]](xs []N) (values []T, nulls int) {
+	for _, x := range xs {
+		if x.IsZero() {
+			nulls++
+			continue
+		}
+		values = append(values, x.ValueOrZero())
+	}
+	return values, nulls
+}
+
+// Compact returns the valid elements of xs, in order, dropping nulls.
+// It returns a nil slice if there are no valid elements.
+func Compact[N interface{ IsZero() bool }](xs []N) []N {
+	var valid []N
+	for _, x := range xs {
+		if !x.IsZero() {
+			valid = append(valid, x)
+		}
+	}
+	return valid
+}
+
+// Filter returns the valid elements of xs whose values satisfy pred, in order.
+// Nulls are dropped without calling pred.
+func Filter[T any, N Nullable[T]](xs []N, pred func(T) bool) []N {
+	var kept []N
+	for _, x := range xs {
+		if !x.IsZero() && pred(x.ValueOrZero()) {
+			kept = append(kept, x)
+		}
+	}
+	return kept
+}
+
+// ParseDates parses each of ss like DateStringFrom, without stopping at the first failure,
+// such as to report every invalid cell of an imported CSV column.
+// Blank inputs produce null DateStrings. An input that is not a date produces a null DateString,
+// and an error wrapping ErrInvalidDate at its index in errs.
+// errs is nil if every input parsed; otherwise it has the length of ss, with nil for the inputs that parsed.
+func ParseDates(ss []string) (dates []DateString, errs []error) {
+	dates = make([]DateString, len(ss))
+	for i, s := range ss {
+		var err error
+		if dates[i], err = parseDateString(s); err != nil {
+			errs = setIndexedError(errs, len(ss), i, err)
+		}
+	}
+	return dates, errs
+}
+
+// parseDateString parses s for ParseDates.
+func parseDateString(s string) (DateString, error) {
+	if s == "" {
+		return DateString{}, nil
+	}
+	t, err := parseDate(s)
+	if err != nil {
+		return DateString{}, fmt.Errorf("%w: %w", ErrInvalidDate, err)
+	}
+	return NewDateString(t.Format(config().DateFormat), true), nil
+}
+
+// ParseAll parses each of ss with the UnmarshalText method of T, without stopping at the first failure.
+// An input that fails produces the zero (null) T and its error at the same index in errs.
+// errs is nil if every input parsed; otherwise it has the length of ss, with nil for the inputs that parsed.
+// Some types, such as DateString, produce null for invalid input instead of an error; use ParseDates for dates.
+func ParseAll[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](ss []string) (values []T, errs []error) {
+	values = make([]T, len(ss))
+	for i, s := range ss {
+		var err error
+		if values[i], err = parseText[T, PT](s); err != nil {
+			errs = setIndexedError(errs, len(ss), i, err)
+		}
+	}
+	return values, errs
+}
+
+// parseText parses s for ParseAll.
+func parseText[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](s string) (T, error) {
+	var v T
+	if err := PT(&v).UnmarshalText([]byte(s)); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
+
+func setIndexedError(errs []error, n, i int, err error) []error {
+	if errs == nil {
+		errs = make([]error, n)
+	}
+	errs[i] = err
+	return errs
+}
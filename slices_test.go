@@ -0,0 +1,82 @@
+package null
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestValidValues(t *testing.T) {
+	ints := []Int{IntFrom(1), NewInt(0, false), IntFrom(0), NewInt(5, false), IntFrom(3)}
+	if got, want := ValidValues(ints), []int64{1, 0, 3}; !slices.Equal(got, want) {
+		t.Errorf("ValidValues = %v, want %v", got, want)
+	}
+	if got := ValidValues([]String{NewString("", false)}); got != nil {
+		t.Errorf("ValidValues of nulls = %v, want nil", got)
+	}
+
+	values, nulls := Partition(ints)
+	if !slices.Equal(values, []int64{1, 0, 3}) || nulls != 2 {
+		t.Errorf("Partition = %v, %d", values, nulls)
+	}
+	dates, nulls := Partition([]DateString{DateStringFrom("2024-01-02"), DateStringFrom("bogus")})
+	if !slices.Equal(dates, []string{"2024-01-02"}) || nulls != 1 {
+		t.Errorf("Partition dates = %v, %d", dates, nulls)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	dates := []DateString{DateStringFrom("2024-01-02"), {}, DateStringFrom("2024-03-04"), {}}
+	got := Compact(dates)
+	if len(got) != 2 || got[0].String != "2024-01-02" || got[1].String != "2024-03-04" {
+		t.Errorf("Compact = %v", got)
+	}
+	if got := Compact([]Interval{{}}); got != nil {
+		t.Errorf("Compact of nulls = %v, want nil", got)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	ints := []Int{IntFrom(1), {}, IntFrom(4), IntFrom(6)}
+	even := Filter(ints, func(v int64) bool { return v%2 == 0 })
+	if len(even) != 2 || even[0].Int64 != 4 || even[1].Int64 != 6 {
+		t.Errorf("Filter = %v", even)
+	}
+
+	called := false
+	Filter([]String{{}}, func(string) bool { called = true; return true })
+	if called {
+		t.Error("Filter should not call pred for nulls")
+	}
+}
+
+func TestParseDates(t *testing.T) {
+	dates, errs := ParseDates([]string{"2024-01-02", "", "2024-13-01", "2024-03-04T10:00:00Z"})
+	want := []DateString{DateStringFrom("2024-01-02"), {}, {}, DateStringFrom("2024-03-04")}
+	if !slices.Equal(dates, want) {
+		t.Errorf("ParseDates = %v, want %v", dates, want)
+	}
+	if len(errs) != 4 || errs[0] != nil || errs[1] != nil || errs[3] != nil {
+		t.Fatalf("ParseDates errs = %v", errs)
+	}
+	if !errors.Is(errs[2], ErrInvalidDate) {
+		t.Errorf("errs[2] = %v, want ErrInvalidDate", errs[2])
+	}
+
+	if _, errs := ParseDates([]string{"2024-01-02"}); errs != nil {
+		t.Errorf("ParseDates of valid dates: errs = %v, want nil", errs)
+	}
+}
+
+func TestParseAll(t *testing.T) {
+	ints, errs := ParseAll[Int]([]string{"1", "x", "", "3"})
+	if want := []Int{IntFrom(1), {}, {}, IntFrom(3)}; !slices.Equal(ints, want) {
+		t.Errorf("ParseAll = %v, want %v", ints, want)
+	}
+	if len(errs) != 4 || errs[1] == nil || errs[0] != nil || errs[2] != nil || errs[3] != nil {
+		t.Errorf("ParseAll errs = %v", errs)
+	}
+	if _, errs := ParseAll[Color]([]string{"#fff", "red"}); errs == nil || errs[1] == nil {
+		t.Errorf("ParseAll colors errs = %v", errs)
+	}
+}
@@ -0,0 +1,32 @@
+package null
+
+import (
+	"fmt"
+	"time"
+)
+
+// DateRule validates a parsed date or time, returning an error to reject it.
+// Set it with Options.DateRule.
+type DateRule func(t time.Time) error
+
+// YearRange returns a DateRule that rejects dates outside the years min to max, inclusive.
+func YearRange(min, max int) DateRule {
+	return func(t time.Time) error {
+		if y := t.Year(); y < min || y > max {
+			return fmt.Errorf("null: year %d out of range [%d, %d]", y, min, max)
+		}
+		return nil
+	}
+}
+
+// defaultDateRule is the DateRule used by StrictDates unless another is set.
+var defaultDateRule = YearRange(1900, 2200)
+
+// checkDate applies Options.DateRule to t if Options.StrictDates is set.
+func checkDate(t time.Time) error {
+	opts := config()
+	if !opts.StrictDates {
+		return nil
+	}
+	return opts.DateRule(t)
+}
@@ -0,0 +1,60 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStrictDates(t *testing.T) {
+	// without StrictDates, typos such as year 0202 are accepted
+	if ds := DateStringFrom("0202-05-01"); !ds.Valid {
+		t.Errorf("lenient: expected valid, got %+v", ds)
+	}
+
+	defer configured.Store(nil)
+	Configure(Options{StrictDates: true})
+
+	for _, in := range []string{"0202-05-01", "2202-01-01", "1899-12-31T23:00:00Z", "2024-02-30"} {
+		if ds := DateStringFrom(in); ds.Valid {
+			t.Errorf("DateStringFrom(%q): expected null, got %+v", in, ds)
+		}
+	}
+	if ds := DateStringFrom("1900-01-01"); !ds.Valid {
+		t.Errorf("lower bound: expected valid, got %+v", ds)
+	}
+
+	var ds DateString
+	err := ds.Scan(time.Date(202, 5, 1, 0, 0, 0, 0, time.UTC))
+	maybePanic(err)
+	if ds.Valid {
+		t.Errorf("Scan: expected null, got %+v", ds)
+	}
+
+	var ti Time
+	err = json.Unmarshal([]byte(`"0202-05-01T00:00:00Z"`), &ti)
+	if !errors.Is(err, ErrInvalidDate) || ti.Valid {
+		t.Errorf("Time JSON: expected ErrInvalidDate, got %v %+v", err, ti)
+	}
+	err = ti.UnmarshalText([]byte("2300-01-01T00:00:00Z"))
+	if !errors.Is(err, ErrInvalidDate) || ti.Valid {
+		t.Errorf("Time text: expected ErrInvalidDate, got %v %+v", err, ti)
+	}
+
+	var v struct {
+		Birth Time `json:"birth" null:"format=02/01/2006"`
+	}
+	err = Unmarshal([]byte(`{"birth":"01/05/0202"}`), &v)
+	if !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("format tag: expected ErrInvalidDate, got %v", err)
+	}
+
+	Configure(Options{StrictDates: true, DateRule: YearRange(2000, 2099)})
+	if ds := DateStringFrom("1999-12-31"); ds.Valid {
+		t.Errorf("custom rule: expected null, got %+v", ds)
+	}
+	if ds := DateStringFrom("2000-01-01"); !ds.Valid {
+		t.Errorf("custom rule: expected valid, got %+v", ds)
+	}
+}
@@ -2,6 +2,15 @@
 // with convenient support for JSON and text marshaling.
 // Types in this package will always encode to their null value if null.
 // Use the zero subpackage if you want zero values and null to be treated the same.
+//
+// Building with the nullunsafe tag (go build -tags nullunsafe) converts between strings and
+// byte slices without copying where the result is only parsed, as in UnmarshalText, UnmarshalParam
+// and Scan of Int, Float, Bool and Time. Values that are kept, such as the text of String, are still copied.
+//
+// Building with the nulleasyjson tag adds MarshalEasyJSON and UnmarshalEasyJSON methods to the types
+// in this package and the zero package, so code generated by github.com/mailru/easyjson calls them directly.
+// Likewise, the nullmsgp tag adds the MessagePack methods used by code generated by github.com/tinylib/msgp
+// to String, Int, Float, Bool, Time and DateString and the types of the zero package.
 package null
 
 import (
@@ -9,11 +18,20 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // nullBytes is a JSON null literal
 var nullBytes = []byte("null")
 
+var (
+	// EmptyStringAsNull Set whether a blank JSON string ("") unmarshals to null for
+	// String, DateString, Enum, Cron and Regexp, defaults to false
+	//
+	// Deprecated: Use Configure with Options.EmptyStringAsNull. It is ignored once Configure has been called.
+	EmptyStringAsNull = false
+)
+
 // String is a nullable string. It supports SQL and JSON serialization.
 // It will marshal to null if null. Blank string input will be considered null.
 type String struct {
@@ -41,6 +59,16 @@ func (s String) ValueOrZero() string {
 	return s.String
 }
 
+// Scan implements the sql.Scanner interface.
+// It returns a *ScanError if value cannot be converted.
+func (s *String) Scan(value any) error {
+	if err := scanInterned(&s.NullString, value); err != nil {
+		s.Valid = false
+		return newScanError("null.String", value, err)
+	}
+	return nil
+}
+
 // NewString creates a new String
 func NewString(s string, valid bool) String {
 	return String{
@@ -52,28 +80,35 @@ func NewString(s string, valid bool) String {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string and null input. Blank string input does not produce a null String.
+// It supports string and null input. Blank string input does not produce a null String,
+// unless EmptyStringAsNull is set.
 func (s *String) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "String")
+	data = trimJSON("String", data)
 	if bytes.Equal(data, nullBytes) {
 		s.Valid = false
 		return nil
 	}
 
 	if err := json.Unmarshal(data, &s.String); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
 	}
 
-	s.Valid = true
+	s.Valid = s.String != "" || !config().EmptyStringAsNull
 	return nil
 }
 
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this String is null.
 func (s String) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("String", s.Valid, s.marshalJSON)
+}
+
+func (s String) marshalJSON() ([]byte, error) {
 	if !s.Valid {
 		return []byte("null"), nil
 	}
-	return json.Marshal(s.String)
+	return marshalJSONString(s.String)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -85,14 +120,61 @@ func (s String) MarshalText() ([]byte, error) {
 	return []byte(s.String), nil
 }
 
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (s String) AppendText(buf []byte) ([]byte, error) {
+	if !s.Valid {
+		return buf, nil
+	}
+	return append(buf, s.String...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this String to v under key, or nothing if null.
+func (s String) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, s.Valid, s.MarshalText)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s String) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(make([]byte, 0, 1+len(s.String)))
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// It appends the same bytes as MarshalBinary to buf.
+func (s String) AppendBinary(buf []byte) ([]byte, error) {
+	buf = appendBinaryFlag(buf, s.Valid)
+	if !s.Valid {
+		return buf, nil
+	}
+	return append(buf, s.String...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *String) UnmarshalBinary(data []byte) error {
+	payload, valid, err := binaryPayload("String", data, -1)
+	if err != nil {
+		return err
+	}
+	s.String, s.Valid = string(payload), valid
+	return nil
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null String if the input is a blank string.
 func (s *String) UnmarshalText(text []byte) error {
+	text = prepareText("String", text)
 	s.String = string(text)
 	s.Valid = s.String != ""
 	return nil
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (s *String) UnmarshalParam(param string) error {
+	return s.UnmarshalText([]byte(param))
+}
+
 // SetValid changes this String's value and also sets it to be non-null.
 func (s *String) SetValid(v string) {
 	s.String = v
@@ -112,7 +194,38 @@ func (s String) IsZero() bool {
 	return !s.Valid
 }
 
+// IsNull returns true if this String is null.
+func (s String) IsNull() bool {
+	return !s.Valid
+}
+
+// SetNull sets this String to null and clears its value.
+func (s *String) SetNull() {
+	s.String, s.Valid = "", false
+}
+
+// Reset sets this String to its zero value, which is null.
+func (s *String) Reset() {
+	*s = String{}
+}
+
 // Equal returns true if both strings have the same value or are both null.
 func (s String) Equal(other String) bool {
 	return s.Valid == other.Valid && (!s.Valid || s.String == other.String)
 }
+
+// Merge returns this String if it is valid, otherwise other.
+func (s String) Merge(other String) String {
+	if s.Valid {
+		return s
+	}
+	return other
+}
+
+// When returns this String if cond is true, otherwise a null String.
+func (s String) When(cond bool) String {
+	if !cond {
+		s.SetNull()
+	}
+	return s
+}
@@ -259,3 +259,45 @@ func assertStringEqualIsFalse(t *testing.T, a, b String) {
 		t.Errorf("Equal() of String{\"%v\", Valid:%t} and String{\"%v\", Valid:%t} should return false", a.String, a.Valid, b.String, b.Valid)
 	}
 }
+
+func TestEmptyStringAsNull(t *testing.T) {
+	t.Cleanup(func() { configured.Store(nil) })
+	Configure(Options{EmptyStringAsNull: true})
+
+	var str String
+	err := json.Unmarshal(blankStringJSON, &str)
+	maybePanic(err)
+	assertNullStr(t, str, "blank json with EmptyStringAsNull")
+
+	err = json.Unmarshal(stringJSON, &str)
+	maybePanic(err)
+	assertStr(t, str, "string json with EmptyStringAsNull")
+
+	ds := DateStringFrom("2024-01-01")
+	err = json.Unmarshal(blankStringJSON, &ds)
+	maybePanic(err)
+	if ds.Valid || ds.String != "" {
+		t.Errorf("blank date json should be null with no leftover value, got %+v", ds)
+	}
+
+	e := NewEnum("a", "b")
+	err = json.Unmarshal(blankStringJSON, &e)
+	maybePanic(err)
+	if e.Valid {
+		t.Error("blank enum json should be null")
+	}
+
+	var c Cron
+	err = json.Unmarshal(blankStringJSON, &c)
+	maybePanic(err)
+	if c.Valid {
+		t.Error("blank cron json should be null")
+	}
+
+	re := RegexpFrom("x")
+	err = json.Unmarshal(blankStringJSON, &re)
+	maybePanic(err)
+	if re.Valid {
+		t.Error("blank regexp json should be null")
+	}
+}
@@ -0,0 +1,343 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Marshal returns the JSON encoding of v like json.Marshal. If v is a struct or a pointer to one,
+// its fields can deviate from the package defaults with a `null` struct tag holding
+// comma-separated options:
+//
+//	format=LAYOUT  encode a Time or DateString field with the time layout LAYOUT,
+//	               such as `null:"format=2006/01/02"`
+//	omitnull       omit the field if it is null
+//	emptyaszero    encode null as the zero value of the type, such as 0 or "",
+//	               and decode zero values to null, like the zero package
+//
+// Field names and the omitempty and "-" options are taken from the json struct tag.
+// The null tag applies to the fields of v and of structs embedded in it, not to nested structs.
+func Marshal(v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return []byte("null"), nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return json.Marshal(v)
+	}
+	fields, err := cachedTagFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	buf := []byte{'{'}
+	for _, f := range fields {
+		fv := rv.FieldByIndex(f.index)
+		if f.omitted(fv) {
+			continue
+		}
+		data, err := f.marshal(fv)
+		if err != nil {
+			return nil, err
+		}
+		if len(buf) > 1 {
+			buf = append(buf, ',')
+		}
+		key, _ := json.Marshal(f.name)
+		buf = append(buf, key...)
+		buf = append(buf, ':')
+		buf = append(buf, data...)
+	}
+	buf = append(buf, '}')
+	return buf, nil
+}
+
+// Unmarshal parses the JSON-encoded data and stores the result in v like json.Unmarshal,
+// honoring the `null` struct tag options described in Marshal.
+// If fields of a struct cannot be decoded, it decodes the others and returns ValidationErrors.
+// A DateString whose value is not blank or a date is an error wrapping ErrInvalidDate,
+// rather than the null that json.Unmarshal leaves.
+func Unmarshal(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return &json.InvalidUnmarshalError{Type: reflect.TypeOf(v)}
+	}
+	rv = rv.Elem()
+	if rv.Kind() != reflect.Struct {
+		return json.Unmarshal(data, v)
+	}
+	fields, err := cachedTagFields(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	var errs ValidationErrors
+	for _, f := range fields {
+		msg, ok := lookupKey(obj, f.name)
+		if !ok {
+			continue
+		}
+		if err := f.unmarshal(msg, rv.FieldByIndex(f.index)); err != nil {
+			errs = append(errs, newValidationError(f.name, msg, err))
+		}
+	}
+	return errs.errOrNil()
+}
+
+// tagField is a struct field and its json and null tag options.
+type tagField struct {
+	index     []int
+	name      string
+	depth     int
+	omitEmpty bool
+
+	format      string
+	omitNull    bool
+	emptyAsZero bool
+}
+
+var tagFieldCache sync.Map // map[reflect.Type][]tagField
+
+func cachedTagFields(t reflect.Type) ([]tagField, error) {
+	if fields, ok := tagFieldCache.Load(t); ok {
+		return fields.([]tagField), nil
+	}
+	fields, err := tagFields(t, nil)
+	if err != nil {
+		return nil, err
+	}
+	// shallower fields hide deeper fields of the same name, as in encoding/json
+	var visible []tagField
+	seen := make(map[string]int)
+	for _, f := range fields {
+		if i, ok := seen[f.name]; ok {
+			if f.depth < visible[i].depth {
+				visible[i] = f
+			}
+			continue
+		}
+		seen[f.name] = len(visible)
+		visible = append(visible, f)
+	}
+	tagFieldCache.Store(t, visible)
+	return visible, nil
+}
+
+var (
+	isZeroerType  = reflect.TypeOf((*interface{ IsZero() bool })(nil)).Elem()
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+func tagFields(t reflect.Type, index []int) ([]tagField, error) {
+	var fields []tagField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		jsonTag := sf.Tag.Get("json")
+		if jsonTag == "-" {
+			continue
+		}
+		name, jsonOpts, _ := strings.Cut(jsonTag, ",")
+		idx := append(append([]int(nil), index...), i)
+
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct &&
+			!sf.Type.Implements(marshalerType) {
+			embedded, err := tagFields(sf.Type, idx)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, embedded...)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		f := tagField{
+			index: idx,
+			name:  name,
+			depth: len(index),
+		}
+		for _, opt := range strings.Split(jsonOpts, ",") {
+			if opt == "omitempty" {
+				f.omitEmpty = true
+			}
+		}
+		if err := f.parseNullTag(sf); err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+func (f *tagField) parseNullTag(sf reflect.StructField) error {
+	tag, ok := sf.Tag.Lookup("null")
+	if !ok || tag == "" {
+		return nil
+	}
+	inFormat := false
+	for _, opt := range strings.Split(tag, ",") {
+		switch {
+		case opt == "omitnull":
+			f.omitNull = true
+			inFormat = false
+		case opt == "emptyaszero":
+			f.emptyAsZero = true
+			inFormat = false
+		case strings.HasPrefix(opt, "format="):
+			f.format = strings.TrimPrefix(opt, "format=")
+			inFormat = true
+		case inFormat:
+			// layouts such as "Jan 2, 2006" contain commas
+			f.format += "," + opt
+		default:
+			return fmt.Errorf("null: unknown null tag option %q on field %s", opt, sf.Name)
+		}
+	}
+
+	if f.format != "" && sf.Type != reflect.TypeOf(Time{}) && sf.Type != reflect.TypeOf(DateString{}) {
+		return fmt.Errorf("null: format option on field %s requires null.Time or null.DateString, not %s", sf.Name, sf.Type)
+	}
+	if (f.omitNull || f.emptyAsZero) && (sf.Type.Kind() == reflect.Pointer || !sf.Type.Implements(isZeroerType)) {
+		return fmt.Errorf("null: null tag on field %s requires a nullable type, not %s", sf.Name, sf.Type)
+	}
+	if _, ok := sf.Type.MethodByName("ValueOrZero"); f.emptyAsZero && !ok {
+		return fmt.Errorf("null: emptyaszero option on field %s requires a ValueOrZero method", sf.Name)
+	}
+	return nil
+}
+
+// omitted reports whether Marshal leaves out the field with the value fv.
+func (f tagField) omitted(fv reflect.Value) bool {
+	return f.omitEmpty && isEmptyValue(fv) || f.omitNull && isNullValue(fv)
+}
+
+func (f tagField) marshal(fv reflect.Value) ([]byte, error) {
+	if f.emptyAsZero && isNullValue(fv) {
+		zero := fv.MethodByName("ValueOrZero").Call(nil)[0].Interface()
+		return json.Marshal(zero)
+	}
+	if f.format != "" && !isNullValue(fv) {
+		t, err := tagTime(fv)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(t.Format(f.format))
+	}
+	return json.Marshal(fv.Interface())
+}
+
+func (f tagField) unmarshal(msg json.RawMessage, fv reflect.Value) error {
+	if f.format != "" && !bytes.Equal(msg, nullBytes) {
+		if err := f.unmarshalFormat(msg, fv); err != nil {
+			return err
+		}
+	} else if err := json.Unmarshal(msg, fv.Addr().Interface()); err != nil {
+		return err
+	} else if err := invalidDate(fv); err != nil {
+		return err
+	}
+
+	if f.emptyAsZero && !isNullValue(fv) && fv.MethodByName("ValueOrZero").Call(nil)[0].IsZero() {
+		fv.FieldByName("Valid").SetBool(false)
+	}
+	return nil
+}
+
+func (f tagField) unmarshalFormat(msg json.RawMessage, fv reflect.Value) error {
+	var str string
+	if err := json.Unmarshal(msg, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	switch x := fv.Addr().Interface().(type) {
+	case *Time:
+		t, err := time.Parse(f.format, str)
+		if err == nil {
+			err = checkDate(t)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidDate, err)
+		}
+		x.SetValid(t)
+	case *DateString:
+		if str == "" {
+			*x = NewDateString("", false)
+			return nil
+		}
+		t, err := time.Parse(f.format, str)
+		if err == nil {
+			err = checkDate(t)
+		}
+		if err != nil {
+			*x = NewDateString(str, false)
+			return fmt.Errorf("%w: %w", ErrInvalidDate, err)
+		}
+		*x = NewDateString(t.Format(config().DateFormat), true)
+	}
+	return nil
+}
+
+// invalidDate returns an error wrapping ErrInvalidDate if fv is a DateString that
+// UnmarshalJSON left null because its value is not a blank string or a date.
+func invalidDate(fv reflect.Value) error {
+	d, ok := fv.Addr().Interface().(*DateString)
+	if !ok || d.Valid || strings.TrimSpace(d.String) == "" {
+		return nil
+	}
+	_, err := parseDate(d.String)
+	return fmt.Errorf("%w: %w", ErrInvalidDate, err)
+}
+
+// tagTime returns the time held by a valid Time or DateString.
+func tagTime(fv reflect.Value) (time.Time, error) {
+	switch x := fv.Interface().(type) {
+	case Time:
+		return x.Time, nil
+	case DateString:
+		t, err := parseDate(x.String)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %w", ErrInvalidDate, err)
+		}
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("null: cannot format %s", fv.Type())
+}
+
+// isNullValue reports whether fv is a nil pointer or a null nullable value.
+func isNullValue(fv reflect.Value) bool {
+	if fv.Kind() == reflect.Pointer && fv.IsNil() {
+		return true
+	}
+	n, ok := fv.Interface().(interface{ IsZero() bool })
+	return ok && n.IsZero()
+}
+
+// isEmptyValue reports whether fv is empty according to encoding/json's omitempty rules.
+func isEmptyValue(fv reflect.Value) bool {
+	switch fv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return fv.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return fv.IsZero()
+	}
+	return false
+}
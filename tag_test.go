@@ -0,0 +1,90 @@
+package null
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type tagBase struct {
+	ID Int `json:"id"`
+}
+
+type tagStruct struct {
+	tagBase
+	Name     String     `json:"name" null:"omitnull"`
+	Birthday DateString `json:"birthday" null:"format=2006/01/02"`
+	Seen     Time       `json:"seen,omitempty" null:"format=Jan 2, 2006"`
+	Count    Int        `json:"count" null:"emptyaszero"`
+	Plain    String
+	Skipped  string `json:"-"`
+}
+
+func TestMarshalTags(t *testing.T) {
+	v := tagStruct{
+		tagBase:  tagBase{ID: IntFrom(1)},
+		Birthday: DateStringFrom("2000-12-31"),
+		Seen:     TimeFrom(time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)),
+		Skipped:  "x",
+	}
+	data, err := Marshal(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"id":1,"birthday":"2000/12/31","seen":"Mar 4, 2024","count":0,"Plain":null}`, "tagged struct")
+
+	data, err = Marshal(&tagStruct{Name: StringFrom("a")})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"id":null,"name":"a","birthday":null,"seen":null,"count":0,"Plain":null}`, "tagged struct pointer")
+}
+
+func TestUnmarshalTags(t *testing.T) {
+	var v tagStruct
+	err := Unmarshal([]byte(`{"id":1,"Name":"a","birthday":"2000/12/31","seen":"Mar 4, 2024","count":0,"Plain":"p"}`), &v)
+	maybePanic(err)
+	if !v.ID.Valid || v.ID.Int64 != 1 {
+		t.Errorf("bad id: %+v", v.ID)
+	}
+	if v.Name.String != "a" {
+		t.Errorf("case-insensitive field name not matched: %+v", v.Name)
+	}
+	if !v.Birthday.Valid || v.Birthday.String != "2000-12-31" {
+		t.Errorf("bad formatted date: %+v", v.Birthday)
+	}
+	if !v.Seen.Valid || !v.Seen.Time.Equal(time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad formatted time: %+v", v.Seen)
+	}
+	if v.Count.Valid {
+		t.Error("emptyaszero: 0 should unmarshal to null")
+	}
+	if v.Plain.String != "p" {
+		t.Errorf("bad plain field: %+v", v.Plain)
+	}
+
+	err = Unmarshal([]byte(`{"seen":"2024-03-04"}`), &v)
+	if !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("expected ErrInvalidDate, got %v", err)
+	}
+}
+
+func TestTagErrors(t *testing.T) {
+	type badOption struct {
+		A String `null:"omitnil"`
+	}
+	if _, err := Marshal(badOption{}); err == nil {
+		t.Error("expected error for unknown option")
+	}
+	type badFormat struct {
+		A String `null:"format=2006"`
+	}
+	if _, err := Marshal(badFormat{}); err == nil {
+		t.Error("expected error for format on String")
+	}
+	type badType struct {
+		A string `null:"omitnull"`
+	}
+	if err := Unmarshal([]byte(`{}`), &badType{}); err == nil {
+		t.Error("expected error for omitnull on string")
+	}
+	if err := Unmarshal([]byte(`{}`), tagStruct{}); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+}
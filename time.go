@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -54,25 +56,55 @@ func (t Time) ValueOrZero() time.Time {
 	return t.Time
 }
 
+// Scan implements the sql.Scanner interface.
+// It returns a *ScanError if value cannot be converted.
+func (t *Time) Scan(value any) error {
+	if err := t.NullTime.Scan(value); err != nil {
+		t.Valid = false
+		return newScanError("null.Time", value, err)
+	}
+	return nil
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this time is null.
 func (t Time) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Time", t.Valid, t.marshalJSON)
+}
+
+func (t Time) marshalJSON() ([]byte, error) {
 	if !t.Valid {
 		return []byte("null"), nil
 	}
-	return t.Time.MarshalJSON()
+	return marshalPooled(t.appendJSON)
+}
+
+// appendJSON appends the text of this valid Time to buf as a JSON string.
+func (t Time) appendJSON(buf []byte) ([]byte, error) {
+	buf, err := t.AppendText(append(buf, '"'))
+	return append(buf, '"'), err
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports string and null input.
 func (t *Time) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "Time")
+	data = trimJSON("Time", data)
 	if bytes.Equal(data, nullBytes) {
 		t.Valid = false
 		return nil
 	}
 
 	if err := json.Unmarshal(data, &t.Time); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		var parseErr *time.ParseError
+		if errors.As(err, &parseErr) {
+			return fmt.Errorf("%w: %w", ErrInvalidDate, err)
+		}
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	if err := checkDate(t.Time); err != nil {
+		t.Valid = false
+		return fmt.Errorf("%w: %w", ErrInvalidDate, err)
 	}
 
 	t.Valid = true
@@ -80,31 +112,99 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It returns an empty string if invalid, otherwise time.Time's MarshalText.
+// It returns an empty string if invalid, otherwise the same text as time.Time's MarshalText.
 func (t Time) MarshalText() ([]byte, error) {
 	if !t.Valid {
 		return []byte{}, nil
 	}
-	return t.Time.MarshalText()
+	return marshalPooled(t.AppendText)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (t Time) AppendText(buf []byte) ([]byte, error) {
+	if !t.Valid {
+		return buf, nil
+	}
+	if y := t.Time.Year(); y < 0 || y > 9999 {
+		// let time.Time report the error
+		_, err := t.Time.MarshalText()
+		return buf, err
+	}
+	return t.Time.AppendFormat(buf, time.RFC3339Nano), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Time to v under key, or nothing if null.
+func (t Time) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, t.Valid, t.MarshalText)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(make([]byte, 0, 16))
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// It appends the same bytes as MarshalBinary to buf.
+func (t Time) AppendBinary(buf []byte) ([]byte, error) {
+	buf = appendBinaryFlag(buf, t.Valid)
+	if !t.Valid {
+		return buf, nil
+	}
+	data, err := t.Time.MarshalBinary()
+	if err != nil {
+		return buf, err
+	}
+	return append(buf, data...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	payload, valid, err := binaryPayload("Time", data, -1)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		t.Time, t.Valid = time.Time{}, false
+		return nil
+	}
+	if err := t.Time.UnmarshalBinary(payload); err != nil {
+		t.Valid = false
+		return fmt.Errorf("null: couldn't unmarshal Time binary: %w", err)
+	}
+	t.Valid = true
+	return nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It has backwards compatibility with v3 in that the string "null" is considered equivalent to an empty string
 // and unmarshaling will succeed. This may be removed in a future version.
 func (t *Time) UnmarshalText(text []byte) error {
-	str := string(text)
+	text = prepareText("Time", text)
+	str := viewString(text)
 	// allowing "null" is for backwards compatibility with v3
 	if str == "" || str == "null" {
 		t.Valid = false
 		return nil
 	}
 	if err := t.Time.UnmarshalText(text); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidDate, err)
+	}
+	if err := checkDate(t.Time); err != nil {
+		t.Valid = false
+		return fmt.Errorf("%w: %w", ErrInvalidDate, err)
 	}
 	t.Valid = true
 	return nil
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (t *Time) UnmarshalParam(param string) error {
+	return t.UnmarshalText(viewBytes(param))
+}
+
 // SetValid changes this Time's value and sets it to be non-null.
 func (t *Time) SetValid(v time.Time) {
 	t.Time = v
@@ -125,6 +225,21 @@ func (t Time) IsZero() bool {
 	return !t.Valid
 }
 
+// IsNull returns true if this Time is null.
+func (t Time) IsNull() bool {
+	return !t.Valid
+}
+
+// SetNull sets this Time to null and clears its value.
+func (t *Time) SetNull() {
+	t.Time, t.Valid = time.Time{}, false
+}
+
+// Reset sets this Time to its zero value, which is null.
+func (t *Time) Reset() {
+	*t = Time{}
+}
+
 // Equal returns true if both Time objects encode the same time or are both null.
 // Two times can be equal even if they are in different locations.
 // For example, 6:00 +0200 CEST and 4:00 UTC are Equal.
@@ -132,6 +247,22 @@ func (t Time) Equal(other Time) bool {
 	return t.Valid == other.Valid && (!t.Valid || t.Time.Equal(other.Time))
 }
 
+// Merge returns this Time if it is valid, otherwise other.
+func (t Time) Merge(other Time) Time {
+	if t.Valid {
+		return t
+	}
+	return other
+}
+
+// When returns this Time if cond is true, otherwise a null Time.
+func (t Time) When(cond bool) Time {
+	if !cond {
+		t.SetNull()
+	}
+	return t
+}
+
 // ExactEqual returns true if both Time objects are equal or both null.
 // ExactEqual returns false for times that are in different locations or
 // have a different monotonic clock reading.
@@ -0,0 +1,253 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TimeZone is a nullable IANA time zone name, such as "Asia/Bangkok".
+// Input is validated with time.LoadLocation, so the names available depend on
+// the system's zoneinfo database, or on importing time/tzdata.
+// It will marshal to null if null.
+type TimeZone struct {
+	sql.NullString
+}
+
+// NewTimeZone creates a new TimeZone.
+// The value is stored as-is and is not validated.
+func NewTimeZone(s string, valid bool) TimeZone {
+	return TimeZone{
+		NullString: sql.NullString{
+			String: s,
+			Valid:  valid,
+		},
+	}
+}
+
+// TimeZoneFrom creates a new TimeZone from s in its canonical form.
+// It will be null if s is not a known time zone.
+func TimeZoneFrom(s string) TimeZone {
+	canonical, err := parseTimeZone(s)
+	if err != nil {
+		coerced("TimeZone", "From", s, err)
+		return NewTimeZone("", false)
+	}
+	return NewTimeZone(canonical, true)
+}
+
+// TimeZoneFromPtr creates a new TimeZone that will be null if s is nil
+// or not a known time zone.
+func TimeZoneFromPtr(s *string) TimeZone {
+	if s == nil {
+		return NewTimeZone("", false)
+	}
+	return TimeZoneFrom(*s)
+}
+
+func parseTimeZone(s string) (string, error) {
+	loc, err := loadTimeZone(s)
+	if err != nil {
+		return "", err
+	}
+	return loc.String(), nil
+}
+
+func loadTimeZone(s string) (*time.Location, error) {
+	s = strings.TrimSpace(s)
+	// LoadLocation maps "" to UTC and "Local" to the zone of the host, neither of which is a zone name.
+	if s == "" || s == "Local" {
+		return nil, errors.New("not an IANA time zone name")
+	}
+	return time.LoadLocation(s)
+}
+
+// Location returns the time.Location of this TimeZone,
+// and false if it is null or not a known time zone.
+func (z TimeZone) Location() (*time.Location, bool) {
+	if !z.Valid {
+		return nil, false
+	}
+	loc, err := loadTimeZone(z.String)
+	if err != nil {
+		return nil, false
+	}
+	return loc, true
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (z TimeZone) ValueOrZero() string {
+	if !z.Valid {
+		return ""
+	}
+	return z.String
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if the column holds a unknown time zone.
+func (z *TimeZone) Scan(value any) error {
+	if err := z.NullString.Scan(value); err != nil {
+		z.Valid = false
+		return newScanError("null.TimeZone", value, err)
+	}
+	if !z.Valid {
+		return nil
+	}
+	canonical, err := parseTimeZone(z.String)
+	if err != nil {
+		z.Valid = false
+		return newScanError("null.TimeZone", value, err)
+	}
+	z.String = canonical
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input. Blank string input produces a null TimeZone.
+// It returns an error if the input is not a known time zone.
+func (z *TimeZone) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "String")
+	data = trimJSON("TimeZone", data)
+	if bytes.Equal(data, nullBytes) {
+		z.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	return unmarshalError(ErrInvalidJSON, z.set(str))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null TimeZone if the input is blank or "null".
+// It returns an error if the input is not a known time zone.
+func (z *TimeZone) UnmarshalText(text []byte) error {
+	text = prepareText("TimeZone", text)
+	str := string(text)
+	if str == "null" {
+		str = ""
+	}
+	return unmarshalError(ErrInvalidText, z.set(str))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (z *TimeZone) UnmarshalParam(param string) error {
+	return z.UnmarshalText([]byte(param))
+}
+
+func (z *TimeZone) set(str string) error {
+	if str == "" {
+		z.String = ""
+		z.Valid = false
+		return nil
+	}
+	canonical, err := parseTimeZone(str)
+	if err != nil {
+		z.Valid = false
+		return fmt.Errorf("invalid time zone %q: %w", str, err)
+	}
+	z.String = canonical
+	z.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this TimeZone is null.
+func (z TimeZone) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("TimeZone", z.Valid, z.marshalJSON)
+}
+
+func (z TimeZone) marshalJSON() ([]byte, error) {
+	if !z.Valid {
+		return []byte("null"), nil
+	}
+	return marshalJSONString(z.String)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this TimeZone is null.
+func (z TimeZone) MarshalText() ([]byte, error) {
+	if !z.Valid {
+		return []byte{}, nil
+	}
+	return []byte(z.String), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (z TimeZone) AppendText(buf []byte) ([]byte, error) {
+	if !z.Valid {
+		return buf, nil
+	}
+	return append(buf, z.String...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this TimeZone to v under key, or nothing if null.
+func (z TimeZone) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, z.Valid, z.MarshalText)
+}
+
+// SetValid changes this TimeZone's value and also sets it to be non-null.
+// The value is stored as-is and is not validated.
+func (z *TimeZone) SetValid(v string) {
+	z.String = v
+	z.Valid = true
+}
+
+// Ptr returns a pointer to this TimeZone's value, or a nil pointer if this TimeZone is null.
+func (z TimeZone) Ptr() *string {
+	if !z.Valid {
+		return nil
+	}
+	return &z.String
+}
+
+// IsZero returns true for null TimeZones, for potential future omitempty support.
+func (z TimeZone) IsZero() bool {
+	return !z.Valid
+}
+
+// IsNull returns true if this TimeZone is null.
+func (z TimeZone) IsNull() bool {
+	return !z.Valid
+}
+
+// SetNull sets this TimeZone to null and clears its value.
+func (z *TimeZone) SetNull() {
+	z.String, z.Valid = "", false
+}
+
+// Reset sets this TimeZone to its zero value, which is null.
+func (z *TimeZone) Reset() {
+	*z = TimeZone{}
+}
+
+// Equal returns true if both TimeZones have the same value or are both null.
+func (z TimeZone) Equal(other TimeZone) bool {
+	return z.Valid == other.Valid && (!z.Valid || z.String == other.String)
+}
+
+// Merge returns this TimeZone if it is valid, otherwise other.
+func (z TimeZone) Merge(other TimeZone) TimeZone {
+	if z.Valid {
+		return z
+	}
+	return other
+}
+
+// When returns this TimeZone if cond is true, otherwise a null TimeZone.
+func (z TimeZone) When(cond bool) TimeZone {
+	if !cond {
+		z.SetNull()
+	}
+	return z
+}
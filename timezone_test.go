@@ -0,0 +1,55 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	_ "time/tzdata" // so the tests do not depend on the zoneinfo of the host
+)
+
+func TestTimeZoneFrom(t *testing.T) {
+	for _, in := range []string{"Asia/Bangkok", " America/New_York ", "UTC"} {
+		z := TimeZoneFrom(in)
+		loc, ok := z.Location()
+		if !z.Valid || !ok || loc.String() != z.String {
+			t.Errorf("TimeZoneFrom(%q) = %+v, Location = %v %v", in, z, loc, ok)
+		}
+	}
+	for _, in := range []string{"", "Local", "Asia/Atlantis", "+07:00"} {
+		if z := TimeZoneFrom(in); z.Valid {
+			t.Errorf("TimeZoneFrom(%q): expected null, got %+v", in, z)
+		}
+	}
+	if _, ok := TimeZoneFromPtr(nil).Location(); ok {
+		t.Error("null TimeZone should have no Location")
+	}
+}
+
+func TestTimeZoneUnmarshal(t *testing.T) {
+	var z TimeZone
+	err := json.Unmarshal([]byte(`"Asia/Tokyo"`), &z)
+	maybePanic(err)
+	if z.String != "Asia/Tokyo" || !z.Valid {
+		t.Errorf("UnmarshalJSON = %+v", z)
+	}
+	data, err := json.Marshal(z)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"Asia/Tokyo"`, "TimeZone")
+
+	if err := json.Unmarshal([]byte(`"Mars/Olympus"`), &z); err == nil || z.Valid {
+		t.Errorf("unknown zone: got %v %+v", err, z)
+	}
+	err = z.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if z.Valid {
+		t.Errorf("blank text: %+v", z)
+	}
+
+	err = z.Scan([]byte("Europe/London"))
+	maybePanic(err)
+	if z.String != "Europe/London" || !z.Valid {
+		t.Errorf("Scan = %+v", z)
+	}
+	if err := z.Scan("nowhere"); err == nil || z.Valid {
+		t.Errorf("Scan unknown: got %v %+v", err, z)
+	}
+}
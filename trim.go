@@ -0,0 +1,57 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+var (
+	// TrimSpace Set whether UnmarshalText and UnmarshalJSON trim surrounding whitespace
+	// from input before parsing, defaults to false
+	//
+	// Deprecated: Use Configure with Options.TrimSpace. It is ignored once Configure has been called.
+	TrimSpace = false
+	// TrimSpaceOf Set TrimSpace for individual types, keyed by type name (e.g. "DateString").
+	// Entries take precedence over TrimSpace.
+	//
+	// Deprecated: Use Configure with Options.TrimSpaceOf. It is ignored once Configure has been called.
+	TrimSpaceOf = map[string]bool{}
+)
+
+func trimSpaceFor(name string) bool {
+	opts := config()
+	if trim, ok := opts.TrimSpaceOf[name]; ok {
+		return trim
+	}
+	return opts.TrimSpace
+}
+
+// trimText trims text input for the named type if trimming is enabled.
+func trimText(name string, text []byte) []byte {
+	if !trimSpaceFor(name) {
+		return text
+	}
+	return bytes.TrimSpace(text)
+}
+
+// trimJSON trims the contents of a JSON string for the named type if trimming is enabled.
+// Other JSON values are returned unchanged.
+func trimJSON(name string, data []byte) []byte {
+	if len(data) == 0 || data[0] != '"' || !trimSpaceFor(name) {
+		return data
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return data
+	}
+	trimmed := strings.TrimSpace(str)
+	if len(trimmed) == len(str) {
+		return data
+	}
+	out, err := json.Marshal(trimmed)
+	if err != nil {
+		return data
+	}
+	return out
+}
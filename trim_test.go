@@ -0,0 +1,86 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTrimSpace(t *testing.T) {
+	var ds DateString
+	err := ds.UnmarshalText([]byte(" 2024-01-01 "))
+	maybePanic(err)
+	if ds.Valid {
+		t.Error("untrimmed date should be invalid by default")
+	}
+
+	defer configured.Store(nil)
+	Configure(Options{TrimSpace: true})
+
+	err = ds.UnmarshalText([]byte(" 2024-01-01 "))
+	maybePanic(err)
+	if !ds.Valid || ds.String != "2024-01-01" {
+		t.Errorf("bad trimmed text date: %+v", ds)
+	}
+
+	err = json.Unmarshal([]byte(`"\t2024-01-01\n"`), &ds)
+	maybePanic(err)
+	if !ds.Valid || ds.String != "2024-01-01" {
+		t.Errorf("bad trimmed json date: %+v", ds)
+	}
+
+	var i Int
+	err = i.UnmarshalText([]byte(" 12345 "))
+	maybePanic(err)
+	assertInt(t, i, "trimmed text int")
+
+	err = json.Unmarshal([]byte(`" 12345 "`), &i)
+	maybePanic(err)
+	assertInt(t, i, "trimmed json int string")
+
+	var f Float
+	err = f.UnmarshalText([]byte(" 1.2345\t"))
+	maybePanic(err)
+	assertFloat(t, f, "trimmed text float")
+
+	var b Bool
+	err = b.UnmarshalText([]byte(" true "))
+	maybePanic(err)
+	assertBool(t, b, "trimmed text bool")
+
+	var ti Time
+	err = json.Unmarshal([]byte(`" `+timeString1+` "`), &ti)
+	maybePanic(err)
+	assertTime(t, ti, "trimmed json time")
+
+	var blank String
+	err = blank.UnmarshalText([]byte("   "))
+	maybePanic(err)
+	assertNullStr(t, blank, "whitespace-only text string")
+}
+
+func TestTrimSpaceOf(t *testing.T) {
+	defer configured.Store(nil)
+	Configure(Options{TrimSpaceOf: map[string]bool{"DateString": true}})
+
+	var ds DateString
+	err := ds.UnmarshalText([]byte(" 2024-01-01 "))
+	maybePanic(err)
+	if !ds.Valid {
+		t.Error("per-type trimming should apply to DateString")
+	}
+
+	var i Int
+	err = i.UnmarshalText([]byte(" 1 "))
+	if err == nil {
+		t.Error("per-type trimming should not apply to Int")
+	}
+
+	Configure(Options{TrimSpace: true, TrimSpaceOf: map[string]bool{"DateString": true, "String": false}})
+
+	var str String
+	err = json.Unmarshal([]byte(`" test "`), &str)
+	maybePanic(err)
+	if str.String != " test " {
+		t.Errorf("per-type override should disable trimming for String, got %q", str.String)
+	}
+}
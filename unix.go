@@ -0,0 +1,147 @@
+package null
+
+import (
+	"time"
+)
+
+// UnixMilli is a nullable Unix timestamp in milliseconds, stored as an int64.
+// It supports SQL (BIGINT) and JSON (number) serialization like Int,
+// and converts to and from time.Time.
+// It will marshal to null if null.
+type UnixMilli struct {
+	Int
+}
+
+// NewUnixMilli creates a new UnixMilli.
+func NewUnixMilli(ms int64, valid bool) UnixMilli {
+	return UnixMilli{Int: NewInt(ms, valid)}
+}
+
+// UnixMilliFrom creates a new UnixMilli that will always be valid.
+func UnixMilliFrom(ms int64) UnixMilli {
+	return NewUnixMilli(ms, true)
+}
+
+// UnixMilliFromPtr creates a new UnixMilli that will be null if ms is nil.
+func UnixMilliFromPtr(ms *int64) UnixMilli {
+	if ms == nil {
+		return NewUnixMilli(0, false)
+	}
+	return NewUnixMilli(*ms, true)
+}
+
+// UnixMilliFromTime creates a new UnixMilli from t that will always be valid.
+func UnixMilliFromTime(t time.Time) UnixMilli {
+	return NewUnixMilli(t.UnixMilli(), true)
+}
+
+// Time returns this timestamp as a Time, which will be null if this UnixMilli is null.
+func (u UnixMilli) Time() Time {
+	if !u.Valid {
+		return NewTime(time.Time{}, false)
+	}
+	return TimeFrom(time.UnixMilli(u.Int64))
+}
+
+// SetTime changes this UnixMilli's value to t and also sets it to be non-null.
+func (u *UnixMilli) SetTime(t time.Time) {
+	u.SetValid(t.UnixMilli())
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this UnixMilli is null.
+func (u UnixMilli) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("UnixMilli", u.Valid, u.Int.marshalJSON)
+}
+
+// Equal returns true if both timestamps have the same value or are both null.
+func (u UnixMilli) Equal(other UnixMilli) bool {
+	return u.Int.Equal(other.Int)
+}
+
+// Merge returns this UnixMilli if it is valid, otherwise other.
+func (u UnixMilli) Merge(other UnixMilli) UnixMilli {
+	if u.Valid {
+		return u
+	}
+	return other
+}
+
+// When returns this UnixMilli if cond is true, otherwise a null UnixMilli.
+func (u UnixMilli) When(cond bool) UnixMilli {
+	if !cond {
+		u.SetNull()
+	}
+	return u
+}
+
+// UnixMicro is a nullable Unix timestamp in microseconds, stored as an int64.
+// It supports SQL (BIGINT) and JSON (number) serialization like Int,
+// and converts to and from time.Time.
+// It will marshal to null if null.
+type UnixMicro struct {
+	Int
+}
+
+// NewUnixMicro creates a new UnixMicro.
+func NewUnixMicro(us int64, valid bool) UnixMicro {
+	return UnixMicro{Int: NewInt(us, valid)}
+}
+
+// UnixMicroFrom creates a new UnixMicro that will always be valid.
+func UnixMicroFrom(us int64) UnixMicro {
+	return NewUnixMicro(us, true)
+}
+
+// UnixMicroFromPtr creates a new UnixMicro that will be null if us is nil.
+func UnixMicroFromPtr(us *int64) UnixMicro {
+	if us == nil {
+		return NewUnixMicro(0, false)
+	}
+	return NewUnixMicro(*us, true)
+}
+
+// UnixMicroFromTime creates a new UnixMicro from t that will always be valid.
+func UnixMicroFromTime(t time.Time) UnixMicro {
+	return NewUnixMicro(t.UnixMicro(), true)
+}
+
+// Time returns this timestamp as a Time, which will be null if this UnixMicro is null.
+func (u UnixMicro) Time() Time {
+	if !u.Valid {
+		return NewTime(time.Time{}, false)
+	}
+	return TimeFrom(time.UnixMicro(u.Int64))
+}
+
+// SetTime changes this UnixMicro's value to t and also sets it to be non-null.
+func (u *UnixMicro) SetTime(t time.Time) {
+	u.SetValid(t.UnixMicro())
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this UnixMicro is null.
+func (u UnixMicro) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("UnixMicro", u.Valid, u.Int.marshalJSON)
+}
+
+// Equal returns true if both timestamps have the same value or are both null.
+func (u UnixMicro) Equal(other UnixMicro) bool {
+	return u.Int.Equal(other.Int)
+}
+
+// Merge returns this UnixMicro if it is valid, otherwise other.
+func (u UnixMicro) Merge(other UnixMicro) UnixMicro {
+	if u.Valid {
+		return u
+	}
+	return other
+}
+
+// When returns this UnixMicro if cond is true, otherwise a null UnixMicro.
+func (u UnixMicro) When(cond bool) UnixMicro {
+	if !cond {
+		u.SetNull()
+	}
+	return u
+}
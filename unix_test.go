@@ -0,0 +1,97 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var (
+	unixTime      = time.Date(2024, 1, 2, 3, 4, 5, 6007000, time.UTC)
+	unixMilliJSON = []byte(`1704164645006`)
+	unixMicroJSON = []byte(`1704164645006007`)
+)
+
+type unixInStruct struct {
+	Milli UnixMilli `json:"milli"`
+	Micro UnixMicro `json:"micro"`
+}
+
+func TestUnixMilliFrom(t *testing.T) {
+	u := UnixMilliFromTime(unixTime)
+	if !u.Valid || u.Int64 != 1704164645006 {
+		t.Errorf("bad UnixMilliFromTime(): %d %t", u.Int64, u.Valid)
+	}
+	if got := u.Time(); !got.Valid || !got.Time.Equal(unixTime.Truncate(time.Millisecond)) {
+		t.Errorf("bad Time(): %v", got)
+	}
+
+	null := UnixMilliFromPtr(nil)
+	if null.Valid || null.Time().Valid {
+		t.Error("UnixMilliFromPtr(nil) should be null")
+	}
+
+	var set UnixMilli
+	set.SetTime(unixTime)
+	if !set.Equal(u) {
+		t.Errorf("SetTime() = %v, want %v", set, u)
+	}
+}
+
+func TestUnixMicroFrom(t *testing.T) {
+	u := UnixMicroFromTime(unixTime)
+	if !u.Valid || u.Int64 != 1704164645006007 {
+		t.Errorf("bad UnixMicroFromTime(): %d %t", u.Int64, u.Valid)
+	}
+	if got := u.Time(); !got.Valid || !got.Time.Equal(unixTime) {
+		t.Errorf("bad Time(): %v", got)
+	}
+
+	null := UnixMicroFromPtr(nil)
+	if null.Valid || null.Time().Valid {
+		t.Error("UnixMicroFromPtr(nil) should be null")
+	}
+}
+
+func TestUnixJSON(t *testing.T) {
+	var milli UnixMilli
+	err := json.Unmarshal(unixMilliJSON, &milli)
+	maybePanic(err)
+	if !milli.Equal(UnixMilliFromTime(unixTime)) {
+		t.Errorf("bad unmarshaled UnixMilli: %v", milli)
+	}
+
+	var micro UnixMicro
+	err = json.Unmarshal(unixMicroJSON, &micro)
+	maybePanic(err)
+	if !micro.Equal(UnixMicroFromTime(unixTime)) {
+		t.Errorf("bad unmarshaled UnixMicro: %v", micro)
+	}
+
+	data, err := json.Marshal(unixInStruct{Milli: milli})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"milli":1704164645006,"micro":null}`, "struct json marshal")
+
+	var null UnixMilli
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should produce null UnixMilli")
+	}
+}
+
+func TestUnixScanValue(t *testing.T) {
+	var milli UnixMilli
+	err := milli.Scan(int64(1704164645006))
+	maybePanic(err)
+	if v, err := milli.Value(); v != int64(1704164645006) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var micro UnixMicro
+	err = micro.Scan(nil)
+	maybePanic(err)
+	if v, err := micro.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+}
@@ -0,0 +1,40 @@
+package null
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestEncodeValues(t *testing.T) {
+	type filter struct {
+		Name   String     `url:"name"`
+		Status String     `url:"status"`
+		MinAge Int        `url:"min_age"`
+		Since  DateString `url:"since"`
+		Day    Weekday    `url:"day"`
+		Ratio  Percent    `url:"ratio"`
+		Limit  int        `url:"limit"`
+	}
+	values, err := query.Values(filter{
+		Name:   StringFrom("a b"),
+		MinAge: IntFrom(0),
+		Since:  DateStringFrom("2024-01-02"),
+		Day:    WeekdayFrom(time.Friday),
+		Limit:  10,
+	})
+	maybePanic(err)
+	want := "day=Friday&limit=10&min_age=0&name=a+b&since=2024-01-02"
+	if got := values.Encode(); got != want {
+		t.Errorf("query.Values() = %s, want %s", got, want)
+	}
+
+	v := url.Values{}
+	maybePanic(NewTime(time.Time{}, false).EncodeValues("t", &v))
+	maybePanic(TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)).EncodeValues("t", &v))
+	if got := v.Encode(); got != "t=2024-01-02T03%3A04%3A05Z" {
+		t.Errorf("Time.EncodeValues = %s", got)
+	}
+}
@@ -0,0 +1,253 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Weekday is a nullable time.Weekday. It supports SQL and JSON serialization.
+// It unmarshals numbers (0 is Sunday) as well as full or three-letter English names,
+// and marshals according to FormatWeekday.
+// It will marshal to null if null.
+type Weekday struct {
+	Weekday time.Weekday
+	Valid   bool
+}
+
+// NewWeekday creates a new Weekday.
+func NewWeekday(d time.Weekday, valid bool) Weekday {
+	return Weekday{
+		Weekday: d,
+		Valid:   valid,
+	}
+}
+
+// WeekdayFrom creates a new Weekday that will always be valid.
+func WeekdayFrom(d time.Weekday) Weekday {
+	return NewWeekday(d, true)
+}
+
+// WeekdayFromPtr creates a new Weekday that will be null if d is nil.
+func WeekdayFromPtr(d *time.Weekday) Weekday {
+	if d == nil {
+		return NewWeekday(time.Sunday, false)
+	}
+	return NewWeekday(*d, true)
+}
+
+// ParseWeekday parses a number (0-6) or an English weekday name, such as "Mon" or "monday".
+// It will be null if s is not a weekday.
+func ParseWeekday(s string) Weekday {
+	n, ok := parseCalendar(s, weekdayName, int(time.Sunday), int(time.Saturday))
+	if !ok && s != "" {
+		coerced("Weekday", "Parse", s, nil)
+	}
+	return NewWeekday(time.Weekday(n), ok)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise Sunday.
+func (d Weekday) ValueOrZero() time.Weekday {
+	if !d.Valid {
+		return time.Sunday
+	}
+	return d.Weekday
+}
+
+// Format returns the weekday in the given style, or a blank string if null.
+func (d Weekday) Format(style CalendarStyle) string {
+	if !d.Valid {
+		return ""
+	}
+	return formatCalendar(d.Weekday.String(), int(d.Weekday), style)
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports integer and text columns holding a number or a weekday name.
+func (d *Weekday) Scan(value any) error {
+	var err error
+	switch x := value.(type) {
+	case nil:
+		d.Weekday, d.Valid = time.Sunday, false
+		return nil
+	case int64:
+		err = d.setNumber(x)
+	case string:
+		err = d.setString(x)
+	case []byte:
+		err = d.setString(string(x))
+	default:
+		d.Valid = false
+		return newScanError("null.Weekday", value, nil)
+	}
+	if err != nil {
+		return newScanError("null.Weekday", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It always encodes the weekday as an integer, 0 being Sunday.
+func (d Weekday) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return int64(d.Weekday), nil
+}
+
+func (d *Weekday) setNumber(n int64) error {
+	if n < int64(time.Sunday) || n > int64(time.Saturday) {
+		d.Valid = false
+		return fmt.Errorf("weekday out of range: %d", n)
+	}
+	d.Weekday, d.Valid = time.Weekday(n), true
+	return nil
+}
+
+func (d *Weekday) setString(s string) error {
+	n, ok := parseCalendar(s, weekdayName, int(time.Sunday), int(time.Saturday))
+	if !ok {
+		d.Valid = false
+		return fmt.Errorf("invalid weekday: %q", s)
+	}
+	d.Weekday, d.Valid = time.Weekday(n), true
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+func (d *Weekday) UnmarshalJSON(data []byte) error {
+	data = legacyJSON(data, "Weekday")
+	data = trimJSON("Weekday", data)
+	if bytes.Equal(data, nullBytes) {
+		d.Valid = false
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+		}
+		return unmarshalError(ErrInvalidJSON, d.setString(str))
+	}
+
+	var n int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	return unmarshalError(ErrInvalidJSON, d.setNumber(n))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Weekday if the input is blank or "null".
+func (d *Weekday) UnmarshalText(text []byte) error {
+	text = prepareText("Weekday", text)
+	str := string(text)
+	if str == "" || str == "null" {
+		d.Valid = false
+		return nil
+	}
+	return unmarshalError(ErrInvalidText, d.setString(str))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (d *Weekday) UnmarshalParam(param string) error {
+	return d.UnmarshalText([]byte(param))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Weekday is null, otherwise a number or string according to FormatWeekday.
+func (d Weekday) MarshalJSON() ([]byte, error) {
+	return marshalJSONShape("Weekday", d.Valid, d.marshalJSON)
+}
+
+func (d Weekday) marshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	style := config().WeekdayStyle
+	if style == StyleNumber {
+		return []byte(strconv.Itoa(int(d.Weekday))), nil
+	}
+	return marshalJSONString(d.Format(style))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Weekday is null.
+func (d Weekday) MarshalText() ([]byte, error) {
+	return []byte(d.Format(config().WeekdayStyle)), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (d Weekday) AppendText(buf []byte) ([]byte, error) {
+	return append(buf, d.Format(config().WeekdayStyle)...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Weekday to v under key, or nothing if null.
+func (d Weekday) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, d.Valid, d.MarshalText)
+}
+
+// SetValid changes this Weekday's value and also sets it to be non-null.
+func (d *Weekday) SetValid(v time.Weekday) {
+	d.Weekday = v
+	d.Valid = true
+}
+
+// Ptr returns a pointer to this Weekday's value, or a nil pointer if this Weekday is null.
+func (d Weekday) Ptr() *time.Weekday {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Weekday
+}
+
+// IsZero returns true for null Weekdays, for potential future omitempty support.
+// A non-null Sunday will not be considered zero.
+func (d Weekday) IsZero() bool {
+	return !d.Valid
+}
+
+// IsNull returns true if this Weekday is null.
+func (d Weekday) IsNull() bool {
+	return !d.Valid
+}
+
+// SetNull sets this Weekday to null and clears its value.
+func (d *Weekday) SetNull() {
+	d.Weekday, d.Valid = time.Sunday, false
+}
+
+// Reset sets this Weekday to its zero value, which is null.
+func (d *Weekday) Reset() {
+	*d = Weekday{}
+}
+
+// Equal returns true if both weekdays have the same value or are both null.
+func (d Weekday) Equal(other Weekday) bool {
+	return d.Valid == other.Valid && (!d.Valid || d.Weekday == other.Weekday)
+}
+
+// Merge returns this Weekday if it is valid, otherwise other.
+func (d Weekday) Merge(other Weekday) Weekday {
+	if d.Valid {
+		return d
+	}
+	return other
+}
+
+// When returns this Weekday if cond is true, otherwise a null Weekday.
+func (d Weekday) When(cond bool) Weekday {
+	if !cond {
+		d.SetNull()
+	}
+	return d
+}
@@ -0,0 +1,167 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Weekday
+		ok   bool
+	}{
+		{"Mon", time.Monday, true},
+		{"monday", time.Monday, true},
+		{"SAT", time.Saturday, true},
+		{"0", time.Sunday, true},
+		{"6", time.Saturday, true},
+		{"7", time.Sunday, false},
+		{"Mo", time.Sunday, false},
+		{"Mondays", time.Sunday, false},
+	}
+	for _, tc := range tests {
+		d := ParseWeekday(tc.in)
+		if d.Valid != tc.ok || (tc.ok && d.Weekday != tc.want) {
+			t.Errorf("ParseWeekday(%q) = %v, %t; want %v, %t", tc.in, d.Weekday, d.Valid, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUnmarshalWeekday(t *testing.T) {
+	var num Weekday
+	err := json.Unmarshal([]byte(`3`), &num)
+	maybePanic(err)
+	assertWeekday(t, num, time.Wednesday, "number json")
+
+	var name Weekday
+	err = json.Unmarshal([]byte(`"Fri"`), &name)
+	maybePanic(err)
+	assertWeekday(t, name, time.Friday, "name json")
+
+	var null Weekday
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullWeekday(t, null, "null json")
+
+	var outOfRange Weekday
+	err = json.Unmarshal([]byte(`9`), &outOfRange)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullWeekday(t, outOfRange, "out of range json")
+
+	var badName Weekday
+	err = json.Unmarshal([]byte(`"Someday"`), &badName)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullWeekday(t, badName, "bad name json")
+
+	var badType Weekday
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullWeekday(t, badType, "wrong type json")
+
+	var text Weekday
+	err = text.UnmarshalText([]byte("tuesday"))
+	maybePanic(err)
+	assertWeekday(t, text, time.Tuesday, "UnmarshalText()")
+
+	var blank Weekday
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullWeekday(t, blank, "UnmarshalText() blank")
+}
+
+func TestMarshalWeekday(t *testing.T) {
+	defer configured.Store(nil)
+
+	d := WeekdayFrom(time.Monday)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"Monday"`, "long json marshal")
+
+	Configure(Options{WeekdayStyle: StyleShort})
+	data, err = json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"Mon"`, "short json marshal")
+	data, err = d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "Mon", "short text marshal")
+
+	Configure(Options{WeekdayStyle: StyleNumber})
+	data, err = json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, `1`, "number json marshal")
+
+	null := WeekdayFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestWeekdayScanValue(t *testing.T) {
+	var num Weekday
+	err := num.Scan(int64(5))
+	maybePanic(err)
+	assertWeekday(t, num, time.Friday, "scanned int")
+	if v, err := num.Value(); v != int64(5) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var text Weekday
+	err = text.Scan([]byte("Sun"))
+	maybePanic(err)
+	assertWeekday(t, text, time.Sunday, "scanned text")
+
+	var null Weekday
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullWeekday(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong Weekday
+	err = wrong.Scan(1.5)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestWeekdayPointer(t *testing.T) {
+	d := WeekdayFrom(time.Sunday)
+	if ptr := d.Ptr(); ptr == nil || *ptr != time.Sunday {
+		t.Errorf("bad pointer: %v", ptr)
+	}
+	if ptr := WeekdayFromPtr(nil).Ptr(); ptr != nil {
+		t.Errorf("bad nil pointer: %v", ptr)
+	}
+	if d.IsZero() {
+		t.Error("valid Sunday should not be zero")
+	}
+}
+
+func assertWeekday(t *testing.T, d Weekday, want time.Weekday, from string) {
+	t.Helper()
+	if d.Weekday != want {
+		t.Errorf("bad %s weekday: %v ≠ %v\n", from, d.Weekday, want)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullWeekday(t *testing.T, d Weekday, from string) {
+	t.Helper()
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
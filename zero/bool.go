@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
 )
 
 // Bool is a nullable bool. False input is considered null.
@@ -80,6 +82,12 @@ func (b *Bool) UnmarshalText(text []byte) error {
 	return errors.New("invalid input:" + str)
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (b *Bool) UnmarshalParam(param string) error {
+	return b.UnmarshalText([]byte(param))
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Bool is null.
 func (b Bool) MarshalJSON() ([]byte, error) {
@@ -98,6 +106,18 @@ func (b Bool) MarshalText() ([]byte, error) {
 	return []byte("true"), nil
 }
 
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (b Bool) AppendText(buf []byte) ([]byte, error) {
+	return strconv.AppendBool(buf, b.Valid && b.Bool), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Bool to v under key, or nothing if null or zero.
+func (b Bool) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, !b.IsZero(), b.MarshalText)
+}
+
 // SetValid changes this Bool's value and also sets it to be non-null.
 func (b *Bool) SetValid(v bool) {
 	b.Bool = v
@@ -117,7 +137,38 @@ func (b Bool) IsZero() bool {
 	return !b.Valid || !b.Bool
 }
 
+// IsNull returns true if this Bool is null or zero, which are considered equivalent.
+func (b Bool) IsNull() bool {
+	return b.IsZero()
+}
+
+// SetNull sets this Bool to null and clears its value.
+func (b *Bool) SetNull() {
+	b.Bool, b.Valid = false, false
+}
+
+// Reset sets this Bool to its zero value, which is null.
+func (b *Bool) Reset() {
+	*b = Bool{}
+}
+
 // Equal returns true if both booleans are true and valid, or if both booleans are either false or invalid.
 func (b Bool) Equal(other Bool) bool {
 	return b.ValueOrZero() == other.ValueOrZero()
 }
+
+// Merge returns this Bool if it is not null or zero, otherwise other.
+func (b Bool) Merge(other Bool) Bool {
+	if !b.IsZero() {
+		return b
+	}
+	return other
+}
+
+// When returns this Bool if cond is true, otherwise a null Bool.
+func (b Bool) When(cond bool) Bool {
+	if !cond {
+		b.SetNull()
+	}
+	return b
+}
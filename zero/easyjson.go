@@ -0,0 +1,70 @@
+//go:build nulleasyjson
+
+package zero
+
+import (
+	"encoding/json"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func marshalEasyJSON(w *jwriter.Writer, m json.Marshaler) {
+	w.Raw(m.MarshalJSON())
+}
+
+func unmarshalEasyJSON(l *jlexer.Lexer, u json.Unmarshaler) {
+	if data := l.Raw(); l.Ok() {
+		l.AddError(u.UnmarshalJSON(data))
+	}
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (b Bool) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, b)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (b *Bool) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, b)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (f Float) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, f)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (f *Float) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, f)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (i Int) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, i)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (i *Int) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, i)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like MarshalJSON.
+func (t Time) MarshalEasyJSON(out *jwriter.Writer) {
+	marshalEasyJSON(out, t)
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (t *Time) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, t)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler like encoding/json, which encodes the text of a String.
+func (s String) MarshalEasyJSON(out *jwriter.Writer) {
+	out.String(s.ValueOrZero())
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler like UnmarshalJSON.
+func (s *String) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	unmarshalEasyJSON(in, s)
+}
@@ -0,0 +1,28 @@
+//go:build nulleasyjson
+
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestEasyJSON(t *testing.T) {
+	values := []easyjson.MarshalerUnmarshaler{&String{}, &Int{}, &Float{}, &Bool{}, &Time{}}
+	s, i := StringFrom("a"), IntFrom(2)
+	values = append(values, &s, &i)
+	for _, v := range values {
+		want, err := json.Marshal(v)
+		maybePanic(err)
+		got, err := easyjson.Marshal(v)
+		maybePanic(err)
+		if string(got) != string(want) {
+			t.Errorf("easyjson.Marshal(%T) = %s, want %s", v, got, want)
+		}
+		if err := easyjson.Unmarshal(want, v); err != nil {
+			t.Errorf("easyjson.Unmarshal(%s) into %T: %v", want, v, err)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net/url"
 	"reflect"
 	"strconv"
 )
@@ -102,6 +103,12 @@ func (f *Float) UnmarshalText(text []byte) error {
 	return err
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (f *Float) UnmarshalParam(param string) error {
+	return f.UnmarshalText([]byte(param))
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Float is null.
 func (f Float) MarshalJSON() ([]byte, error) {
@@ -128,6 +135,22 @@ func (f Float) MarshalText() ([]byte, error) {
 	return []byte(strconv.FormatFloat(n, 'f', -1, 64)), nil
 }
 
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (f Float) AppendText(buf []byte) ([]byte, error) {
+	n := f.Float64
+	if !f.Valid {
+		n = 0
+	}
+	return strconv.AppendFloat(buf, n, 'f', -1, 64), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Float to v under key, or nothing if null or zero.
+func (f Float) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, !f.IsZero(), f.MarshalText)
+}
+
 // SetValid changes this Float's value and also sets it to be non-null.
 func (f *Float) SetValid(v float64) {
 	f.Float64 = v
@@ -147,6 +170,21 @@ func (f Float) IsZero() bool {
 	return !f.Valid || f.Float64 == 0
 }
 
+// IsNull returns true if this Float is null or zero, which are considered equivalent.
+func (f Float) IsNull() bool {
+	return f.IsZero()
+}
+
+// SetNull sets this Float to null and clears its value.
+func (f *Float) SetNull() {
+	f.Float64, f.Valid = 0, false
+}
+
+// Reset sets this Float to its zero value, which is null.
+func (f *Float) Reset() {
+	*f = Float{}
+}
+
 // Equal returns true if both floats have the same value or are both either null or zero.
 // Warning: calculations using floating point numbers can result in different ways
 // the numbers are stored in memory. Therefore, this function is not suitable to
@@ -155,3 +193,19 @@ func (f Float) IsZero() bool {
 func (f Float) Equal(other Float) bool {
 	return f.ValueOrZero() == other.ValueOrZero()
 }
+
+// Merge returns this Float if it is not null or zero, otherwise other.
+func (f Float) Merge(other Float) Float {
+	if !f.IsZero() {
+		return f
+	}
+	return other
+}
+
+// When returns this Float if cond is true, otherwise a null Float.
+func (f Float) When(cond bool) Float {
+	if !cond {
+		f.SetNull()
+	}
+	return f
+}
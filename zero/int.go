@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
 )
 
@@ -101,6 +102,12 @@ func (i *Int) UnmarshalText(text []byte) error {
 	return err
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (i *Int) UnmarshalParam(param string) error {
+	return i.UnmarshalText([]byte(param))
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode 0 if this Int is null.
 func (i Int) MarshalJSON() ([]byte, error) {
@@ -121,6 +128,22 @@ func (i Int) MarshalText() ([]byte, error) {
 	return []byte(strconv.FormatInt(n, 10)), nil
 }
 
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (i Int) AppendText(buf []byte) ([]byte, error) {
+	n := i.Int64
+	if !i.Valid {
+		n = 0
+	}
+	return strconv.AppendInt(buf, n, 10), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Int to v under key, or nothing if null or zero.
+func (i Int) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, !i.IsZero(), i.MarshalText)
+}
+
 // SetValid changes this Int's value and also sets it to be non-null.
 func (i *Int) SetValid(n int64) {
 	i.Int64 = n
@@ -140,7 +163,38 @@ func (i Int) IsZero() bool {
 	return !i.Valid || i.Int64 == 0
 }
 
+// IsNull returns true if this Int is null or zero, which are considered equivalent.
+func (i Int) IsNull() bool {
+	return i.IsZero()
+}
+
+// SetNull sets this Int to null and clears its value.
+func (i *Int) SetNull() {
+	i.Int64, i.Valid = 0, false
+}
+
+// Reset sets this Int to its zero value, which is null.
+func (i *Int) Reset() {
+	*i = Int{}
+}
+
 // Equal returns true if both ints have the same value or are both either null or zero.
 func (i Int) Equal(other Int) bool {
 	return i.ValueOrZero() == other.ValueOrZero()
 }
+
+// Merge returns this Int if it is not null or zero, otherwise other.
+func (i Int) Merge(other Int) Int {
+	if !i.IsZero() {
+		return i
+	}
+	return other
+}
+
+// When returns this Int if cond is true, otherwise a null Int.
+func (i Int) When(cond bool) Int {
+	if !cond {
+		i.SetNull()
+	}
+	return i
+}
@@ -0,0 +1,200 @@
+//go:build nullmsgp
+
+package zero
+
+import (
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// The MessagePack methods of the types encode their value or zero value as their base type,
+// such as a String as a str and a Time as a timestamp extension, like MarshalJSON.
+// They decode nil and zero values to null.
+
+func appendMsg[T any](b []byte, valid bool, v T, appendV func([]byte, T) []byte) []byte {
+	if !valid {
+		return msgp.AppendNil(b)
+	}
+	return appendV(b, v)
+}
+
+func readMsg[T any](b []byte, read func([]byte) (T, []byte, error), set func(v T, valid bool)) ([]byte, error) {
+	if msgp.IsNil(b) {
+		var zero T
+		set(zero, false)
+		return msgp.ReadNilBytes(b)
+	}
+	v, rest, err := read(b)
+	if err != nil {
+		return b, err
+	}
+	set(v, true)
+	return rest, nil
+}
+
+func encodeMsg[T any](w *msgp.Writer, valid bool, v T, write func(T) error) error {
+	if !valid {
+		return w.WriteNil()
+	}
+	return write(v)
+}
+
+func decodeMsg[T any](r *msgp.Reader, read func() (T, error), set func(v T, valid bool)) error {
+	if r.IsNil() {
+		var zero T
+		set(zero, false)
+		return r.ReadNil()
+	}
+	v, err := read()
+	if err != nil {
+		return err
+	}
+	set(v, true)
+	return nil
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (s String) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, true, s.ValueOrZero(), msgp.AppendString), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (s *String) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadStringBytes, s.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (s String) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, true, s.ValueOrZero(), en.WriteString)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (s *String) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadString, s.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (s String) Msgsize() int {
+	return msgp.StringPrefixSize + len(s.ValueOrZero())
+}
+
+func (s *String) setMsg(v string, valid bool) {
+	*s = StringFrom(v)
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (i Int) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, true, i.ValueOrZero(), msgp.AppendInt64), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (i *Int) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadInt64Bytes, i.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (i Int) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, true, i.ValueOrZero(), en.WriteInt64)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (i *Int) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadInt64, i.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (i Int) Msgsize() int {
+	return msgp.Int64Size
+}
+
+func (i *Int) setMsg(v int64, valid bool) {
+	*i = IntFrom(v)
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (f Float) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, true, f.ValueOrZero(), msgp.AppendFloat64), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (f *Float) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadFloat64Bytes, f.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (f Float) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, true, f.ValueOrZero(), en.WriteFloat64)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (f *Float) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadFloat64, f.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (f Float) Msgsize() int {
+	return msgp.Float64Size
+}
+
+func (f *Float) setMsg(v float64, valid bool) {
+	*f = FloatFrom(v)
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (b Bool) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, true, b.ValueOrZero(), msgp.AppendBool), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (b *Bool) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadBoolBytes, b.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (b Bool) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, true, b.ValueOrZero(), en.WriteBool)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (b *Bool) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadBool, b.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (b Bool) Msgsize() int {
+	return msgp.BoolSize
+}
+
+func (b *Bool) setMsg(v bool, valid bool) {
+	*b = BoolFrom(v)
+}
+
+// MarshalMsg implements msgp.Marshaler.
+func (t Time) MarshalMsg(bts []byte) ([]byte, error) {
+	return appendMsg(bts, true, t.ValueOrZero(), msgp.AppendTime), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler.
+func (t *Time) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return readMsg(bts, msgp.ReadTimeBytes, t.setMsg)
+}
+
+// EncodeMsg implements msgp.Encodable.
+func (t Time) EncodeMsg(en *msgp.Writer) error {
+	return encodeMsg(en, true, t.ValueOrZero(), en.WriteTime)
+}
+
+// DecodeMsg implements msgp.Decodable.
+func (t *Time) DecodeMsg(dc *msgp.Reader) error {
+	return decodeMsg(dc, dc.ReadTime, t.setMsg)
+}
+
+// Msgsize implements msgp.Sizer. It returns an upper bound of the size of the encoded value.
+func (t Time) Msgsize() int {
+	return msgp.TimeSize
+}
+
+func (t *Time) setMsg(v time.Time, valid bool) {
+	*t = TimeFrom(v)
+}
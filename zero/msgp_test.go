@@ -0,0 +1,34 @@
+//go:build nullmsgp
+
+package zero
+
+import (
+	"testing"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+func TestMsgp(t *testing.T) {
+	data, err := String{}.MarshalMsg(nil)
+	maybePanic(err)
+	if want := msgp.AppendString(nil, ""); string(data) != string(want) {
+		t.Errorf("null String = %x, want %x", data, want)
+	}
+
+	i := IntFrom(5)
+	_, err = i.UnmarshalMsg(msgp.AppendInt64(nil, 0))
+	maybePanic(err)
+	if i.Valid {
+		t.Errorf("zero should decode to null: %+v", i)
+	}
+	_, err = i.UnmarshalMsg(msgp.AppendInt64(nil, 3))
+	maybePanic(err)
+	if i.Int64 != 3 || !i.Valid {
+		t.Errorf("UnmarshalMsg = %+v", i)
+	}
+	_, err = i.UnmarshalMsg(msgp.AppendNil(nil))
+	maybePanic(err)
+	if i.Valid {
+		t.Errorf("nil should decode to null: %+v", i)
+	}
+}
@@ -0,0 +1,23 @@
+package zero
+
+import (
+	"time"
+
+	"github.com/attapon-th/null"
+)
+
+var (
+	_ null.Nullable[string]    = String{}
+	_ null.Nullable[int64]     = Int{}
+	_ null.Nullable[float64]   = Float{}
+	_ null.Nullable[bool]      = Bool{}
+	_ null.Nullable[time.Time] = Time{}
+)
+
+var (
+	_ null.Nuller = (*String)(nil)
+	_ null.Nuller = (*Int)(nil)
+	_ null.Nuller = (*Float)(nil)
+	_ null.Nuller = (*Bool)(nil)
+	_ null.Nuller = (*Time)(nil)
+)
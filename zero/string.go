@@ -9,6 +9,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // nullBytes is a JSON null literal
@@ -78,6 +79,21 @@ func (s String) MarshalText() ([]byte, error) {
 	return []byte(s.String), nil
 }
 
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (s String) AppendText(buf []byte) ([]byte, error) {
+	if !s.Valid {
+		return buf, nil
+	}
+	return append(buf, s.String...), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this String to v under key, or nothing if null or zero.
+func (s String) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, !s.IsZero(), s.MarshalText)
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null String if the input is a blank string.
 func (s *String) UnmarshalText(text []byte) error {
@@ -86,6 +102,12 @@ func (s *String) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (s *String) UnmarshalParam(param string) error {
+	return s.UnmarshalText([]byte(param))
+}
+
 // SetValid changes this String's value and also sets it to be non-null.
 func (s *String) SetValid(v string) {
 	s.String = v
@@ -105,7 +127,38 @@ func (s String) IsZero() bool {
 	return !s.Valid || s.String == ""
 }
 
+// IsNull returns true if this String is null or zero, which are considered equivalent.
+func (s String) IsNull() bool {
+	return s.IsZero()
+}
+
+// SetNull sets this String to null and clears its value.
+func (s *String) SetNull() {
+	s.String, s.Valid = "", false
+}
+
+// Reset sets this String to its zero value, which is null.
+func (s *String) Reset() {
+	*s = String{}
+}
+
 // Equal returns true if both strings have the same value or are both either null or empty.
 func (s String) Equal(other String) bool {
 	return s.ValueOrZero() == other.ValueOrZero()
 }
+
+// Merge returns this String if it is not null or zero, otherwise other.
+func (s String) Merge(other String) String {
+	if !s.IsZero() {
+		return s
+	}
+	return other
+}
+
+// When returns this String if cond is true, otherwise a null String.
+func (s String) When(cond bool) String {
+	if !cond {
+		s.SetNull()
+	}
+	return s
+}
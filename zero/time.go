@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -93,6 +94,27 @@ func (t Time) MarshalText() ([]byte, error) {
 	return ti.MarshalText()
 }
 
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText to buf.
+func (t Time) AppendText(buf []byte) ([]byte, error) {
+	ti := t.Time
+	if !t.Valid {
+		ti = time.Time{}
+	}
+	if y := ti.Year(); y < 0 || y > 9999 {
+		// let time.Time report the error
+		_, err := ti.MarshalText()
+		return buf, err
+	}
+	return ti.AppendFormat(buf, time.RFC3339Nano), nil
+}
+
+// EncodeValues implements query.Encoder from github.com/google/go-querystring.
+// It adds the text form of this Time to v under key, or nothing if null or zero.
+func (t Time) EncodeValues(key string, v *url.Values) error {
+	return encodeValues(key, v, !t.IsZero(), t.MarshalText)
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It has compatibility with the null package in that it will accept empty strings as invalid values,
 // which will be unmarshaled to an invalid zero value.
@@ -110,6 +132,12 @@ func (t *Time) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// UnmarshalParam implements the BindUnmarshaler interfaces of Gin and Echo,
+// so query, form and path parameters are decoded like UnmarshalText.
+func (t *Time) UnmarshalParam(param string) error {
+	return t.UnmarshalText([]byte(param))
+}
+
 // SetValid changes this Time's value and
 // sets it to be non-null.
 func (t *Time) SetValid(v time.Time) {
@@ -131,6 +159,21 @@ func (t Time) IsZero() bool {
 	return !t.Valid || t.Time.IsZero()
 }
 
+// IsNull returns true if this Time is null or zero, which are considered equivalent.
+func (t Time) IsNull() bool {
+	return t.IsZero()
+}
+
+// SetNull sets this Time to null and clears its value.
+func (t *Time) SetNull() {
+	t.Time, t.Valid = time.Time{}, false
+}
+
+// Reset sets this Time to its zero value, which is null.
+func (t *Time) Reset() {
+	*t = Time{}
+}
+
 // Equal returns true if both Time objects encode the same time or are both are either null or zero.
 // Two times can be equal even if they are in different locations.
 // For example, 6:00 +0200 CEST and 4:00 UTC are Equal.
@@ -138,6 +181,22 @@ func (t Time) Equal(other Time) bool {
 	return t.ValueOrZero().Equal(other.ValueOrZero())
 }
 
+// Merge returns this Time if it is not null or zero, otherwise other.
+func (t Time) Merge(other Time) Time {
+	if !t.IsZero() {
+		return t
+	}
+	return other
+}
+
+// When returns this Time if cond is true, otherwise a null Time.
+func (t Time) When(cond bool) Time {
+	if !cond {
+		t.SetNull()
+	}
+	return t
+}
+
 // ExactEqual returns true if both Time objects are equal or both are either null or zero.
 // ExactEqual returns false for times that are in different locations or
 // have a different monotonic clock reading.
@@ -0,0 +1,18 @@
+package zero
+
+import (
+	"net/url"
+)
+
+// encodeValues adds the text returned by marshalText to v under key if valid.
+func encodeValues(key string, v *url.Values, valid bool, marshalText func() ([]byte, error)) error {
+	if !valid {
+		return nil
+	}
+	text, err := marshalText()
+	if err != nil {
+		return err
+	}
+	v.Add(key, string(text))
+	return nil
+}
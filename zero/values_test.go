@@ -0,0 +1,24 @@
+package zero
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestEncodeValues(t *testing.T) {
+	v := url.Values{}
+	for key, e := range map[string]interface {
+		EncodeValues(key string, v *url.Values) error
+	}{
+		"s":     StringFrom("test"),
+		"empty": StringFrom(""),
+		"i":     IntFrom(5),
+		"zero":  NewInt(0, true),
+		"b":     BoolFrom(true),
+	} {
+		maybePanic(e.EncodeValues(key, &v))
+	}
+	if got := v.Encode(); got != "b=true&i=5&s=test" {
+		t.Errorf("EncodeValues = %s", got)
+	}
+}